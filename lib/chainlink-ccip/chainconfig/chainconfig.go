@@ -3,8 +3,11 @@ package chainconfig
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -46,6 +49,54 @@ type ChainConfig struct {
 	// on this chain. If true, we will only report prices based on the heartbeat (configured
 	// in the commit plugin offchain config).
 	ChainFeeDeviationDisabled bool `json:"chainFeeDeviationDisabled"`
+
+	// SourceChainFeePolicies overrides how the gas prices of specific source chains are reported to this chain,
+	// e.g. to use a wider deviation and a shorter heartbeat for an L2 with volatile fees.
+	// Source chains without a policy use GasPriceDeviationPPB, DAGasPriceDeviationPPB and the heartbeat
+	// configured in the commit plugin offchain config.
+	SourceChainFeePolicies map[cciptypes.ChainSelector]ChainFeePolicy `json:"sourceChainFeePolicies,omitempty"`
+}
+
+// ChainFeePolicy configures the gas price updates of a single source chain. Unset fields fall back to the
+// destination chain defaults.
+type ChainFeePolicy struct {
+	// GasPriceDeviationPPB overrides ChainConfig.GasPriceDeviationPPB for the source chain.
+	GasPriceDeviationPPB cciptypes.BigInt `json:"gasPriceDeviationPPB"`
+
+	// DAGasPriceDeviationPPB overrides ChainConfig.DAGasPriceDeviationPPB for the source chain.
+	DAGasPriceDeviationPPB cciptypes.BigInt `json:"daGasPriceDeviationPPB"`
+
+	// Heartbeat overrides the commit plugin RemoteGasPriceBatchWriteFrequency for the source chain.
+	Heartbeat commonconfig.Duration `json:"heartbeat"`
+
+	// EMASmoothingFactor enables an exponential moving average of the observed gas prices of the source chain
+	// when set, the weight of the latest observation between 0 (exclusive) and 1. The smoothed gas price is the one
+	// checked for deviation and reported.
+	EMASmoothingFactor float64 `json:"emaSmoothingFactor"`
+
+	// MaxUpdatesPerHour caps the deviation based updates of the source chain gas price, zero means no cap.
+	// Heartbeat updates are not capped.
+	MaxUpdatesPerHour uint32 `json:"maxUpdatesPerHour"`
+}
+
+func (p ChainFeePolicy) Validate() error {
+	if p.GasPriceDeviationPPB.Int != nil && p.GasPriceDeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
+		return errors.New("GasPriceDeviationPPB must be positive")
+	}
+
+	if p.DAGasPriceDeviationPPB.Int != nil && p.DAGasPriceDeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
+		return errors.New("DAGasPriceDeviationPPB must be positive")
+	}
+
+	if p.Heartbeat.Duration() < 0 {
+		return errors.New("Heartbeat is negative")
+	}
+
+	if p.EMASmoothingFactor < 0 || p.EMASmoothingFactor > 1 {
+		return fmt.Errorf("EMASmoothingFactor must be between 0 and 1, got %f", p.EMASmoothingFactor)
+	}
+
+	return nil
 }
 
 func (cc ChainConfig) Validate() error {
@@ -68,6 +119,12 @@ func (cc ChainConfig) Validate() error {
 	// No validation for OptimisticConfirmations as it is deprecated
 	// and no longer used.
 
+	for chain, policy := range cc.SourceChainFeePolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid fee policy for source chain %d: %w", chain, err)
+		}
+	}
+
 	return nil
 }
 
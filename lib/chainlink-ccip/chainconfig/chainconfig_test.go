@@ -3,6 +3,9 @@ package chainconfig
 import (
 	"math/big"
 	"testing"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -12,6 +15,7 @@ func TestChainConfig_Validate(t *testing.T) {
 		GasPriceDeviationPPB    cciptypes.BigInt
 		DAGasPriceDeviationPPB  cciptypes.BigInt
 		OptimisticConfirmations uint32
+		SourceChainFeePolicies  map[cciptypes.ChainSelector]ChainFeePolicy
 	}
 	tests := []struct {
 		name    string
@@ -74,6 +78,44 @@ func TestChainConfig_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid, with source chain fee policy",
+			fields{
+				GasPriceDeviationPPB:   cciptypes.BigInt{Int: big.NewInt(1)},
+				DAGasPriceDeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				SourceChainFeePolicies: map[cciptypes.ChainSelector]ChainFeePolicy{
+					1: {
+						GasPriceDeviationPPB: cciptypes.BigInt{Int: big.NewInt(2e8)},
+						Heartbeat:            *commonconfig.MustNewDuration(10 * time.Minute),
+						EMASmoothingFactor:   0.2,
+						MaxUpdatesPerHour:    6,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid, source chain fee policy with negative deviation",
+			fields{
+				GasPriceDeviationPPB:   cciptypes.BigInt{Int: big.NewInt(1)},
+				DAGasPriceDeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				SourceChainFeePolicies: map[cciptypes.ChainSelector]ChainFeePolicy{
+					1: {DAGasPriceDeviationPPB: cciptypes.BigInt{Int: big.NewInt(-1)}},
+				},
+			},
+			true,
+		},
+		{
+			"invalid, source chain fee policy with ema smoothing factor above 1",
+			fields{
+				GasPriceDeviationPPB:   cciptypes.BigInt{Int: big.NewInt(1)},
+				DAGasPriceDeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				SourceChainFeePolicies: map[cciptypes.ChainSelector]ChainFeePolicy{
+					1: {EMASmoothingFactor: 1.5},
+				},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -81,6 +123,7 @@ func TestChainConfig_Validate(t *testing.T) {
 				GasPriceDeviationPPB:    tt.fields.GasPriceDeviationPPB,
 				DAGasPriceDeviationPPB:  tt.fields.DAGasPriceDeviationPPB,
 				OptimisticConfirmations: tt.fields.OptimisticConfirmations,
+				SourceChainFeePolicies:  tt.fields.SourceChainFeePolicies,
 			}
 			if err := cc.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("ChainConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,122 @@
+// ccip-inspect derives, fetches and decodes the CCIP program accounts for the given chain selectors and mints.
+//
+// Example:
+//
+//	go run ./cmd/ccip-inspect --rpc https://api.devnet.solana.com \
+//	  --router <router> --offramp <offramp> --fee-quoter <fee_quoter> \
+//	  --chain-selectors 16015286601757825753 --mints <mint> --format table
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/inspect"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Problem running command: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("ccip-inspect", flag.ContinueOnError)
+	rpcURL := fs.String("rpc", "http://127.0.0.1:8899", "Solana RPC URL")
+	router := fs.String("router", "", "CCIP router program ID")
+	offramp := fs.String("offramp", "", "CCIP offramp program ID")
+	feeQuoter := fs.String("fee-quoter", "", "fee quoter program ID")
+	rmnRemote := fs.String("rmn-remote", "", "RMN remote program ID")
+	pools := fs.String("token-pools", "", "comma separated token pool program IDs")
+	selectors := fs.String("chain-selectors", "", "comma separated remote chain selectors")
+	mints := fs.String("mints", "", "comma separated token mints")
+	format := fs.String("format", "json", "output format: json or table")
+	commitment := fs.String("commitment", string(rpc.CommitmentConfirmed), "commitment level")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var q inspect.Query
+	var err error
+	if q.Router, err = parseKey(*router); err != nil {
+		return fmt.Errorf("invalid --router: %w", err)
+	}
+	if q.OffRamp, err = parseKey(*offramp); err != nil {
+		return fmt.Errorf("invalid --offramp: %w", err)
+	}
+	if q.FeeQuoter, err = parseKey(*feeQuoter); err != nil {
+		return fmt.Errorf("invalid --fee-quoter: %w", err)
+	}
+	if q.RMNRemote, err = parseKey(*rmnRemote); err != nil {
+		return fmt.Errorf("invalid --rmn-remote: %w", err)
+	}
+	if q.TokenPools, err = parseKeys(*pools); err != nil {
+		return fmt.Errorf("invalid --token-pools: %w", err)
+	}
+	if q.Mints, err = parseKeys(*mints); err != nil {
+		return fmt.Errorf("invalid --mints: %w", err)
+	}
+	for _, s := range splitList(*selectors) {
+		selector, perr := strconv.ParseUint(s, 10, 64)
+		if perr != nil {
+			return fmt.Errorf("invalid --chain-selectors: %w", perr)
+		}
+		q.ChainSelectors = append(q.ChainSelectors, selector)
+	}
+
+	if q.Router.IsZero() && q.OffRamp.IsZero() && q.FeeQuoter.IsZero() && q.RMNRemote.IsZero() && len(q.TokenPools) == 0 {
+		return errors.New("at least one program ID is required")
+	}
+
+	accounts, err := inspect.Inspect(ctx, rpc.New(*rpcURL), q, rpc.CommitmentType(*commitment))
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "json":
+		return inspect.WriteJSON(os.Stdout, accounts)
+	case "table":
+		return inspect.WriteTable(os.Stdout, accounts)
+	default:
+		return fmt.Errorf("unknown format %q, expected one of [json, table]", *format)
+	}
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func parseKey(s string) (solana.PublicKey, error) {
+	if s == "" {
+		return solana.PublicKey{}, nil
+	}
+	return solana.PublicKeyFromBase58(s)
+}
+
+func parseKeys(s string) ([]solana.PublicKey, error) {
+	var keys []solana.PublicKey
+	for _, v := range splitList(s) {
+		k, err := solana.PublicKeyFromBase58(v)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
@@ -0,0 +1,92 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/testutils"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/fees"
+)
+
+func TestSVMComputeBudgetEstimator(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	url := testutils.SetupLocalSolNode(t)
+	c := rpc.New(url)
+
+	sender, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+	testutils.FundAccounts(ctx, []solana.PrivateKey{sender}, c, t)
+
+	instructions := []solana.Instruction{}
+	for i := 0; i < 5; i++ {
+		k, kerr := solana.NewRandomPrivateKey()
+		require.NoError(t, kerr)
+		instructions = append(instructions, system.NewTransferInstruction(1_000_000_000, sender.PublicKey(), k.PublicKey()).Build())
+	}
+
+	estimator := fees.NewEstimator(c, fees.EstimatorConfig{
+		MarginBPS:   2_000,
+		PriorityFee: fees.PercentilePriorityFee{Percentile: 50, Min: 10},
+		Commitment:  rpc.CommitmentConfirmed,
+	})
+
+	t.Run("estimate", func(t *testing.T) {
+		blockhash, err := c.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		tx, err := solana.NewTransaction(instructions, blockhash.Value.Blockhash, solana.TransactionPayer(sender.PublicKey()))
+		require.NoError(t, err)
+		instructionCount := len(tx.Message.Instructions)
+
+		est, err := estimator.Estimate(ctx, tx)
+		require.NoError(t, err)
+		assert.Equal(t, instructionCount, len(tx.Message.Instructions)) // estimate does not modify the transaction
+		assert.Positive(t, est.UnitsConsumed)
+		assert.Equal(t, fees.ComputeUnitLimit(est.UnitsConsumed*12/10), est.ComputeUnitLimit) //nolint:gosec // test values are small
+		assert.GreaterOrEqual(t, est.ComputeUnitPrice, fees.ComputeUnitPrice(10))
+	})
+
+	t.Run("send with estimated budget", func(t *testing.T) {
+		res := testutils.SendAndConfirm(ctx, t, c, instructions, sender, rpc.CommitmentConfirmed, common.AddEstimatedComputeBudget(ctx, estimator))
+		require.NotNil(t, res.Meta)
+		require.NotNil(t, res.Meta.ComputeUnitsConsumed)
+
+		tx, err := res.Transaction.GetTransaction()
+		require.NoError(t, err)
+		var limit *fees.ComputeUnitLimit
+		for _, ix := range tx.Message.Instructions {
+			if !tx.Message.AccountKeys[ix.ProgramIDIndex].Equals(fees.ComputeBudgetProgram) {
+				continue
+			}
+			if v, perr := fees.ParseComputeUnitLimit(ix.Data); perr == nil {
+				limit = &v
+			}
+		}
+		require.NotNil(t, limit)
+		assert.GreaterOrEqual(t, uint64(*limit), *res.Meta.ComputeUnitsConsumed)
+		assert.Less(t, *limit, fees.MaxComputeUnitLimit)
+	})
+
+	t.Run("failed simulation", func(t *testing.T) {
+		broke, err := solana.NewRandomPrivateKey()
+		require.NoError(t, err)
+		blockhash, err := c.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+		require.NoError(t, err)
+		tx, err := solana.NewTransaction([]solana.Instruction{
+			system.NewTransferInstruction(1, broke.PublicKey(), sender.PublicKey()).Build(),
+		}, blockhash.Value.Blockhash, solana.TransactionPayer(broke.PublicKey()))
+		require.NoError(t, err)
+
+		_, err = estimator.Estimate(ctx, tx)
+		require.ErrorContains(t, err, "simulation failed")
+	})
+}
@@ -0,0 +1,57 @@
+package ccip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/fees"
+)
+
+type Operation string
+
+const (
+	OperationCommit   Operation = "commit"
+	OperationExecute  Operation = "execute"
+	OperationCCIPSend Operation = "ccip_send"
+)
+
+// DefaultEstimatorConfigs are the compute budget margins per CCIP operation
+// execute varies the most between simulation and landing (receiver + token pool CPIs), so it gets the largest margin
+var DefaultEstimatorConfigs = map[Operation]fees.EstimatorConfig{
+	OperationCommit: {
+		MarginBPS:   1_000,
+		PriorityFee: fees.PercentilePriorityFee{Percentile: 50},
+		Commitment:  rpc.CommitmentConfirmed,
+	},
+	OperationExecute: {
+		MarginBPS:   2_500,
+		PriorityFee: fees.PercentilePriorityFee{Percentile: 75},
+		Commitment:  rpc.CommitmentConfirmed,
+	},
+	OperationCCIPSend: {
+		MarginBPS:   1_500,
+		PriorityFee: fees.PercentilePriorityFee{Percentile: 50},
+		Commitment:  rpc.CommitmentConfirmed,
+	},
+}
+
+// NewComputeBudgetEstimator returns an estimator using the default config for the operation
+func NewComputeBudgetEstimator(client *rpc.Client, op Operation) (*fees.Estimator, error) {
+	cfg, ok := DefaultEstimatorConfigs[op]
+	if !ok {
+		return nil, fmt.Errorf("unknown CCIP operation: %s", op)
+	}
+	return fees.NewEstimator(client, cfg), nil
+}
+
+// AddEstimatedComputeBudget returns a TxModifier that estimates the compute budget for the CCIP operation
+func AddEstimatedComputeBudget(ctx context.Context, client *rpc.Client, op Operation) (common.TxModifier, error) {
+	estimator, err := NewComputeBudgetEstimator(client, op)
+	if err != nil {
+		return nil, err
+	}
+	return common.AddEstimatedComputeBudget(ctx, estimator), nil
+}
@@ -0,0 +1,425 @@
+package ccip
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+// Event is a decoded Anchor event emitted by one of the CCIP programs
+type Event struct {
+	Slot      uint64
+	Signature solana.Signature
+	ProgramID solana.PublicKey
+	Name      string
+	// InstructionIndex is the index of the top level instruction the event was emitted under
+	InstructionIndex int
+	// Depth is the invoke depth of the emitting program, 1 for top level instructions and > 1 for CPIs
+	Depth int
+	// Data is a pointer to the typed event struct, or the raw event bytes for events without a known layout
+	Data interface{}
+}
+
+type eventDecodeFunc func(data []byte) (interface{}, error)
+
+type eventDef struct {
+	name   string
+	decode eventDecodeFunc
+}
+
+func eventAs[T any](name string) eventDef {
+	return eventDef{
+		name: name,
+		decode: func(data []byte) (interface{}, error) {
+			obj := new(T)
+			if err := bin.UnmarshalBorsh(obj, data); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		},
+	}
+}
+
+func eventTable(defs ...eventDef) map[[8]byte]eventDef {
+	table := make(map[[8]byte]eventDef, len(defs))
+	for _, d := range defs {
+		table[[8]byte(common.Discriminator("event", d.name))] = d
+	}
+	return table
+}
+
+var ownershipEvents = []eventDef{
+	eventAs[OwnershipTransferRequested]("OwnershipTransferRequested"),
+	eventAs[OwnershipTransferred]("OwnershipTransferred"),
+}
+
+var routerEvents = eventTable(append([]eventDef{
+	eventAs[EventCCIPMessageSent]("CCIPMessageSent"),
+	eventAs[EventRouterConfigSet]("ConfigSet"),
+}, ownershipEvents...)...)
+
+// the offramp emits two different ConfigSet events (OCR and offramp config) sharing a discriminator,
+// they are surfaced with raw data
+var offrampEvents = eventTable(append([]eventDef{
+	{name: "CommitReportAccepted", decode: decodeCommitReportAccepted},
+	eventAs[EventCommitReportPDAClosed]("CommitReportPDAClosed"),
+	eventAs[EventExecutionStateChanged]("ExecutionStateChanged"),
+	eventAs[EventSkippedAlreadyExecutedMessage]("SkippedAlreadyExecutedMessage"),
+	eventAs[EventTransmitted]("Transmitted"),
+	eventAs[EventOfframpReferenceAddressesSet]("ReferenceAddressesSet"),
+}, ownershipEvents...)...)
+
+var feeQuoterEvents = eventTable(append([]eventDef{
+	eventAs[EventFeeQuoterConfigSet]("ConfigSet"),
+	eventAs[UsdPerTokenUpdated]("UsdPerTokenUpdated"),
+	eventAs[TokenPriceUpdateIgnored]("TokenPriceUpdateIgnored"),
+	eventAs[UsdPerUnitGasUpdated]("UsdPerUnitGasUpdated"),
+	eventAs[PremiumMultiplierWeiPerEthUpdated]("PremiumMultiplierWeiPerEthUpdated"),
+	eventAs[TokenTransferFeeConfigUpdated]("TokenTransferFeeConfigUpdated"),
+}, ownershipEvents...)...)
+
+var tokenPoolEvents = eventTable(append([]eventDef{
+	eventAs[tokens.EventBurnLock]("Burned"),
+	eventAs[tokens.EventBurnLock]("Locked"),
+	eventAs[tokens.EventMintRelease]("Minted"),
+	eventAs[tokens.EventMintRelease]("Released"),
+	eventAs[tokens.EventChainConfigured]("RemoteChainConfigured"),
+	eventAs[tokens.EventRemotePoolsAppended]("RemotePoolsAppended"),
+	eventAs[tokens.EventRateLimitConfigured]("RateLimitConfigured"),
+	eventAs[tokens.EventChainRemoved]("RemoteChainRemoved"),
+	eventAs[tokens.EventRouterUpdated]("RouterUpdated"),
+}, ownershipEvents...)...)
+
+// decodeCommitReportAccepted uses bespoke parsing, the optional report cannot be unmarshalled through `bin.UnmarshalBorsh`
+func decodeCommitReportAccepted(data []byte) (interface{}, error) {
+	obj := new(EventCommitReportAccepted)
+	decoder := bin.NewBorshDecoder(data)
+	if err := decoder.Decode(&obj.Discriminator); err != nil {
+		return nil, err
+	}
+	ok, err := decoder.ReadBool()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if err = decoder.Decode(&obj.Report); err != nil {
+			return nil, err
+		}
+	}
+	if err = decoder.Decode(&obj.PriceUpdates); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// EventStreamConfig holds the programs to decode events for
+type EventStreamConfig struct {
+	Router     solana.PublicKey
+	OffRamp    solana.PublicKey
+	FeeQuoter  solana.PublicKey
+	TokenPools []solana.PublicKey
+}
+
+// EventDecoder decodes CCIP events out of transaction logs, attributing each event to the program that emitted it
+type EventDecoder struct {
+	programs map[solana.PublicKey]map[[8]byte]eventDef
+
+	programInvokeRegex *regexp.Regexp
+	programExitRegex   *regexp.Regexp
+}
+
+func NewEventDecoder(cfg EventStreamConfig) *EventDecoder {
+	programs := map[solana.PublicKey]map[[8]byte]eventDef{}
+	add := func(program solana.PublicKey, table map[[8]byte]eventDef) {
+		if !program.IsZero() {
+			programs[program] = table
+		}
+	}
+	add(cfg.Router, routerEvents)
+	add(cfg.OffRamp, offrampEvents)
+	add(cfg.FeeQuoter, feeQuoterEvents)
+	for _, pool := range cfg.TokenPools {
+		add(pool, tokenPoolEvents)
+	}
+
+	return &EventDecoder{
+		programs:           programs,
+		programInvokeRegex: regexp.MustCompile(`^Program (\w+) invoke \[(\d+)\]`),
+		programExitRegex:   regexp.MustCompile(`^Program (\w+) (success|failed)`),
+	}
+}
+
+// Programs returns the programs the decoder knows about
+func (d *EventDecoder) Programs() []solana.PublicKey {
+	out := make([]solana.PublicKey, 0, len(d.programs))
+	for p := range d.programs {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].String() < out[j].String() })
+	return out
+}
+
+type invocation struct {
+	program solana.PublicKey
+	depth   int
+}
+
+// DecodeLogs decodes all events from the logs of a single transaction, including events emitted in CPIs.
+// Events from programs not in the config are ignored.
+func (d *EventDecoder) DecodeLogs(slot uint64, signature solana.Signature, logs []string) ([]Event, error) {
+	var events []Event
+	var stack []invocation
+	instructionIndex := -1
+
+	for _, line := range logs {
+		line = strings.TrimSpace(line)
+
+		if match := d.programInvokeRegex.FindStringSubmatch(line); len(match) > 2 {
+			program, err := solana.PublicKeyFromBase58(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid program id in log %q: %w", line, err)
+			}
+			depth, err := strconv.Atoi(match[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid invoke depth in log %q: %w", line, err)
+			}
+			if depth == 1 {
+				instructionIndex++
+			}
+			stack = append(stack, invocation{program: program, depth: depth})
+			continue
+		}
+
+		if d.programExitRegex.MatchString(line) {
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(line, "Program data:") || len(stack) == 0 {
+			continue
+		}
+
+		current := stack[len(stack)-1]
+		table, ok := d.programs[current.program]
+		if !ok {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(strings.TrimPrefix(line, "Program data:")))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode program data for %s: %w", signature, err)
+		}
+		if len(data) < 8 {
+			continue
+		}
+
+		event := Event{
+			Slot:             slot,
+			Signature:        signature,
+			ProgramID:        current.program,
+			InstructionIndex: instructionIndex,
+			Depth:            current.depth,
+			Data:             data,
+		}
+		if def, found := table[[8]byte(data[:8])]; found {
+			event.Name = def.name
+			if event.Data, err = def.decode(data); err != nil {
+				return nil, fmt.Errorf("failed to decode %s event in %s: %w", def.name, signature, err)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Replay fetches all successful transactions mentioning the configured programs in [startSlot, endSlot] and returns
+// their events ordered by slot. Within a slot transactions keep the order the RPC returned them in, oldest first, for
+// the programs in the order of Programs, so that replays of the same range are deterministic.
+func (d *EventDecoder) Replay(ctx context.Context, client *rpc.Client, startSlot, endSlot uint64, commitment rpc.CommitmentType) ([]Event, error) {
+	type txRef struct {
+		slot      uint64
+		signature solana.Signature
+	}
+	seen := map[solana.Signature]struct{}{}
+	var refs []txRef
+
+	for _, program := range d.Programs() {
+		// signatures are returned newest first, they are reversed once all pages are read
+		var programRefs []txRef
+		var before solana.Signature
+		for {
+			limit := 1000
+			sigs, err := client.GetSignaturesForAddressWithOpts(ctx, program, &rpc.GetSignaturesForAddressOpts{
+				Limit:      &limit,
+				Before:     before,
+				Commitment: commitment,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get signatures for %s: %w", program, err)
+			}
+			if len(sigs) == 0 {
+				break
+			}
+			for _, s := range sigs {
+				if s.Err != nil || s.Slot < startSlot || s.Slot > endSlot {
+					continue
+				}
+				if _, ok := seen[s.Signature]; ok {
+					continue
+				}
+				seen[s.Signature] = struct{}{}
+				programRefs = append(programRefs, txRef{slot: s.Slot, signature: s.Signature})
+			}
+			last := sigs[len(sigs)-1]
+			if last.Slot < startSlot || len(sigs) < limit {
+				break
+			}
+			before = last.Signature
+		}
+		slices.Reverse(programRefs)
+		refs = append(refs, programRefs...)
+	}
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].slot < refs[j].slot })
+
+	v := uint64(0)
+	var events []Event
+	for _, r := range refs {
+		tx, err := client.GetTransaction(ctx, r.signature, &rpc.GetTransactionOpts{
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &v,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transaction %s: %w", r.signature, err)
+		}
+		if tx.Meta == nil {
+			continue
+		}
+		decoded, err := d.DecodeLogs(tx.Slot, r.signature, tx.Meta.LogMessages)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, decoded...)
+	}
+	return events, nil
+}
+
+// Subscribe streams events from successful transactions mentioning any of the configured programs.
+// A transaction mentioning several programs is only decoded once. Errors are sent until ctx is done, the error channel
+// must be read along with the events. Both channels are closed when ctx is done or a subscription fails.
+func (d *EventDecoder) Subscribe(ctx context.Context, client *ws.Client, commitment rpc.CommitmentType) (<-chan Event, <-chan error, error) {
+	var subs []*ws.LogSubscription
+	for _, program := range d.Programs() {
+		sub, err := client.LogsSubscribeMentions(program, commitment)
+		if err != nil {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+			return nil, nil, fmt.Errorf("failed to subscribe to logs for %s: %w", program, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	events := make(chan Event)
+	errs := make(chan error, len(subs))
+	ctx, cancel := context.WithCancel(ctx)
+	dedupe := newSignatureSet(1024)
+
+	// sendErr blocks until the error is read or ctx is done
+	sendErr := func(err error) bool {
+		select {
+		case errs <- err:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, sub := range subs {
+		wg.Add(1)
+		go func(sub *ws.LogSubscription) {
+			defer wg.Done()
+			defer sub.Unsubscribe()
+			for {
+				res, err := sub.Recv(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						sendErr(err)
+						cancel()
+					}
+					return
+				}
+				if res.Value.Err != nil || !dedupe.add(res.Value.Signature) {
+					continue
+				}
+				decoded, err := d.DecodeLogs(res.Context.Slot, res.Value.Signature, res.Value.Logs)
+				if err != nil {
+					if !sendErr(err) {
+						return
+					}
+					continue
+				}
+				for _, e := range decoded {
+					select {
+					case events <- e:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}(sub)
+	}
+
+	go func() {
+		wg.Wait()
+		cancel()
+		close(events)
+		close(errs)
+	}()
+
+	return events, errs, nil
+}
+
+// signatureSet is a bounded set of recently seen signatures
+type signatureSet struct {
+	mu    sync.Mutex
+	size  int
+	order []solana.Signature
+	set   map[solana.Signature]struct{}
+}
+
+func newSignatureSet(size int) *signatureSet {
+	return &signatureSet{size: size, set: make(map[solana.Signature]struct{}, size)}
+}
+
+// add returns false if the signature was already seen
+func (s *signatureSet) add(sig solana.Signature) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.set[sig]; ok {
+		return false
+	}
+	if len(s.order) >= s.size {
+		delete(s.set, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.order = append(s.order, sig)
+	s.set[sig] = struct{}{}
+	return true
+}
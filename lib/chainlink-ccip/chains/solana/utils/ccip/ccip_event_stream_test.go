@@ -0,0 +1,117 @@
+package ccip
+
+import (
+	"encoding/base64"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+func TestEventDecoder(t *testing.T) {
+	t.Parallel()
+
+	router := solana.PublicKey{1}
+	offramp := solana.PublicKey{2}
+	pool := solana.PublicKey{3}
+	other := solana.PublicKey{4}
+	decoder := NewEventDecoder(EventStreamConfig{Router: router, OffRamp: offramp, TokenPools: []solana.PublicKey{pool}})
+
+	dataLine := func(name string, v interface{}) string {
+		b, err := bin.MarshalBorsh(v)
+		require.NoError(t, err)
+		copy(b[:8], common.Discriminator("event", name))
+		return "Program data: " + base64.StdEncoding.EncodeToString(b)
+	}
+
+	execEvent := EventExecutionStateChanged{
+		SourceChainSelector: 10,
+		SequenceNumber:      11,
+		MessageID:           [32]byte{12},
+		State:               ccip_offramp.Success_MessageExecutionState,
+	}
+	releaseEvent := tokens.EventMintRelease{
+		Sender:    solana.PublicKey{5},
+		Recipient: solana.PublicKey{6},
+		Amount:    100,
+		Mint:      solana.PublicKey{7},
+	}
+
+	priceUpdates, err := bin.MarshalBorsh(ccip_offramp.PriceUpdates{})
+	require.NoError(t, err)
+	commitData := append(append(common.Discriminator("event", "CommitReportAccepted"), 0), priceUpdates...)
+
+	logs := []string{
+		"Program " + offramp.String() + " invoke [1]",
+		"Program log: Instruction: Commit",
+		"Program data: " + base64.StdEncoding.EncodeToString(commitData),
+		"Program " + offramp.String() + " success",
+		"Program " + offramp.String() + " invoke [1]",
+		"Program log: Instruction: Execute",
+		"Program " + pool.String() + " invoke [2]",
+		"Program log: Instruction: ReleaseOrMintTokens",
+		dataLine("Released", releaseEvent),
+		"Program " + pool.String() + " consumed 13620 of 180083 compute units",
+		"Program " + pool.String() + " success",
+		"Program " + other.String() + " invoke [2]",
+		dataLine("Released", releaseEvent), // not a configured program
+		"Program " + other.String() + " success",
+		dataLine("ExecutionStateChanged", execEvent),
+		dataLine("UnknownEvent", execEvent),
+		"Program " + offramp.String() + " success",
+	}
+
+	sig := solana.Signature{9}
+	events, err := decoder.DecodeLogs(42, sig, logs)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	for _, e := range events {
+		assert.Equal(t, uint64(42), e.Slot)
+		assert.Equal(t, sig, e.Signature)
+	}
+
+	assert.Equal(t, "CommitReportAccepted", events[0].Name)
+	assert.Equal(t, 0, events[0].InstructionIndex)
+	commit, ok := events[0].Data.(*EventCommitReportAccepted)
+	require.True(t, ok)
+	assert.Nil(t, commit.Report)
+
+	assert.Equal(t, "Released", events[1].Name)
+	assert.Equal(t, pool, events[1].ProgramID)
+	assert.Equal(t, 1, events[1].InstructionIndex)
+	assert.Equal(t, 2, events[1].Depth)
+	release, ok := events[1].Data.(*tokens.EventMintRelease)
+	require.True(t, ok)
+	assert.Equal(t, releaseEvent.Amount, release.Amount)
+	assert.Equal(t, releaseEvent.Recipient, release.Recipient)
+
+	assert.Equal(t, "ExecutionStateChanged", events[2].Name)
+	assert.Equal(t, offramp, events[2].ProgramID)
+	assert.Equal(t, 1, events[2].Depth)
+	exec, ok := events[2].Data.(*EventExecutionStateChanged)
+	require.True(t, ok)
+	assert.Equal(t, execEvent.SequenceNumber, exec.SequenceNumber)
+	assert.Equal(t, execEvent.State, exec.State)
+
+	assert.Equal(t, "", events[3].Name)
+	_, ok = events[3].Data.([]byte)
+	assert.True(t, ok)
+}
+
+func TestSignatureSet(t *testing.T) {
+	t.Parallel()
+	s := newSignatureSet(2)
+	assert.True(t, s.add(solana.Signature{1}))
+	assert.False(t, s.add(solana.Signature{1}))
+	assert.True(t, s.add(solana.Signature{2}))
+	assert.True(t, s.add(solana.Signature{3})) // evicts 1
+	assert.True(t, s.add(solana.Signature{1}))
+	assert.False(t, s.add(solana.Signature{3}))
+}
@@ -152,6 +152,15 @@ func AddComputeUnitPrice(v fees.ComputeUnitPrice) TxModifier {
 	}
 }
 
+// AddEstimatedComputeBudget simulates the transaction and sets the compute unit limit (and price if configured) from the result
+// it should be passed after any modifier that changes instructions so the simulation matches the sent transaction
+func AddEstimatedComputeBudget(ctx context.Context, estimator *fees.Estimator) TxModifier {
+	return func(tx *solana.Transaction, _ map[solana.PublicKey]solana.PrivateKey) error {
+		_, err := estimator.Apply(ctx, tx)
+		return err
+	}
+}
+
 type TransactionConfigs struct {
 	SkipPreflight bool
 	Retries       int
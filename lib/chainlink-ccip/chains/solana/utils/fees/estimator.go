@@ -0,0 +1,217 @@
+package fees
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// MaxComputeUnitLimit is the maximum compute unit limit a transaction can request
+// https://github.com/solana-labs/solana/blob/60858d043ca612334de300805d93ea3014e8ab37/program-runtime/src/compute_budget.rs#L14
+const MaxComputeUnitLimit ComputeUnitLimit = 1_400_000
+
+const basisPoints = 10_000
+
+// PriorityFeeStrategy selects a compute unit price from the recent prioritization fees
+// paid for the writable accounts of a transaction
+type PriorityFeeStrategy interface {
+	ComputeUnitPrice(recent []rpc.PriorizationFeeResult) ComputeUnitPrice
+}
+
+// FixedPriorityFee always returns the configured price, ignoring recent fees
+type FixedPriorityFee ComputeUnitPrice
+
+func (f FixedPriorityFee) ComputeUnitPrice(_ []rpc.PriorizationFeeResult) ComputeUnitPrice {
+	return ComputeUnitPrice(f)
+}
+
+// PercentilePriorityFee returns the given percentile of recent prioritization fees, bounded by Min and Max.
+// A zero Max disables the upper bound.
+type PercentilePriorityFee struct {
+	Percentile uint8
+	Min        ComputeUnitPrice
+	Max        ComputeUnitPrice
+}
+
+func (p PercentilePriorityFee) ComputeUnitPrice(recent []rpc.PriorizationFeeResult) ComputeUnitPrice {
+	price := ComputeUnitPrice(percentile(recent, p.Percentile))
+	if price < p.Min {
+		price = p.Min
+	}
+	if p.Max != 0 && price > p.Max {
+		price = p.Max
+	}
+	return price
+}
+
+// percentile uses the nearest-rank method over the prioritization fees
+func percentile(recent []rpc.PriorizationFeeResult, pct uint8) uint64 {
+	if len(recent) == 0 {
+		return 0
+	}
+	if pct > 100 {
+		pct = 100
+	}
+
+	values := make([]uint64, len(recent))
+	for i, r := range recent {
+		values[i] = r.PrioritizationFee
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	rank := int(math.Ceil(float64(pct) / 100 * float64(len(values))))
+	if rank < 1 {
+		rank = 1
+	}
+	return values[rank-1]
+}
+
+// EstimatorConfig controls how simulated compute units are turned into compute budget instructions
+type EstimatorConfig struct {
+	// MarginBPS is added on top of the simulated units consumed, in basis points (1000 = 10%)
+	MarginBPS uint32
+	// MinComputeUnitLimit is a floor for the estimated limit, zero disables it
+	MinComputeUnitLimit ComputeUnitLimit
+	// PriorityFee selects the compute unit price, nil leaves the price untouched
+	PriorityFee PriorityFeeStrategy
+	// Commitment used for simulation
+	Commitment rpc.CommitmentType
+}
+
+// Estimate is the result of simulating a transaction
+type Estimate struct {
+	UnitsConsumed    uint64
+	ComputeUnitLimit ComputeUnitLimit
+	ComputeUnitPrice ComputeUnitPrice
+	Logs             []string
+}
+
+// Estimator simulates transactions to derive compute unit limits and prices
+type Estimator struct {
+	client *rpc.Client
+	cfg    EstimatorConfig
+}
+
+func NewEstimator(client *rpc.Client, cfg EstimatorConfig) *Estimator {
+	return &Estimator{client: client, cfg: cfg}
+}
+
+// Estimate simulates the transaction with the maximum compute unit limit and returns the
+// margin-adjusted limit and the price chosen by the priority fee strategy. tx is not modified.
+func (e *Estimator) Estimate(ctx context.Context, tx *solana.Transaction) (Estimate, error) {
+	simTx := cloneTransaction(tx)
+	if err := SetComputeUnitLimit(simTx, MaxComputeUnitLimit); err != nil {
+		return Estimate{}, fmt.Errorf("failed to set simulation compute unit limit: %w", err)
+	}
+
+	// signatures are not verified during simulation, but the count must match the header
+	for len(simTx.Signatures) < int(simTx.Message.Header.NumRequiredSignatures) {
+		simTx.Signatures = append(simTx.Signatures, solana.Signature{})
+	}
+
+	res, err := e.client.SimulateTransactionWithOpts(ctx, simTx, &rpc.SimulateTransactionOpts{
+		SigVerify:              false,
+		ReplaceRecentBlockhash: true,
+		Commitment:             e.cfg.Commitment,
+	})
+	if err != nil {
+		return Estimate{}, fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+	if res == nil || res.Value == nil {
+		return Estimate{}, fmt.Errorf("empty simulation result")
+	}
+	if res.Value.Err != nil {
+		return Estimate{}, fmt.Errorf("simulation failed with: %v, logs: %v", res.Value.Err, res.Value.Logs)
+	}
+	if res.Value.UnitsConsumed == nil {
+		return Estimate{}, fmt.Errorf("simulation did not report units consumed")
+	}
+
+	est := Estimate{
+		UnitsConsumed:    *res.Value.UnitsConsumed,
+		ComputeUnitLimit: applyMargin(*res.Value.UnitsConsumed, e.cfg.MarginBPS, e.cfg.MinComputeUnitLimit),
+		Logs:             res.Value.Logs,
+	}
+
+	if e.cfg.PriorityFee != nil {
+		recent, err := e.client.GetRecentPrioritizationFees(ctx, writableAccounts(tx))
+		if err != nil {
+			return Estimate{}, fmt.Errorf("failed to get recent prioritization fees: %w", err)
+		}
+		est.ComputeUnitPrice = e.cfg.PriorityFee.ComputeUnitPrice(recent)
+	}
+
+	return est, nil
+}
+
+// Apply estimates the compute budget and rewrites the compute budget instructions of tx.
+// It must be called before the transaction is signed.
+func (e *Estimator) Apply(ctx context.Context, tx *solana.Transaction) (Estimate, error) {
+	est, err := e.Estimate(ctx, tx)
+	if err != nil {
+		return Estimate{}, err
+	}
+	if err = SetComputeUnitLimit(tx, est.ComputeUnitLimit); err != nil {
+		return Estimate{}, err
+	}
+	if e.cfg.PriorityFee != nil {
+		if err = SetComputeUnitPrice(tx, est.ComputeUnitPrice); err != nil {
+			return Estimate{}, err
+		}
+	}
+	return est, nil
+}
+
+// applyMargin scales the consumed units by the margin and clamps the result to the valid range
+func applyMargin(consumed uint64, marginBPS uint32, floor ComputeUnitLimit) ComputeUnitLimit {
+	limit := consumed * (basisPoints + uint64(marginBPS)) / basisPoints
+	if limit < uint64(floor) {
+		limit = uint64(floor)
+	}
+	if limit > uint64(MaxComputeUnitLimit) {
+		limit = uint64(MaxComputeUnitLimit)
+	}
+	return ComputeUnitLimit(limit) //nolint:gosec // bounded by MaxComputeUnitLimit
+}
+
+// writableAccounts returns the static writable accounts of a message, prioritization fees are tracked per writable account
+// accounts loaded from lookup tables are not included
+func writableAccounts(tx *solana.Transaction) solana.PublicKeySlice {
+	header := tx.Message.Header
+	keys := tx.Message.AccountKeys
+	signers := int(header.NumRequiredSignatures)
+
+	out := solana.PublicKeySlice{}
+	for i, k := range keys {
+		if i < signers {
+			if i < signers-int(header.NumReadonlySignedAccounts) {
+				out = append(out, k)
+			}
+			continue
+		}
+		if i < len(keys)-int(header.NumReadonlyUnsignedAccounts) {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// cloneTransaction copies the parts of a transaction modified by set so simulation does not mutate the original
+func cloneTransaction(tx *solana.Transaction) *solana.Transaction {
+	out := *tx
+	out.Signatures = append([]solana.Signature{}, tx.Signatures...)
+	out.Message.AccountKeys = append(solana.PublicKeySlice{}, tx.Message.AccountKeys...)
+	out.Message.Instructions = make([]solana.CompiledInstruction, len(tx.Message.Instructions))
+	for i, ix := range tx.Message.Instructions {
+		out.Message.Instructions[i] = solana.CompiledInstruction{
+			ProgramIDIndex: ix.ProgramIDIndex,
+			Accounts:       append([]uint16{}, ix.Accounts...),
+			Data:           append(solana.Base58{}, ix.Data...),
+		}
+	}
+	return &out
+}
@@ -0,0 +1,69 @@
+package fees
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMargin(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ComputeUnitLimit(100_000), applyMargin(100_000, 0, 0))
+	assert.Equal(t, ComputeUnitLimit(110_000), applyMargin(100_000, 1_000, 0))
+	assert.Equal(t, ComputeUnitLimit(50_000), applyMargin(10_000, 1_000, 50_000))
+	assert.Equal(t, MaxComputeUnitLimit, applyMargin(1_300_000, 5_000, 0))
+}
+
+func TestPriorityFeeStrategies(t *testing.T) {
+	t.Parallel()
+	recent := []rpc.PriorizationFeeResult{
+		{Slot: 1, PrioritizationFee: 50},
+		{Slot: 2, PrioritizationFee: 10},
+		{Slot: 3, PrioritizationFee: 40},
+		{Slot: 4, PrioritizationFee: 20},
+		{Slot: 5, PrioritizationFee: 30},
+	}
+
+	t.Run("fixed", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, ComputeUnitPrice(7), FixedPriorityFee(7).ComputeUnitPrice(recent))
+	})
+
+	t.Run("percentile", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, ComputeUnitPrice(10), PercentilePriorityFee{Percentile: 0}.ComputeUnitPrice(recent))
+		assert.Equal(t, ComputeUnitPrice(30), PercentilePriorityFee{Percentile: 50}.ComputeUnitPrice(recent))
+		assert.Equal(t, ComputeUnitPrice(50), PercentilePriorityFee{Percentile: 100}.ComputeUnitPrice(recent))
+		assert.Equal(t, ComputeUnitPrice(50), PercentilePriorityFee{Percentile: 200}.ComputeUnitPrice(recent))
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, ComputeUnitPrice(5), PercentilePriorityFee{Percentile: 50, Min: 5}.ComputeUnitPrice(nil))
+		assert.Equal(t, ComputeUnitPrice(25), PercentilePriorityFee{Percentile: 100, Max: 25}.ComputeUnitPrice(recent))
+	})
+}
+
+func TestWritableAccountsAndClone(t *testing.T) {
+	t.Parallel()
+	payer := solana.PublicKey{1}
+	receiver := solana.PublicKey{2}
+	tx, err := solana.NewTransaction([]solana.Instruction{
+		system.NewTransferInstruction(1, payer, receiver).Build(),
+	}, solana.Hash{}, solana.TransactionPayer(payer))
+	require.NoError(t, err)
+
+	// system program is read only
+	assert.Equal(t, solana.PublicKeySlice{payer, receiver}, writableAccounts(tx))
+
+	clone := cloneTransaction(tx)
+	require.NoError(t, SetComputeUnitLimit(clone, MaxComputeUnitLimit))
+	assert.Len(t, tx.Message.Instructions, 1)
+	assert.Len(t, tx.Message.AccountKeys, 3)
+	assert.Len(t, clone.Message.Instructions, 2)
+	assert.Len(t, clone.Message.AccountKeys, 4)
+}
@@ -0,0 +1,223 @@
+package inspect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/burnmint_token_pool"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/fee_quoter"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/rmn_remote"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+// GetMultipleAccounts accepts at most 100 accounts per call
+const maxAccountsPerCall = 100
+
+// Query describes the CCIP state to inspect. Zero program IDs are skipped.
+type Query struct {
+	Router    solana.PublicKey
+	OffRamp   solana.PublicKey
+	FeeQuoter solana.PublicKey
+	RMNRemote solana.PublicKey
+	// TokenPools are inspected for every mint, all pool programs share the base token pool account layout
+	TokenPools     []solana.PublicKey
+	ChainSelectors []uint64
+	Mints          []solana.PublicKey
+}
+
+// Target is a derived account to fetch, with the type used to decode it
+type Target struct {
+	Name    string
+	Program solana.PublicKey
+	Address solana.PublicKey
+	newData func() interface{}
+}
+
+// Account is a fetched and decoded account
+type Account struct {
+	Name    string           `json:"name"`
+	Program solana.PublicKey `json:"program"`
+	Address solana.PublicKey `json:"address"`
+	Exists  bool             `json:"exists"`
+	Data    interface{}      `json:"data,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+func target[T any](name string, program, address solana.PublicKey) Target {
+	return Target{
+		Name:    name,
+		Program: program,
+		Address: address,
+		newData: func() interface{} { return new(T) },
+	}
+}
+
+// Targets derives all the PDAs relevant for the query
+func Targets(q Query) ([]Target, error) {
+	var targets []Target
+	var err error
+
+	// pda is used to unify the PDA helpers, most of them also return the bump
+	pda := func(addr solana.PublicKey, _ uint8, e error) solana.PublicKey {
+		if e != nil && err == nil {
+			err = e
+		}
+		return addr
+	}
+	pdaNoBump := func(addr solana.PublicKey, e error) solana.PublicKey {
+		return pda(addr, 0, e)
+	}
+
+	if !q.Router.IsZero() {
+		targets = append(targets, target[ccip_router.Config]("router config", q.Router, pda(state.FindConfigPDA(q.Router))))
+		for _, selector := range q.ChainSelectors {
+			targets = append(targets, target[ccip_router.DestChain](
+				fmt.Sprintf("router dest chain %d", selector), q.Router, pdaNoBump(state.FindDestChainStatePDA(selector, q.Router))))
+		}
+		for _, mint := range q.Mints {
+			targets = append(targets, target[ccip_common.TokenAdminRegistry](
+				fmt.Sprintf("token admin registry %s", mint), q.Router, pda(state.FindTokenAdminRegistryPDA(mint, q.Router))))
+		}
+	}
+
+	if !q.OffRamp.IsZero() {
+		targets = append(targets,
+			target[ccip_offramp.Config]("offramp config", q.OffRamp, pda(state.FindOfframpConfigPDA(q.OffRamp))),
+			target[ccip_offramp.ReferenceAddresses]("offramp reference addresses", q.OffRamp, pda(state.FindOfframpReferenceAddressesPDA(q.OffRamp))),
+			target[ccip_offramp.GlobalState]("offramp state", q.OffRamp, pda(state.FindOfframpStatePDA(q.OffRamp))),
+		)
+		for _, selector := range q.ChainSelectors {
+			targets = append(targets, target[ccip_offramp.SourceChain](
+				fmt.Sprintf("offramp source chain %d", selector), q.OffRamp, pda(state.FindOfframpSourceChainPDA(selector, q.OffRamp))))
+		}
+	}
+
+	if !q.FeeQuoter.IsZero() {
+		targets = append(targets, target[fee_quoter.Config]("fee quoter config", q.FeeQuoter, pda(state.FindFqConfigPDA(q.FeeQuoter))))
+		for _, selector := range q.ChainSelectors {
+			targets = append(targets, target[fee_quoter.DestChain](
+				fmt.Sprintf("fee quoter dest chain %d", selector), q.FeeQuoter, pda(state.FindFqDestChainPDA(selector, q.FeeQuoter))))
+		}
+		for _, mint := range q.Mints {
+			targets = append(targets, target[fee_quoter.BillingTokenConfigWrapper](
+				fmt.Sprintf("billing token config %s", mint), q.FeeQuoter, pda(state.FindFqBillingTokenConfigPDA(mint, q.FeeQuoter))))
+			for _, selector := range q.ChainSelectors {
+				targets = append(targets, target[fee_quoter.PerChainPerTokenConfig](
+					fmt.Sprintf("per chain per token config %d %s", selector, mint), q.FeeQuoter, pda(state.FindFqPerChainPerTokenConfigPDA(selector, mint, q.FeeQuoter))))
+			}
+		}
+	}
+
+	if !q.RMNRemote.IsZero() {
+		targets = append(targets,
+			target[rmn_remote.Config]("rmn remote config", q.RMNRemote, pda(state.FindRMNRemoteConfigPDA(q.RMNRemote))),
+			target[rmn_remote.Curses]("rmn remote curses", q.RMNRemote, pda(state.FindRMNRemoteCursesPDA(q.RMNRemote))),
+		)
+	}
+
+	for _, pool := range q.TokenPools {
+		for _, mint := range q.Mints {
+			targets = append(targets, target[burnmint_token_pool.State](
+				fmt.Sprintf("pool config %s", mint), pool, pdaNoBump(tokens.TokenPoolConfigAddress(mint, pool))))
+			for _, selector := range q.ChainSelectors {
+				targets = append(targets, target[burnmint_token_pool.ChainConfig](
+					fmt.Sprintf("pool chain config %d %s", selector, mint), pool, pda(tokens.TokenPoolChainConfigPDA(selector, mint, pool))))
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive PDA: %w", err)
+	}
+	return targets, nil
+}
+
+// Inspect derives, fetches and decodes all accounts for the query. Missing accounts and decoding failures are
+// reported per account rather than failing the whole inspection.
+func Inspect(ctx context.Context, client *rpc.Client, q Query, commitment rpc.CommitmentType) ([]Account, error) {
+	targets, err := Targets(q)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, 0, len(targets))
+	for start := 0; start < len(targets); start += maxAccountsPerCall {
+		end := min(start+maxAccountsPerCall, len(targets))
+		batch := targets[start:end]
+
+		keys := make([]solana.PublicKey, len(batch))
+		for i, t := range batch {
+			keys[i] = t.Address
+		}
+		res, err := client.GetMultipleAccountsWithOpts(ctx, keys, &rpc.GetMultipleAccountsOpts{Commitment: commitment})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch accounts: %w", err)
+		}
+		if len(res.Value) != len(batch) {
+			return nil, fmt.Errorf("expected %d accounts, got %d", len(batch), len(res.Value))
+		}
+
+		for i, t := range batch {
+			accounts = append(accounts, decode(t, res.Value[i]))
+		}
+	}
+	return accounts, nil
+}
+
+func decode(t Target, info *rpc.Account) Account {
+	acc := Account{Name: t.Name, Program: t.Program, Address: t.Address}
+	if info == nil {
+		return acc
+	}
+	acc.Exists = true
+	if !info.Owner.Equals(t.Program) {
+		acc.Error = fmt.Sprintf("account owned by %s", info.Owner)
+		return acc
+	}
+
+	data := t.newData()
+	if err := bin.NewBorshDecoder(info.Data.GetBinary()).Decode(data); err != nil {
+		acc.Error = err.Error()
+		return acc
+	}
+	acc.Data = data
+	return acc
+}
+
+func WriteJSON(w io.Writer, accounts []Account) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(accounts)
+}
+
+// WriteTable writes one line per account, decoded data is printed with its field names
+func WriteTable(w io.Writer, accounts []Account) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "NAME\tADDRESS\tEXISTS\tDATA"); err != nil {
+		return err
+	}
+	for _, a := range accounts {
+		data := ""
+		switch {
+		case a.Error != "":
+			data = "error: " + a.Error
+		case a.Data != nil:
+			data = fmt.Sprintf("%+v", a.Data)
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%t\t%s\n", a.Name, a.Address, a.Exists, data); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
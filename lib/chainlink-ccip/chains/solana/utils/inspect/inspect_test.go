@@ -0,0 +1,105 @@
+package inspect
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+)
+
+func TestTargets(t *testing.T) {
+	t.Parallel()
+
+	router := solana.PublicKey{1}
+	offramp := solana.PublicKey{2}
+	feeQuoter := solana.PublicKey{3}
+	pool := solana.PublicKey{4}
+	mint := solana.PublicKey{5}
+
+	targets, err := Targets(Query{
+		Router:         router,
+		OffRamp:        offramp,
+		FeeQuoter:      feeQuoter,
+		TokenPools:     []solana.PublicKey{pool},
+		ChainSelectors: []uint64{10, 20},
+		Mints:          []solana.PublicKey{mint},
+	})
+	require.NoError(t, err)
+
+	// router: config + 2 dest chains + 1 registry
+	// offramp: config + reference addresses + state + 2 source chains
+	// fee quoter: config + 2 dest chains + 1 billing config + 2 per chain per token
+	// pool: config + 2 chain configs
+	assert.Len(t, targets, 4+5+6+3)
+
+	seen := map[solana.PublicKey]bool{}
+	for _, target := range targets {
+		assert.False(t, seen[target.Address], "duplicate address for %s", target.Name)
+		seen[target.Address] = true
+	}
+
+	destChain, err := state.FindDestChainStatePDA(20, router)
+	require.NoError(t, err)
+	assert.Equal(t, "router dest chain 20", targets[2].Name)
+	assert.Equal(t, destChain, targets[2].Address)
+	assert.Equal(t, router, targets[2].Program)
+
+	empty, err := Targets(Query{ChainSelectors: []uint64{10}})
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	router := solana.PublicKey{1}
+	cfg := ccip_router.Config{SvmChainSelector: 15, Owner: solana.PublicKey{9}}
+	data, err := bin.MarshalBorsh(cfg)
+	require.NoError(t, err)
+	target := target[ccip_router.Config]("router config", router, solana.PublicKey{2})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+		acc := decode(target, nil)
+		assert.False(t, acc.Exists)
+		assert.Nil(t, acc.Data)
+	})
+
+	t.Run("decoded", func(t *testing.T) {
+		t.Parallel()
+		acc := decode(target, &rpc.Account{Owner: router, Data: rpc.DataBytesOrJSONFromBytes(data)})
+		assert.True(t, acc.Exists)
+		assert.Empty(t, acc.Error)
+		decoded, ok := acc.Data.(*ccip_router.Config)
+		require.True(t, ok)
+		assert.Equal(t, cfg, *decoded)
+	})
+
+	t.Run("wrong owner", func(t *testing.T) {
+		t.Parallel()
+		acc := decode(target, &rpc.Account{Owner: solana.PublicKey{3}, Data: rpc.DataBytesOrJSONFromBytes(data)})
+		assert.True(t, acc.Exists)
+		assert.Contains(t, acc.Error, "account owned by")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		t.Parallel()
+		acc := decode(target, &rpc.Account{Owner: router, Data: rpc.DataBytesOrJSONFromBytes(make([]byte, 16))})
+		assert.Contains(t, acc.Error, "wrong discriminator")
+	})
+
+	t.Run("table", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		require.NoError(t, WriteTable(&buf, []Account{decode(target, nil)}))
+		assert.Contains(t, buf.String(), "router config")
+		assert.Contains(t, buf.String(), "false")
+	})
+}
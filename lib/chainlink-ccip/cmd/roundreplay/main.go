@@ -0,0 +1,142 @@
+// Command roundreplay replays rounds recorded by a roundrecorder.FileSink against the plugins of this checkout and
+// prints how the outcome and reports differ from the recorded ones.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit"
+	"github.com/smartcontractkit/chainlink-ccip/execute"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type arguments struct {
+	rounds   string
+	snapshot string
+	seqNr    uint64
+	reports  bool
+	verbose  bool
+}
+
+func main() {
+	var args arguments
+	flag.StringVar(&args.rounds, "rounds", "", "File with the recorded rounds, as written by the round recorder.")
+	flag.StringVar(&args.snapshot, "snapshot", "", "JSON file with the reader state to replay the rounds against.")
+	flag.Uint64Var(&args.seqNr, "seqNr", 0, "Only replay the round with this sequence number.")
+	flag.BoolVar(&args.reports, "reports", false,
+		"Also diff the reports. Reports are encoded with JSON codecs and only match rounds recorded with them.")
+	flag.BoolVar(&args.verbose, "v", false, "Log the plugin output.")
+	flag.Parse()
+
+	differ, err := run(context.Background(), args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Problem running command: %s\n", err.Error())
+		os.Exit(2)
+	}
+	if differ {
+		os.Exit(1)
+	}
+}
+
+// run replays the rounds and reports whether any of them differs from its recording.
+func run(ctx context.Context, args arguments) (bool, error) {
+	if args.rounds == "" || args.snapshot == "" {
+		return false, fmt.Errorf("both -rounds and -snapshot are required")
+	}
+
+	rounds, err := roundrecorder.ReadRoundsFile(args.rounds)
+	if err != nil {
+		return false, err
+	}
+	snapshot, err := roundrecorder.ReadSnapshotFile(args.snapshot)
+	if err != nil {
+		return false, err
+	}
+
+	lggr := logger.Nop()
+	if args.verbose {
+		if lggr, err = logger.New(); err != nil {
+			return false, fmt.Errorf("create logger: %w", err)
+		}
+	}
+
+	var differ bool
+	for _, recorded := range rounds {
+		if args.seqNr != 0 && recorded.SeqNr != args.seqNr {
+			continue
+		}
+
+		d, err := replay(ctx, lggr, recorded, snapshot, args.reports)
+		if err != nil {
+			return differ, fmt.Errorf("%s round %d: %w", recorded.Plugin, recorded.SeqNr, err)
+		}
+		if d == "" {
+			fmt.Printf("%s round %d: ok\n", recorded.Plugin, recorded.SeqNr)
+			continue
+		}
+		differ = true
+		fmt.Printf("%s round %d: differs\n%s\n", recorded.Plugin, recorded.SeqNr, d)
+	}
+	return differ, nil
+}
+
+func replay(
+	ctx context.Context,
+	lggr logger.Logger,
+	recorded roundrecorder.Round,
+	snapshot roundrecorder.Snapshot,
+	withReports bool,
+) (string, error) {
+	var (
+		plugin ocr3types.ReportingPlugin[[]byte]
+		decode roundrecorder.OutcomeDecoder
+		err    error
+	)
+	switch recorded.Plugin {
+	case roundrecorder.PluginCommit:
+		plugin, err = commit.NewReplayPlugin(lggr, recorded, snapshot,
+			mocks.NewCommitPluginJSONReportCodec(), mocks.NewMessageHasher(), hexAddressCodec{})
+		decode = roundrecorder.CommitOutcomeDecoder
+	case roundrecorder.PluginExecute:
+		plugin, err = execute.NewReplayPlugin(lggr, recorded, snapshot,
+			mocks.NewExecutePluginJSONReportCodec(), mocks.NewMessageHasher(), hexAddressCodec{})
+		decode = roundrecorder.ExecOutcomeDecoder
+	default:
+		return "", fmt.Errorf("unknown plugin %q", recorded.Plugin)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer plugin.Close()
+
+	replayed, err := roundrecorder.Replay(ctx, plugin, recorded)
+	if err != nil {
+		return "", err
+	}
+	if !withReports {
+		replayed.Reports, replayed.ReportsErr = recorded.Reports, recorded.ReportsErr
+	}
+	return roundrecorder.Diff(recorded, replayed, decode)
+}
+
+// hexAddressCodec encodes addresses as 0x prefixed hex strings regardless of the chain family.
+type hexAddressCodec struct{}
+
+func (hexAddressCodec) AddressBytesToString(addr cciptypes.UnknownAddress, _ cciptypes.ChainSelector) (string, error) {
+	return "0x" + hex.EncodeToString(addr), nil
+}
+
+func (hexAddressCodec) AddressStringToBytes(addr string, _ cciptypes.ChainSelector) (cciptypes.UnknownAddress, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.ToLower(addr), "0x"))
+}
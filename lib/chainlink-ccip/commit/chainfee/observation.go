@@ -55,6 +55,7 @@ func (p *processor) Observation(
 
 	asynclib.WaitForAllNoErrOperations(ctx, p.cfg.ChainFeeAsyncObserverSyncTimeout, operations, lggr)
 	now := time.Now().UTC()
+	feeComponents = p.smoothFeeComponents(lggr, feeComponents)
 
 	lggr.Infow("observed fee components",
 		"feeComponents", feeComponents,
@@ -96,6 +97,29 @@ func filterMapByUniqueChains[T comparable](
 	return filtered
 }
 
+// smoothFeeComponents applies the EMA smoothing of the source chain fee policies of the destination chain config.
+func (p *processor) smoothFeeComponents(
+	lggr logger.Logger,
+	feeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	destChainCfg, err := p.homeChain.GetChainConfig(p.destChain)
+	if err != nil {
+		lggr.Debugw("failed to get dest chain config, fee components are not smoothed", "err", err)
+		return feeComponents
+	}
+
+	factors := make(map[cciptypes.ChainSelector]float64)
+	for chain, policy := range destChainCfg.Config.SourceChainFeePolicies {
+		if policy.EMASmoothingFactor > 0 {
+			factors[chain] = policy.EMASmoothingFactor
+		}
+	}
+	if len(factors) == 0 {
+		return feeComponents
+	}
+	return p.smoother.smooth(lggr, factors, feeComponents)
+}
+
 func (p *processor) observeFChain(lggr logger.Logger) map[cciptypes.ChainSelector]int {
 	fChain, err := p.homeChain.GetFChain()
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	ccipreader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -110,15 +111,19 @@ type asyncObserver struct {
 	baseObserver         *baseObserver
 	lggr                 logger.Logger
 	cancelFunc           func()
+	syncer               *observationstore.Syncer
 	mu                   *sync.RWMutex
 	chainsFeeComponents  map[cciptypes.ChainSelector]types.ChainFeeComponents
 	nativeTokenPrices    map[cciptypes.ChainSelector]cciptypes.BigInt
 	chainFeePriceUpdates map[cciptypes.ChainSelector]Update
 }
 
+// newAsyncObserver creates a new asyncObserver, starting with the values persisted by the cache if they are still
+// fresh.
 func newAsyncObserver(
 	lggr logger.Logger,
 	baseObserver *baseObserver,
+	cache *observationstore.Cache[observationstore.Observations],
 	tickDur, syncTimeout time.Duration,
 ) *asyncObserver {
 	ctx, cf := context.WithCancel(context.Background())
@@ -129,6 +134,42 @@ func newAsyncObserver(
 		cancelFunc:   nil,
 		mu:           &sync.RWMutex{},
 	}
+	obs.syncer = observationstore.NewSyncer(obs.lggr, cache,
+		observationstore.NewSyncOp("chainsFeeComponents",
+			func(ctx context.Context) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+				return obs.baseObserver.getChainsFeeComponents(ctx, obs.lggr)
+			},
+			func(chainsFeeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents) {
+				obs.mu.Lock()
+				obs.chainsFeeComponents = chainsFeeComponents
+				obs.mu.Unlock()
+			},
+		),
+		observationstore.NewSyncOp("nativeTokenPrices",
+			func(ctx context.Context) map[cciptypes.ChainSelector]cciptypes.BigInt {
+				return obs.baseObserver.getNativeTokenPrices(ctx, obs.lggr)
+			},
+			func(nativeTokenPrices map[cciptypes.ChainSelector]cciptypes.BigInt) {
+				obs.mu.Lock()
+				obs.nativeTokenPrices = nativeTokenPrices
+				obs.mu.Unlock()
+			},
+		),
+		observationstore.NewSyncOp("chainFeePriceUpdates",
+			func(ctx context.Context) map[cciptypes.ChainSelector]Update {
+				return obs.baseObserver.getChainFeePriceUpdates(ctx, obs.lggr)
+			},
+			func(chainFeePriceUpdates map[cciptypes.ChainSelector]Update) {
+				obs.mu.Lock()
+				obs.chainFeePriceUpdates = chainFeePriceUpdates
+				obs.mu.Unlock()
+			},
+		),
+	)
+
+	ctxLoad, cfLoad := context.WithTimeout(ctx, syncTimeout)
+	obs.syncer.Restore(ctxLoad)
+	cfLoad()
 
 	ticker := time.NewTicker(tickDur)
 	lggr.Debugw("async chainfee observer started", "tickDur", tickDur, "syncTimeout", syncTimeout)
@@ -149,69 +190,13 @@ func (o *asyncObserver) start(ctx context.Context, ticker <-chan time.Time, sync
 			case <-ctx.Done():
 				return
 			case <-ticker:
-				o.sync(ctx, syncTimeout)
+				o.lggr.Debugw("async chainfee observer is syncing", "syncTimeout", syncTimeout)
+				o.syncer.Sync(ctx, syncTimeout)
 			}
 		}
 	}()
 }
 
-func (o *asyncObserver) sync(ctx context.Context, syncTimeout time.Duration) {
-	o.lggr.Debugw("async chainfee observer is syncing", "syncTimeout", syncTimeout)
-	ctxSync, cf := context.WithTimeout(ctx, syncTimeout)
-	defer cf()
-
-	syncOps := []struct {
-		id string
-		op func(context.Context)
-	}{
-		{
-			id: "chainsFeeComponents",
-			op: func(ctx context.Context) {
-				chainsFeeComponents := o.baseObserver.getChainsFeeComponents(ctx, o.lggr)
-				o.mu.Lock()
-				o.chainsFeeComponents = chainsFeeComponents
-				o.mu.Unlock()
-			},
-		},
-		{
-			id: "nativeTokenPrices",
-			op: func(ctx context.Context) {
-				nativeTokenPrices := o.baseObserver.getNativeTokenPrices(ctx, o.lggr)
-				o.mu.Lock()
-				o.nativeTokenPrices = nativeTokenPrices
-				o.mu.Unlock()
-			},
-		},
-		{
-			id: "chainFeePriceUpdates",
-			op: func(ctx context.Context) {
-				chainFeePriceUpdates := o.baseObserver.getChainFeePriceUpdates(ctx, o.lggr)
-				o.mu.Lock()
-				o.chainFeePriceUpdates = chainFeePriceUpdates
-				o.mu.Unlock()
-			},
-		},
-	}
-
-	wg := &sync.WaitGroup{}
-	wg.Add(len(syncOps))
-	for _, op := range syncOps {
-		go o.applySyncOp(ctxSync, op.id, wg, op.op)
-	}
-	wg.Wait()
-}
-
-// applySyncOp applies the given operation synchronously.
-func (o *asyncObserver) applySyncOp(
-	ctx context.Context, id string, wg *sync.WaitGroup, op func(ctx context.Context)) {
-	defer wg.Done()
-	tStart := time.Now()
-	o.lggr.Debugw("async observer applying sync operation", "id", id)
-	op(ctx)
-	o.lggr.Debugw("async observer has applied the sync operation",
-		"id", id, "duration", time.Since(tStart))
-}
-
 func (o *asyncObserver) getChainsFeeComponents(
 	_ context.Context,
 	lggr logger.Logger,
@@ -1,6 +1,7 @@
 package chainfee
 
 import (
+	"encoding/json"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -23,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/mocks/internal_/plugincommon"
 	reader2 "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
 	"github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -151,6 +154,7 @@ func Test_processor_Observation(t *testing.T) {
 				Return(tc.existingChainFeePriceUpdates).Maybe()
 
 			homeChain.EXPECT().GetFChain().Return(tc.fChain, nil).Maybe()
+			homeChain.EXPECT().GetChainConfig(tc.dstChain).Return(readerpkg.ChainConfig{}, nil).Maybe()
 
 			tStart := time.Now()
 			obs, err := p.Observation(ctx, Outcome{}, Query{})
@@ -346,6 +350,7 @@ func Test_unique_chain_filter_in_Observation(t *testing.T) {
 				Return(tc.existingChainFeePriceUpdates).Maybe()
 
 			homeChain.EXPECT().GetFChain().Return(tc.fChain, nil).Maybe()
+			homeChain.EXPECT().GetChainConfig(tc.dstChain).Return(readerpkg.ChainConfig{}, nil).Maybe()
 
 			obs, err := p.Observation(ctx, Outcome{}, Query{})
 			require.NoError(t, err)
@@ -360,3 +365,39 @@ func Test_unique_chain_filter_in_Observation(t *testing.T) {
 		})
 	}
 }
+
+func Test_asyncObserver_loadsPersistedObservations(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	destChain := ccipocr3.ChainSelector(1)
+	cfg := observationstore.Config{Store: observationstore.NewMemoryStore(), MaxAge: time.Hour}
+
+	feeComponents := map[ccipocr3.ChainSelector]types.ChainFeeComponents{
+		2: {ExecutionFee: big.NewInt(10), DataAvailabilityFee: big.NewInt(20)},
+	}
+	nativeTokenPrices := map[ccipocr3.ChainSelector]ccipocr3.BigInt{2: ccipocr3.NewBigIntFromInt64(30)}
+	persisted := observationstore.Observations{}
+	for id, v := range map[string]any{
+		"chainsFeeComponents": feeComponents,
+		"nativeTokenPrices":   nativeTokenPrices,
+	} {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		persisted[id] = data
+	}
+	observationstore.NewCache[observationstore.Observations](lggr, cfg, destChain, processorLabel).Save(ctx, persisted)
+
+	// no sync happens during the test, the observer serves the persisted values
+	obs := newAsyncObserver(
+		lggr,
+		&baseObserver{},
+		observationstore.NewCache[observationstore.Observations](lggr, cfg, destChain, processorLabel),
+		time.Hour,
+		time.Second,
+	)
+	t.Cleanup(obs.close)
+
+	require.Equal(t, feeComponents, obs.getChainsFeeComponents(ctx, lggr))
+	require.Equal(t, nativeTokenPrices, obs.getNativeTokenPrices(ctx, lggr))
+	require.Empty(t, obs.getChainFeePriceUpdates(ctx, lggr))
+}
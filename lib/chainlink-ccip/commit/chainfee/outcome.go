@@ -222,7 +222,9 @@ func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]
 // the fee quoter updates.
 // A chain fee is selected for update if it meets one of 2 conditions:
 // 1. If time passed since the last update is greater than the stale threshold.
-// 2. If deviation between the fee quoter and latest observed chain fee exceeds the chain's configured threshold.
+// 2. If deviation between the fee quoter and latest observed chain fee exceeds the chain's configured threshold,
+// and the chain did not reach its maximum number of updates per hour.
+// Thresholds are taken from the source chain fee policy of the destination chain config when one is set.
 func (p *processor) getGasPricesToUpdate(
 	lggr logger.Logger,
 	currentChainUSDFees map[cciptypes.ChainSelector]ComponentsUSDPrices,
@@ -236,8 +238,6 @@ func (p *processor) getGasPricesToUpdate(
 		lggr.Errorw("error getting dest chain config", "chain", p.destChain, "err", err)
 		return gasPrices
 	}
-	execGasPriceDeviation := destChainCfg.Config.GasPriceDeviationPPB.Int64()
-	daGasPriceDeviation := destChainCfg.Config.DAGasPriceDeviationPPB.Int64()
 
 	for chain, currentChainFee := range currentChainUSDFees {
 		chainCfg, err := p.homeChain.GetChainConfig(chain)
@@ -247,6 +247,7 @@ func (p *processor) getGasPricesToUpdate(
 		}
 
 		feeConfig := chainCfg.Config
+		policy := resolveFeePolicy(destChainCfg.Config, chain, p.cfg.RemoteGasPriceBatchWriteFrequency.Duration())
 		packedFee := cciptypes.NewBigInt(FeeComponentsToPackedFee(currentChainFee))
 		lastUpdate, exists := latestUpdates[chain]
 		lggr := logger.With(lggr,
@@ -265,12 +266,12 @@ func (p *processor) getGasPricesToUpdate(
 			continue
 		}
 
-		nextUpdateTime := lastUpdate.Timestamp.Add(p.cfg.RemoteGasPriceBatchWriteFrequency.Duration())
+		nextUpdateTime := lastUpdate.Timestamp.Add(policy.heartbeat)
 		if consensusTimestamp.After(nextUpdateTime) {
 			lggr.Infow("chain fee update needed: heartbeat time passed",
 				"nextUpdateTime", nextUpdateTime,
 				"consensusTimestamp", consensusTimestamp,
-				"heartbeatInterval", p.cfg.RemoteGasPriceBatchWriteFrequency)
+				"heartbeatInterval", policy.heartbeat)
 			gasPrices = append(gasPrices, cciptypes.GasPriceChain{
 				ChainSel: chain,
 				GasPrice: packedFee,
@@ -284,21 +285,28 @@ func (p *processor) getGasPricesToUpdate(
 		}
 
 		// Validating later as chain can be updated even if the config is invalid when write frequency is reached
-		if feeConfig.Validate() != nil {
+		if err := feeConfig.Validate(); err != nil {
 			lggr.Errorw("invalid fee config for chain", "err", err)
 			continue
 		}
 
+		if minInterval := policy.minUpdateInterval(); consensusTimestamp.Sub(lastUpdate.Timestamp) < minInterval {
+			lggr.Debugw("chain fee update not allowed: max updates per hour reached",
+				"maxUpdatesPerHour", policy.maxUpdatesPerHour,
+				"minUpdateInterval", minInterval)
+			continue
+		}
+
 		executionFeeDeviates := mathslib.Deviates(
 			currentChainFee.ExecutionFeePriceUSD,
 			lastUpdate.ChainFee.ExecutionFeePriceUSD,
-			execGasPriceDeviation,
+			policy.execDeviationPPB,
 		)
 
 		dataAvFeeDeviates := mathslib.Deviates(
 			currentChainFee.DataAvFeePriceUSD,
 			lastUpdate.ChainFee.DataAvFeePriceUSD,
-			daGasPriceDeviation,
+			policy.daDeviationPPB,
 		)
 
 		if executionFeeDeviates || dataAvFeeDeviates {
@@ -306,16 +314,16 @@ func (p *processor) getGasPricesToUpdate(
 				"chain fee update needed: deviation threshold exceeded for either execution or data availability fee",
 				"executionFeeDeviates", executionFeeDeviates,
 				"dataAvFeeDeviates", dataAvFeeDeviates,
-				"executionFeeDeviationPPB", feeConfig.GasPriceDeviationPPB,
-				"dataAvFeeDeviationPPB", feeConfig.DAGasPriceDeviationPPB)
+				"executionFeeDeviationPPB", policy.execDeviationPPB,
+				"dataAvFeeDeviationPPB", policy.daDeviationPPB)
 			gasPrices = append(gasPrices, cciptypes.GasPriceChain{
 				ChainSel: chain,
 				GasPrice: packedFee,
 			})
 		} else {
 			lggr.Debugw("chain fee update not needed: within deviation thresholds",
-				"executionFeeDeviationPPB", feeConfig.GasPriceDeviationPPB,
-				"dataAvFeeDeviationPPB", feeConfig.DAGasPriceDeviationPPB)
+				"executionFeeDeviationPPB", policy.execDeviationPPB,
+				"dataAvFeeDeviationPPB", policy.daDeviationPPB)
 		}
 	}
 
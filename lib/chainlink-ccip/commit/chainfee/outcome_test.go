@@ -208,10 +208,51 @@ func TestProcessor_Outcome(t *testing.T) {
 	oneMinuteAgo := time.Now().Add(-time.Minute).UTC()
 	numOracles := 5 // Use a consistent number for generating aos
 
+	bothChainsFeeInfo := map[cciptypes.ChainSelector]FeeInfo{
+		internal.EvmChainSelector: {
+			ExecDeviationPPB:             cciptypes.NewBigInt(big.NewInt(1)),
+			DataAvailabilityDeviationPPB: cciptypes.NewBigInt(big.NewInt(1)),
+		},
+		internal.EvmChainSelector2: {
+			ExecDeviationPPB:             cciptypes.NewBigInt(big.NewInt(1)),
+			DataAvailabilityDeviationPPB: cciptypes.NewBigInt(big.NewInt(1)),
+		},
+	}
+	// chain 1 fee doubled since its last update one minute ago, chain 2 fee is unchanged
+	deviationObs := Observation{
+		FeeComponents: map[cciptypes.ChainSelector]types.ChainFeeComponents{
+			internal.EvmChainSelector:  {ExecutionFee: big.NewInt(2), DataAvailabilityFee: big.NewInt(1)},
+			internal.EvmChainSelector2: {ExecutionFee: big.NewInt(2), DataAvailabilityFee: big.NewInt(1)},
+		},
+		NativeTokenPrices: map[cciptypes.ChainSelector]cciptypes.BigInt{
+			internal.EvmChainSelector:  cciptypes.NewBigInt(big.NewInt(2e18)),
+			internal.EvmChainSelector2: cciptypes.NewBigInt(big.NewInt(1e18)),
+		},
+		ChainFeeUpdates: map[cciptypes.ChainSelector]Update{
+			internal.EvmChainSelector: {
+				Timestamp: oneMinuteAgo,
+				ChainFee:  ComponentsUSDPrices{ExecutionFeePriceUSD: big.NewInt(2), DataAvFeePriceUSD: big.NewInt(1)},
+			},
+			internal.EvmChainSelector2: {
+				Timestamp: oneMinuteAgo,
+				ChainFee:  ComponentsUSDPrices{ExecutionFeePriceUSD: big.NewInt(2), DataAvFeePriceUSD: big.NewInt(1)},
+			},
+		},
+		FChain: map[cciptypes.ChainSelector]int{
+			internal.EvmChainSelector:  1,
+			internal.EvmChainSelector2: 1,
+		},
+		TimestampNow: time.Now().UTC(),
+	}
+	chain2PackedFee := cciptypes.NewBigInt(FeeComponentsToPackedFee(ComponentsUSDPrices{
+		ExecutionFeePriceUSD: big.NewInt(2), DataAvFeePriceUSD: big.NewInt(1),
+	}))
+
 	cases := []struct {
 		name                   string
 		chainFeeWriteFrequency commonconfig.Duration
 		feeInfo                map[cciptypes.ChainSelector]FeeInfo
+		sourceFeePolicies      map[cciptypes.ChainSelector]chainconfig.ChainFeePolicy
 		aos                    []plugincommon.AttributedObservation[Observation]
 		expectedError          bool
 		expectedOutcome        func() Outcome
@@ -345,6 +386,50 @@ func TestProcessor_Outcome(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:                   "source chain policy deviation override",
+			chainFeeWriteFrequency: *commonconfig.MustNewDuration(time.Hour),
+			feeInfo:                bothChainsFeeInfo,
+			sourceFeePolicies: map[cciptypes.ChainSelector]chainconfig.ChainFeePolicy{
+				internal.EvmChainSelector: {
+					GasPriceDeviationPPB:   cciptypes.NewBigInt(big.NewInt(2e9)),
+					DAGasPriceDeviationPPB: cciptypes.NewBigInt(big.NewInt(2e9)),
+				},
+			},
+			aos: sameObs(numOracles, deviationObs),
+			expectedOutcome: func() Outcome {
+				return Outcome{}
+			},
+		},
+		{
+			name:                   "source chain policy max updates per hour",
+			chainFeeWriteFrequency: *commonconfig.MustNewDuration(time.Hour),
+			feeInfo:                bothChainsFeeInfo,
+			sourceFeePolicies: map[cciptypes.ChainSelector]chainconfig.ChainFeePolicy{
+				internal.EvmChainSelector: {MaxUpdatesPerHour: 10},
+			},
+			aos: sameObs(numOracles, deviationObs),
+			expectedOutcome: func() Outcome {
+				return Outcome{}
+			},
+		},
+		{
+			name:                   "source chain policy heartbeat",
+			chainFeeWriteFrequency: *commonconfig.MustNewDuration(time.Hour),
+			feeInfo:                bothChainsFeeInfo,
+			sourceFeePolicies: map[cciptypes.ChainSelector]chainconfig.ChainFeePolicy{
+				internal.EvmChainSelector:  {MaxUpdatesPerHour: 10},
+				internal.EvmChainSelector2: {Heartbeat: *commonconfig.MustNewDuration(30 * time.Second)},
+			},
+			aos: sameObs(numOracles, deviationObs),
+			expectedOutcome: func() Outcome {
+				return Outcome{
+					GasPrices: []cciptypes.GasPriceChain{
+						{GasPrice: chain2PackedFee, ChainSel: internal.EvmChainSelector2},
+					},
+				}
+			},
+		},
 		{
 			name: "Empty Observations with only FChain and TimestampNow",
 			aos: func() []plugincommon.AttributedObservation[Observation] {
@@ -387,6 +472,7 @@ func TestProcessor_Outcome(t *testing.T) {
 						DAGasPriceDeviationPPB:    info.DataAvailabilityDeviationPPB,
 						OptimisticConfirmations:   1,
 						ChainFeeDeviationDisabled: info.ChainFeeDeviationDisabled,
+						SourceChainFeePolicies:    tt.sourceFeePolicies,
 					},
 				}, nil).Maybe()
 			}
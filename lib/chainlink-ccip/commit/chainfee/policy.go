@@ -0,0 +1,118 @@
+package chainfee
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// feePolicy is the chain fee update policy of a source chain, resolved from the destination chain config.
+type feePolicy struct {
+	execDeviationPPB  int64
+	daDeviationPPB    int64
+	heartbeat         time.Duration
+	maxUpdatesPerHour uint32
+}
+
+// resolveFeePolicy applies the source chain policy of the destination chain config, if any, on top of the
+// destination chain deviations and the default heartbeat.
+func resolveFeePolicy(
+	destChainCfg chainconfig.ChainConfig,
+	sourceChain cciptypes.ChainSelector,
+	defaultHeartbeat time.Duration,
+) feePolicy {
+	policy := feePolicy{
+		execDeviationPPB: destChainCfg.GasPriceDeviationPPB.Int64(),
+		daDeviationPPB:   destChainCfg.DAGasPriceDeviationPPB.Int64(),
+		heartbeat:        defaultHeartbeat,
+	}
+
+	sourcePolicy, ok := destChainCfg.SourceChainFeePolicies[sourceChain]
+	if !ok {
+		return policy
+	}
+	if !sourcePolicy.GasPriceDeviationPPB.IsEmpty() {
+		policy.execDeviationPPB = sourcePolicy.GasPriceDeviationPPB.Int64()
+	}
+	if !sourcePolicy.DAGasPriceDeviationPPB.IsEmpty() {
+		policy.daDeviationPPB = sourcePolicy.DAGasPriceDeviationPPB.Int64()
+	}
+	if sourcePolicy.Heartbeat.Duration() > 0 {
+		policy.heartbeat = sourcePolicy.Heartbeat.Duration()
+	}
+	policy.maxUpdatesPerHour = sourcePolicy.MaxUpdatesPerHour
+	return policy
+}
+
+// minUpdateInterval is the minimum time between two deviation based updates, zero when updates are not capped.
+func (p feePolicy) minUpdateInterval() time.Duration {
+	if p.maxUpdatesPerHour == 0 {
+		return 0
+	}
+	return time.Hour / time.Duration(p.maxUpdatesPerHour)
+}
+
+// feeSmoother keeps an exponential moving average of the observed fee components of the source chains with an
+// EMASmoothingFactor. The averages are local to the oracle, the fee components consensus takes their median.
+type feeSmoother struct {
+	mu       sync.Mutex
+	averages map[cciptypes.ChainSelector]types.ChainFeeComponents
+}
+
+// smooth returns the fee components with the ones of the chains in factors replaced by their moving average.
+func (s *feeSmoother) smooth(
+	lggr logger.Logger,
+	factors map[cciptypes.ChainSelector]float64,
+	feeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.averages == nil {
+		s.averages = make(map[cciptypes.ChainSelector]types.ChainFeeComponents)
+	}
+	for chain := range s.averages {
+		if _, ok := factors[chain]; !ok {
+			delete(s.averages, chain)
+		}
+	}
+
+	smoothed := make(map[cciptypes.ChainSelector]types.ChainFeeComponents, len(feeComponents))
+	for chain, observed := range feeComponents {
+		factor, ok := factors[chain]
+		if !ok {
+			smoothed[chain] = observed
+			continue
+		}
+
+		avg := observed
+		if prev, ok := s.averages[chain]; ok {
+			avg = types.ChainFeeComponents{
+				ExecutionFee:        ema(observed.ExecutionFee, prev.ExecutionFee, factor),
+				DataAvailabilityFee: ema(observed.DataAvailabilityFee, prev.DataAvailabilityFee, factor),
+			}
+		}
+		lggr.Debugw("smoothed fee components", "chain", chain, "observed", observed, "smoothed", avg)
+		s.averages[chain] = avg
+		smoothed[chain] = avg
+	}
+	return smoothed
+}
+
+// ema returns factor*observed + (1-factor)*prev, or observed when either value is missing.
+func ema(observed, prev *big.Int, factor float64) *big.Int {
+	if observed == nil || prev == nil {
+		return observed
+	}
+
+	res := new(big.Float).Mul(big.NewFloat(factor), new(big.Float).SetInt(observed))
+	res.Add(res, new(big.Float).Mul(big.NewFloat(1-factor), new(big.Float).SetInt(prev)))
+	out, _ := res.Int(nil)
+	return out
+}
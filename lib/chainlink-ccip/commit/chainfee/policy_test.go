@@ -0,0 +1,71 @@
+package chainfee
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_resolveFeePolicy(t *testing.T) {
+	destCfg := chainconfig.ChainConfig{
+		GasPriceDeviationPPB:   cciptypes.NewBigIntFromInt64(1e8),
+		DAGasPriceDeviationPPB: cciptypes.NewBigIntFromInt64(2e8),
+		SourceChainFeePolicies: map[cciptypes.ChainSelector]chainconfig.ChainFeePolicy{
+			1: {
+				GasPriceDeviationPPB: cciptypes.NewBigIntFromInt64(5e8),
+				Heartbeat:            *commonconfig.MustNewDuration(5 * time.Minute),
+				MaxUpdatesPerHour:    4,
+			},
+		},
+	}
+
+	assert.Equal(t, feePolicy{
+		execDeviationPPB: 1e8,
+		daDeviationPPB:   2e8,
+		heartbeat:        time.Hour,
+	}, resolveFeePolicy(destCfg, 2, time.Hour))
+
+	policy := resolveFeePolicy(destCfg, 1, time.Hour)
+	assert.Equal(t, feePolicy{
+		execDeviationPPB:  5e8,
+		daDeviationPPB:    2e8,
+		heartbeat:         5 * time.Minute,
+		maxUpdatesPerHour: 4,
+	}, policy)
+	assert.Equal(t, 15*time.Minute, policy.minUpdateInterval())
+	assert.Zero(t, feePolicy{}.minUpdateInterval())
+}
+
+func Test_feeSmoother(t *testing.T) {
+	lggr := logger.Test(t)
+	fees := func(exec, da int64) types.ChainFeeComponents {
+		return types.ChainFeeComponents{ExecutionFee: big.NewInt(exec), DataAvailabilityFee: big.NewInt(da)}
+	}
+	factors := map[cciptypes.ChainSelector]float64{1: 0.5}
+
+	s := &feeSmoother{}
+	// first observation is used as is
+	got := s.smooth(lggr, factors, map[cciptypes.ChainSelector]types.ChainFeeComponents{1: fees(100, 10), 2: fees(100, 10)})
+	assert.Equal(t, fees(100, 10), got[1])
+
+	got = s.smooth(lggr, factors, map[cciptypes.ChainSelector]types.ChainFeeComponents{1: fees(300, 30), 2: fees(300, 30)})
+	assert.Equal(t, fees(200, 20), got[1])
+	// chains without smoothing are unchanged
+	assert.Equal(t, fees(300, 30), got[2])
+
+	got = s.smooth(lggr, factors, map[cciptypes.ChainSelector]types.ChainFeeComponents{1: fees(200, 40)})
+	assert.Equal(t, fees(200, 30), got[1])
+
+	// averages of chains no longer smoothed are dropped
+	s.smooth(lggr, nil, map[cciptypes.ChainSelector]types.ChainFeeComponents{1: fees(1, 1)})
+	assert.Empty(t, s.averages)
+}
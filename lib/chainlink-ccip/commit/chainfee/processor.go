@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -25,6 +26,7 @@ type processor struct {
 	metricsReporter plugincommon.MetricsReporter
 	fRoleDON        int
 	obs             observer
+	smoother        feeSmoother
 }
 
 func NewProcessor(
@@ -37,6 +39,7 @@ func NewProcessor(
 	chainSupport plugincommon.ChainSupport,
 	fRoleDON int,
 	metricsReporter plugincommon.MetricsReporter,
+	observationStoreCfg observationstore.Config,
 ) plugincommon.PluginProcessor[Query, Observation, Outcome] {
 	var obs observer
 	baseObs := newBaseObserver(
@@ -49,6 +52,7 @@ func NewProcessor(
 		obs = newAsyncObserver(
 			lggr,
 			baseObs,
+			observationstore.NewCache[observationstore.Observations](lggr, observationStoreCfg, destChain, processorLabel),
 			offChainConfig.ChainFeeAsyncObserverSyncFreq,
 			offChainConfig.ChainFeeAsyncObserverSyncTimeout,
 		)
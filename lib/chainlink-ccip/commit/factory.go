@@ -23,7 +23,10 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
@@ -77,6 +80,8 @@ type PluginFactory struct {
 	chainWriters      map[cciptypes.ChainSelector]types.ContractWriter
 	rmnPeerClient     rmn.PeerClient
 	rmnCrypto         cciptypes.RMNCrypto
+	roundRecorder     roundrecorder.Sink
+	observationStore  observationstore.Config
 }
 
 type CommitPluginFactoryParams struct {
@@ -92,6 +97,12 @@ type CommitPluginFactoryParams struct {
 	ContractWriters   map[cciptypes.ChainSelector]types.ContractWriter
 	RmnPeerClient     rmn.PeerClient
 	RmnCrypto         cciptypes.RMNCrypto
+	// RoundRecorder is optional, when set every round is recorded for later replay.
+	RoundRecorder roundrecorder.Sink
+	// ObservationStore is optional, when its Store is set the async observers persist their last successful sync
+	// and load it at startup while it is within the MaxAge freshness window. The node usually provides an
+	// observationstore.DBStore, entries are scoped by the DON and the OCR config digest.
+	ObservationStore observationstore.Config
 }
 
 // NewCommitPluginFactory creates a new PluginFactory instance. For commit plugin, oracle instances are not managed by
@@ -110,6 +121,8 @@ func NewCommitPluginFactory(params CommitPluginFactoryParams) *PluginFactory {
 		chainWriters:      params.ContractWriters,
 		rmnPeerClient:     params.RmnPeerClient,
 		rmnCrypto:         params.RmnCrypto,
+		roundRecorder:     params.RoundRecorder,
+		observationStore:  params.ObservationStore,
 	}
 }
 
@@ -185,6 +198,7 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		p.ocrConfig.Config.ChainSelector,
 		p.ocrConfig.Config.OfframpAddress,
 		p.addrCodec,
+		offchainConfig.CCIPReaderCache,
 	)
 
 	// The node supports the chain that the token prices are on.
@@ -226,34 +240,41 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			oracleIDToP2PID,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			ccipReader,
-			onChainTokenPricesReader,
-			p.commitCodec,
-			p.msgHasher,
-			lggr,
-			p.homeChainReader,
-			rmnHomeReader,
-			p.rmnCrypto,
-			p.rmnPeerClient,
-			config,
-			metricsReporter,
-			p.addrCodec,
-			reportBuilder,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleCommit",
-			Limits: ocr3types.ReportingPluginLimits{
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	var plugin ocr3types.ReportingPlugin[[]byte] = NewPlugin(
+		p.donID,
+		oracleIDToP2PID,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		ccipReader,
+		onChainTokenPricesReader,
+		p.commitCodec,
+		p.msgHasher,
+		lggr,
+		p.homeChainReader,
+		rmnHomeReader,
+		p.rmnCrypto,
+		p.rmnPeerClient,
+		config,
+		metricsReporter,
+		p.addrCodec,
+		reportBuilder,
+		p.observationStore.WithScope(uint32(p.donID), config.ConfigDigest),
+	)
+	if p.roundRecorder != nil {
+		plugin = roundrecorder.NewPlugin(plugin, lggr, p.roundRecorder, roundrecorder.PluginCommit, config)
+	}
+	plugin = tracing.NewPlugin(plugin, "commit", config)
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleCommit",
+		Limits: ocr3types.ReportingPluginLimits{
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func validateOcrConfig(cfg readerpkg.OCR3Config) error {
@@ -31,6 +31,7 @@ import (
 	reader2 "github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
@@ -326,7 +327,9 @@ func TestPluginFactory_NewReportingPlugin(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		pluginCommit, is := plugin.(*Plugin)
+		traced, is := plugin.(*tracing.Plugin)
+		require.True(t, is)
+		pluginCommit, is := traced.ReportingPlugin.(*Plugin)
 		require.True(t, is)
 		pluginOffchainConfig := pluginCommit.offchainCfg
 
@@ -0,0 +1,176 @@
+package merkleroot
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// commitCadence applies the merkle root batching policies of the offchain config.
+//
+// A merkle root is deferred by observing the onRamp max sequence number of its source chain as if no new messages
+// were sent, i.e. offRampNextSeqNum-1. Since the onRamp max sequence numbers consensus is the fChain+1 lowest
+// observation, a root is deferred when at least fChain+1 oracles defer it and the outcome remains deterministic.
+type commitCadence struct {
+	lggr            logger.Logger
+	policies        map[cciptypes.ChainSelector]pluginconfig.MerkleRootBatchingPolicy
+	ccipReader      readerpkg.CCIPReader
+	metricsReporter MetricsReporter
+	now             func() time.Time
+
+	mu sync.Mutex
+	// pending tracks the messages of each source chain that are waiting for a commit.
+	pending map[cciptypes.ChainSelector]pendingMessages
+}
+
+type pendingMessages struct {
+	// offRampNextSeqNum is the first pending message, a new batch starts when it changes.
+	offRampNextSeqNum cciptypes.SeqNum
+	// since is when pending messages were first observed for the batch.
+	since time.Time
+	// released is set once the batch was no longer deferred, to track it only once.
+	released bool
+}
+
+// newCommitCadence returns nil when no source chain has a batching policy.
+func newCommitCadence(
+	lggr logger.Logger,
+	policies map[cciptypes.ChainSelector]pluginconfig.MerkleRootBatchingPolicy,
+	ccipReader readerpkg.CCIPReader,
+	metricsReporter MetricsReporter,
+) *commitCadence {
+	if len(policies) == 0 {
+		return nil
+	}
+	return &commitCadence{
+		lggr:            lggr,
+		policies:        policies,
+		ccipReader:      ccipReader,
+		metricsReporter: metricsReporter,
+		now:             time.Now,
+		pending:         make(map[cciptypes.ChainSelector]pendingMessages),
+	}
+}
+
+// apply returns the onRamp max sequence numbers to observe, with the source chains whose merkle root is deferred
+// set to their offRamp next sequence number minus one. Chains without a policy or without an observed offRamp next
+// sequence number are returned unchanged.
+func (c *commitCadence) apply(
+	ctx context.Context,
+	lggr logger.Logger,
+	onRampMaxSeqNums []plugintypes.SeqNumChain,
+	offRampNextSeqNums []plugintypes.SeqNumChain,
+) []plugintypes.SeqNumChain {
+	if c == nil || len(onRampMaxSeqNums) == 0 {
+		return onRampMaxSeqNums
+	}
+
+	offRampNext := make(map[cciptypes.ChainSelector]cciptypes.SeqNum, len(offRampNextSeqNums))
+	for _, snc := range offRampNextSeqNums {
+		offRampNext[snc.ChainSel] = snc.SeqNum
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	var gasPrice *big.Int
+	gasPriceRead := false
+
+	res := make([]plugintypes.SeqNumChain, 0, len(onRampMaxSeqNums))
+	for _, snc := range onRampMaxSeqNums {
+		policy, hasPolicy := c.policies[snc.ChainSel]
+		nextSeqNum, hasNext := offRampNext[snc.ChainSel]
+		if !hasPolicy || !hasNext || nextSeqNum == 0 {
+			res = append(res, snc)
+			continue
+		}
+
+		if snc.SeqNum < nextSeqNum {
+			// nothing pending
+			delete(c.pending, snc.ChainSel)
+			res = append(res, snc)
+			continue
+		}
+		numPending := uint64(snc.SeqNum-nextSeqNum) + 1
+
+		batch, ok := c.pending[snc.ChainSel]
+		if !ok || batch.offRampNextSeqNum != nextSeqNum {
+			batch = pendingMessages{offRampNextSeqNum: nextSeqNum, since: now}
+		}
+		waited := now.Sub(batch.since)
+
+		if !gasPriceRead {
+			gasPrice = c.destGasPrice(ctx, lggr)
+			gasPriceRead = true
+		}
+		minMessages, maxLatency := scaledBatchingLimits(policy, gasPrice)
+
+		if numPending < minMessages && waited < maxLatency {
+			lggr.Debugw("deferring merkle root",
+				"sourceChain", snc.ChainSel,
+				"pendingMessages", numPending,
+				"minMessages", minMessages,
+				"waited", waited,
+				"maxLatency", maxLatency,
+				"destGasPrice", gasPrice)
+			c.pending[snc.ChainSel] = batch
+			c.metricsReporter.TrackMerkleRootDeferred(snc.ChainSel)
+			res = append(res, plugintypes.NewSeqNumChain(snc.ChainSel, nextSeqNum-1))
+			continue
+		}
+
+		if !batch.released {
+			batch.released = true
+			c.metricsReporter.TrackMerkleRootReleased(snc.ChainSel, numPending, waited)
+		}
+		c.pending[snc.ChainSel] = batch
+		res = append(res, snc)
+	}
+
+	return res
+}
+
+// destGasPrice returns nil when the destination chain gas price cannot be read, the configured limits are then used
+// without scaling.
+func (c *commitCadence) destGasPrice(ctx context.Context, lggr logger.Logger) *big.Int {
+	fees, err := c.ccipReader.GetDestChainFeeComponents(ctx)
+	if err != nil {
+		lggr.Debugw("failed to get dest chain fee components, merkle root batching limits are not scaled", "err", err)
+		return nil
+	}
+	return fees.ExecutionFee
+}
+
+// scaledBatchingLimits scales the policy limits linearly with the gas price, from zero at CheapGasPrice to their
+// configured value at ExpensiveGasPrice.
+func scaledBatchingLimits(
+	policy pluginconfig.MerkleRootBatchingPolicy, gasPrice *big.Int,
+) (minMessages uint64, maxLatency time.Duration) {
+	minMessages, maxLatency = policy.MinMessages, policy.MaxLatency.Duration()
+	if gasPrice == nil || policy.CheapGasPrice.IsEmpty() || policy.ExpensiveGasPrice.IsEmpty() {
+		return minMessages, maxLatency
+	}
+
+	if gasPrice.Cmp(policy.CheapGasPrice.Int) <= 0 {
+		return 0, 0
+	}
+	if gasPrice.Cmp(policy.ExpensiveGasPrice.Int) >= 0 {
+		return minMessages, maxLatency
+	}
+
+	// ratio = (gasPrice - cheap) / (expensive - cheap)
+	num := new(big.Float).SetInt(new(big.Int).Sub(gasPrice, policy.CheapGasPrice.Int))
+	den := new(big.Float).SetInt(new(big.Int).Sub(policy.ExpensiveGasPrice.Int, policy.CheapGasPrice.Int))
+	ratio, _ := new(big.Float).Quo(num, den).Float64()
+
+	return uint64(ratio * float64(minMessages)), time.Duration(ratio * float64(maxLatency))
+}
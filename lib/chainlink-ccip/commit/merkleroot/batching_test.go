@@ -0,0 +1,138 @@
+package merkleroot
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_scaledBatchingLimits(t *testing.T) {
+	policy := pluginconfig.MerkleRootBatchingPolicy{
+		MinMessages:       100,
+		MaxLatency:        *commonconfig.MustNewDuration(10 * time.Minute),
+		CheapGasPrice:     cciptypes.NewBigIntFromInt64(10),
+		ExpensiveGasPrice: cciptypes.NewBigIntFromInt64(110),
+	}
+
+	testCases := []struct {
+		name       string
+		policy     pluginconfig.MerkleRootBatchingPolicy
+		gasPrice   *big.Int
+		expMinMsgs uint64
+		expLatency time.Duration
+	}{
+		{"unknown gas price", policy, nil, 100, 10 * time.Minute},
+		{"cheap", policy, big.NewInt(5), 0, 0},
+		{"expensive", policy, big.NewInt(500), 100, 10 * time.Minute},
+		{"in between", policy, big.NewInt(60), 50, 5 * time.Minute},
+		{
+			"no gas price thresholds",
+			pluginconfig.MerkleRootBatchingPolicy{MinMessages: 100, MaxLatency: policy.MaxLatency},
+			big.NewInt(5),
+			100,
+			10 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minMsgs, latency := scaledBatchingLimits(tc.policy, tc.gasPrice)
+			assert.Equal(t, tc.expMinMsgs, minMsgs)
+			assert.Equal(t, tc.expLatency, latency)
+		})
+	}
+}
+
+type batchingMetrics struct {
+	NoopMetrics
+	deferred int
+	released []uint64
+}
+
+func (m *batchingMetrics) TrackMerkleRootDeferred(cciptypes.ChainSelector) { m.deferred++ }
+
+func (m *batchingMetrics) TrackMerkleRootReleased(_ cciptypes.ChainSelector, pending uint64, _ time.Duration) {
+	m.released = append(m.released, pending)
+}
+
+func TestCommitCadence_apply(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	const batched, notBatched = cciptypes.ChainSelector(1), cciptypes.ChainSelector(2)
+
+	ccipReader := readerpkg_mock.NewMockCCIPReader(t)
+	ccipReader.EXPECT().GetDestChainFeeComponents(ctx).Return(types.ChainFeeComponents{}, errors.New("unavailable"))
+
+	metrics := &batchingMetrics{}
+	c := newCommitCadence(lggr, map[cciptypes.ChainSelector]pluginconfig.MerkleRootBatchingPolicy{
+		batched: {MinMessages: 10, MaxLatency: *commonconfig.MustNewDuration(time.Minute)},
+	}, ccipReader, metrics)
+	now := time.Unix(1_700_000_000, 0)
+	c.now = func() time.Time { return now }
+
+	offRampNext := []plugintypes.SeqNumChain{
+		plugintypes.NewSeqNumChain(batched, 5),
+		plugintypes.NewSeqNumChain(notBatched, 5),
+	}
+	onRampMax := func(batchedMax cciptypes.SeqNum) []plugintypes.SeqNumChain {
+		return []plugintypes.SeqNumChain{
+			plugintypes.NewSeqNumChain(batched, batchedMax),
+			plugintypes.NewSeqNumChain(notBatched, 7),
+		}
+	}
+
+	// 3 pending messages, deferred
+	got := c.apply(ctx, lggr, onRampMax(7), offRampNext)
+	assert.Equal(t, []plugintypes.SeqNumChain{
+		plugintypes.NewSeqNumChain(batched, 4),
+		plugintypes.NewSeqNumChain(notBatched, 7),
+	}, got)
+	assert.Equal(t, 1, metrics.deferred)
+
+	// enough messages are pending
+	got = c.apply(ctx, lggr, onRampMax(14), offRampNext)
+	assert.Equal(t, onRampMax(14), got)
+	assert.Equal(t, []uint64{10}, metrics.released)
+
+	// released batches are tracked once
+	_ = c.apply(ctx, lggr, onRampMax(14), offRampNext)
+	assert.Equal(t, []uint64{10}, metrics.released)
+
+	// new batch after the commit, deferred until max latency
+	offRampNext[0].SeqNum = 15
+	got = c.apply(ctx, lggr, onRampMax(15), offRampNext)
+	assert.Equal(t, cciptypes.SeqNum(14), got[0].SeqNum)
+	now = now.Add(time.Minute)
+	got = c.apply(ctx, lggr, onRampMax(15), offRampNext)
+	assert.Equal(t, cciptypes.SeqNum(15), got[0].SeqNum)
+	assert.Equal(t, []uint64{10, 1}, metrics.released)
+
+	// nothing pending
+	offRampNext[0].SeqNum = 16
+	got = c.apply(ctx, lggr, onRampMax(15), offRampNext)
+	assert.Equal(t, cciptypes.SeqNum(15), got[0].SeqNum)
+	require.Empty(t, c.pending)
+}
+
+func TestCommitCadence_nil(t *testing.T) {
+	assert.Nil(t, newCommitCadence(logger.Test(t), nil, nil, NoopMetrics{}))
+
+	var c *commitCadence
+	seqNums := []plugintypes.SeqNumChain{plugintypes.NewSeqNumChain(1, 10)}
+	assert.Equal(t, seqNums, c.apply(tests.Context(t), logger.Test(t), seqNums, nil))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 
@@ -230,9 +231,11 @@ func (p *Processor) getObservation(
 	nextState := previousOutcome.nextState()
 	switch nextState {
 	case selectingRangesForReport:
+		offRampNextSeqNums := p.observer.ObserveOffRampNextSeqNums(ctx)
+		onRampMaxSeqNums := p.cadence.apply(ctx, lggr, p.observer.ObserveLatestOnRampSeqNums(ctx), offRampNextSeqNums)
 		return Observation{
-			OnRampMaxSeqNums:   p.observer.ObserveLatestOnRampSeqNums(ctx),
-			OffRampNextSeqNums: p.observer.ObserveOffRampNextSeqNums(ctx),
+			OnRampMaxSeqNums:   onRampMaxSeqNums,
+			OffRampNextSeqNums: offRampNextSeqNums,
 			RMNRemoteConfig:    p.observer.ObserveRMNRemoteCfg(ctx),
 			FChain:             p.observer.ObserveFChain(ctx),
 		}, nextState, nil
@@ -312,6 +315,7 @@ type asyncObserver struct {
 	lggr                logger.Logger
 	syncObserver        observerImpl
 	cancelFunc          func()
+	syncer              *observationstore.Syncer
 	mu                  *sync.RWMutex
 	offRampNextSeqNums  []plugintypes.SeqNumChain
 	onRampLatestSeqNums []plugintypes.SeqNumChain
@@ -320,7 +324,13 @@ type asyncObserver struct {
 // newAsyncObserver creates a new asyncObserver.
 // It fetches the data from the base observer asynchronously and caches the results.
 // It fetches the data every tickDur and uses a timeout of syncTimeout to kill long RPC calls.
-func newAsyncObserver(lggr logger.Logger, observer observerImpl, tickDur, syncTimeout time.Duration) *asyncObserver {
+// Until the first sync completes it serves the values persisted by the cache if they are still fresh.
+func newAsyncObserver(
+	lggr logger.Logger,
+	observer observerImpl,
+	cache *observationstore.Cache[observationstore.Observations],
+	tickDur, syncTimeout time.Duration,
+) *asyncObserver {
 	ctx, cf := context.WithCancel(context.Background())
 
 	o := &asyncObserver{
@@ -331,6 +341,28 @@ func newAsyncObserver(lggr logger.Logger, observer observerImpl, tickDur, syncTi
 		offRampNextSeqNums:  make([]plugintypes.SeqNumChain, 0),
 		onRampLatestSeqNums: make([]plugintypes.SeqNumChain, 0),
 	}
+	o.syncer = observationstore.NewSyncer(lggr, cache,
+		observationstore.NewSyncOp("offRampNextSeqNums",
+			o.syncObserver.ObserveOffRampNextSeqNums,
+			func(offRampNext []plugintypes.SeqNumChain) {
+				o.mu.Lock()
+				o.offRampNextSeqNums = offRampNext
+				o.mu.Unlock()
+			},
+		),
+		observationstore.NewSyncOp("onRampLatestSeqNums",
+			o.syncObserver.ObserveLatestOnRampSeqNums,
+			func(onRampLast []plugintypes.SeqNumChain) {
+				o.mu.Lock()
+				o.onRampLatestSeqNums = onRampLast
+				o.mu.Unlock()
+			},
+		),
+	)
+
+	ctxLoad, cfLoad := context.WithTimeout(ctx, syncTimeout)
+	o.syncer.Restore(ctxLoad)
+	cfLoad()
 
 	ticker := time.NewTicker(tickDur)
 	lggr.Debugw("async observer started", "tickDur", tickDur, "syncTimeout", syncTimeout)
@@ -351,60 +383,13 @@ func (o *asyncObserver) start(ctx context.Context, ticker <-chan time.Time, sync
 			case <-ctx.Done():
 				return
 			case <-ticker:
-				o.sync(ctx, syncTimeout)
+				o.lggr.Debugw("async observer is syncing", "syncTimeout", syncTimeout)
+				o.syncer.Sync(ctx, syncTimeout)
 			}
 		}
 	}()
 }
 
-func (o *asyncObserver) sync(ctx context.Context, syncTimeout time.Duration) {
-	o.lggr.Debugw("async observer is syncing", "syncTimeout", syncTimeout)
-	ctxSync, cf := context.WithTimeout(ctx, syncTimeout)
-	defer cf()
-
-	syncOps := []struct {
-		id string
-		op func(context.Context)
-	}{
-		{
-			id: "offRampNextSeqNums",
-			op: func(ctx context.Context) {
-				offRampNext := o.syncObserver.ObserveOffRampNextSeqNums(ctxSync)
-				o.mu.Lock()
-				o.offRampNextSeqNums = offRampNext
-				o.mu.Unlock()
-			},
-		},
-		{
-			id: "onRampLatestSeqNums",
-			op: func(ctx context.Context) {
-				onRampLast := o.syncObserver.ObserveLatestOnRampSeqNums(ctxSync)
-				o.mu.Lock()
-				o.onRampLatestSeqNums = onRampLast
-				o.mu.Unlock()
-			},
-		},
-	}
-
-	wg := &sync.WaitGroup{}
-	wg.Add(len(syncOps))
-	for _, op := range syncOps {
-		go o.applySyncOp(ctxSync, o.lggr, op.id, wg, op.op)
-	}
-	wg.Wait()
-}
-
-// applySyncOp applies the given operation synchronously.
-func (o *asyncObserver) applySyncOp(
-	ctx context.Context, lggr logger.Logger, id string, wg *sync.WaitGroup, op func(ctx context.Context)) {
-	defer wg.Done()
-	tStart := time.Now()
-	o.lggr.Debugw("async observer applying sync operation", "id", id)
-	op(ctx)
-	lggr.Debugw("async observer has applied the sync operation",
-		"id", id, "duration", time.Since(tStart))
-}
-
 // ObserveOffRampNextSeqNums observes the next sequence numbers for each source chain from the OffRamp.
 // Values are fetched from observers state which are fetched async.
 func (o *asyncObserver) ObserveOffRampNextSeqNums(_ context.Context) []plugintypes.SeqNumChain {
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -37,6 +38,8 @@ type Processor struct {
 	rmnHomeReader          readerpkg.RMNHome
 	metricsReporter        MetricsReporter
 	addressCodec           cciptypes.AddressCodec
+	// cadence is nil when no source chain has a merkle root batching policy.
+	cadence *commitCadence
 }
 
 // NewProcessor creates a new Processor
@@ -56,6 +59,7 @@ func NewProcessor(
 	rmnHomeReader readerpkg.RMNHome,
 	metricsReporter MetricsReporter,
 	addressCodec cciptypes.AddressCodec,
+	observationStoreCfg observationstore.Config,
 ) plugincommon.PluginProcessor[Query, Observation, Outcome] {
 	var observer Observer
 	baseObserver := newObserverImpl(
@@ -70,6 +74,7 @@ func NewProcessor(
 		observer = newAsyncObserver(
 			lggr,
 			baseObserver,
+			observationstore.NewCache[observationstore.Observations](lggr, observationStoreCfg, destChain, processorLabel),
 			offchainCfg.MerkleRootAsyncObserverSyncFreq,
 			offchainCfg.MerkleRootAsyncObserverSyncTimeout,
 		)
@@ -92,6 +97,7 @@ func NewProcessor(
 		rmnHomeReader:   rmnHomeReader,
 		metricsReporter: metricsReporter,
 		addressCodec:    addressCodec,
+		cadence:         newCommitCadence(lggr, offchainCfg.MerkleRootBatching, ccipReader, metricsReporter),
 	}
 	return plugincommon.NewTrackedProcessor(lggr, p, processorLabel, metricsReporter)
 }
@@ -1,6 +1,7 @@
 package merkleroot
 
 import (
+	"context"
 	"sort"
 	"time"
 
@@ -246,14 +247,24 @@ func (p processorState) String() string {
 // MetricsReporter exposes only relevant methods for reporting merkle roots from metrics.Reporter
 type MetricsReporter interface {
 	TrackRmnReport(latency float64, success bool)
-	TrackProcessorLatency(processor string, method string, latency time.Duration, err error)
+	TrackProcessorLatency(ctx context.Context, processor string, method string, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
+	// TrackMerkleRootDeferred is called for every round the merkle root of a source chain is deferred by its
+	// batching policy.
+	TrackMerkleRootDeferred(sourceChain cciptypes.ChainSelector)
+	// TrackMerkleRootReleased is called once per batch when the merkle root of a source chain is no longer deferred,
+	// with the number of pending messages and the time since they were first observed.
+	TrackMerkleRootReleased(sourceChain cciptypes.ChainSelector, pendingMessages uint64, latency time.Duration)
 }
 
 type NoopMetrics struct{}
 
 func (n NoopMetrics) TrackRmnReport(float64, bool) {}
 
-func (n NoopMetrics) TrackProcessorLatency(string, string, time.Duration, error) {}
+func (n NoopMetrics) TrackProcessorLatency(context.Context, string, string, time.Duration, error) {}
 
 func (n NoopMetrics) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
+
+func (n NoopMetrics) TrackMerkleRootDeferred(cciptypes.ChainSelector) {}
+
+func (n NoopMetrics) TrackMerkleRootReleased(cciptypes.ChainSelector, uint64, time.Duration) {}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -77,6 +79,31 @@ var (
 		},
 		[]string{"method", "nodeID", "error"},
 	)
+	promMerkleRootDeferredRounds = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_commit_merkle_root_deferred_rounds",
+			Help: "This metric tracks the number of rounds a merkle root was deferred by the batching policy of its " +
+				"source chain, i.e. the commits saved",
+		},
+		[]string{"chainID", "sourceChain"},
+	)
+	promMerkleRootBatchLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ccip_commit_merkle_root_batch_latency_seconds",
+			Help: "This metric tracks the time pending messages waited before their merkle root was no longer " +
+				"deferred by the batching policy",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"chainID", "sourceChain"},
+	)
+	promMerkleRootBatchSize = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_commit_merkle_root_batch_size",
+			Help:    "This metric tracks the number of pending messages when a batched merkle root is released",
+			Buckets: []float64{1, 2, 5, 10, 25, 50, 100, 256},
+		},
+		[]string{"chainID", "sourceChain"},
+	)
 )
 
 type PromReporter struct {
@@ -89,6 +116,9 @@ type PromReporter struct {
 	processorOutputCounter            *prometheus.CounterVec
 	processorErrors                   *prometheus.CounterVec
 	sequenceNumbers                   *prometheus.GaugeVec
+	merkleRootDeferredRounds          *prometheus.CounterVec
+	merkleRootBatchLatency            *prometheus.HistogramVec
+	merkleRootBatchSize               *prometheus.HistogramVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -109,6 +139,10 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		processorLatencyHistogram: promProcessorLatencyHistogram,
 		processorOutputCounter:    promProcessorOutputCounter,
 		processorErrors:           promProcessorErrors,
+
+		merkleRootDeferredRounds: promMerkleRootDeferredRounds,
+		merkleRootBatchLatency:   promMerkleRootBatchLatency,
+		merkleRootBatchSize:      promMerkleRootBatchSize,
 	}, nil
 }
 
@@ -169,6 +203,7 @@ func (p *PromReporter) TrackRmnRequest(method string, latency float64, nodeID ui
 }
 
 func (p *PromReporter) TrackProcessorLatency(
+	ctx context.Context,
 	processor string,
 	method plugincommon.MethodType,
 	latency time.Duration,
@@ -181,9 +216,9 @@ func (p *PromReporter) TrackProcessorLatency(
 		return
 	}
 
-	p.processorLatencyHistogram.
-		WithLabelValues(p.chainID, processor, method).
-		Observe(float64(latency))
+	tracing.ObserveWithExemplar(ctx,
+		p.processorLatencyHistogram.WithLabelValues(p.chainID, processor, method),
+		float64(latency))
 }
 
 func (p *PromReporter) TrackProcessorOutput(
@@ -197,3 +232,28 @@ func (p *PromReporter) TrackProcessorOutput(
 			Add(float64(val))
 	}
 }
+
+func (p *PromReporter) TrackMerkleRootDeferred(sourceChainSelector cciptypes.ChainSelector) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.merkleRootDeferredRounds.WithLabelValues(p.chainID, sourceChain).Inc()
+}
+
+func (p *PromReporter) TrackMerkleRootReleased(
+	sourceChainSelector cciptypes.ChainSelector,
+	pendingMessages uint64,
+	latency time.Duration,
+) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.merkleRootBatchLatency.WithLabelValues(p.chainID, sourceChain).Observe(latency.Seconds())
+	p.merkleRootBatchSize.WithLabelValues(p.chainID, sourceChain).Observe(float64(pendingMessages))
+}
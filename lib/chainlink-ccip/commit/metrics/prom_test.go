@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
@@ -377,7 +378,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 		processor := "merkle"
 		method := "query"
 
-		reporter.TrackProcessorLatency(processor, method, time.Second, nil)
+		reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, nil)
 		l1 := internal.CounterFromHistogramByLabels(t, reporter.processorLatencyHistogram, chainID, processor, method)
 		require.Equal(t, 1, l1)
 
@@ -393,7 +394,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 
 		passCounter := 10
 		for i := 0; i < passCounter; i++ {
-			reporter.TrackProcessorLatency(processor, method, time.Second, nil)
+			reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, nil)
 		}
 		l2 := internal.CounterFromHistogramByLabels(t, reporter.processorLatencyHistogram, chainID, processor, method)
 		require.Equal(t, passCounter, l2)
@@ -405,7 +406,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 
 		errCounter := 5
 		for i := 0; i < errCounter; i++ {
-			reporter.TrackProcessorLatency(processor, method, time.Second, fmt.Errorf("error"))
+			reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, fmt.Errorf("error"))
 		}
 		errs := testutil.ToFloat64(
 			reporter.processorErrors.WithLabelValues(chainID, processor, method),
@@ -549,3 +550,22 @@ func cleanupMetrics(reporter *PromReporter) func() {
 		reporter.processorLatencyHistogram.Reset()
 	}
 }
+
+func Test_MerkleRootBatching(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+
+	sourceChain := "3337"
+	sourceSelector := cciptypes.ChainSelector(4793464827907405086)
+
+	reporter.TrackMerkleRootDeferred(sourceSelector)
+	reporter.TrackMerkleRootDeferred(sourceSelector)
+	deferred := testutil.ToFloat64(reporter.merkleRootDeferredRounds.WithLabelValues(chainID, sourceChain))
+	require.Equal(t, float64(2), deferred)
+
+	reporter.TrackMerkleRootReleased(sourceSelector, 10, time.Minute)
+	latency := internal.CounterFromHistogramByLabels(t, reporter.merkleRootBatchLatency, chainID, sourceChain)
+	require.Equal(t, 1, latency)
+	size := internal.CounterFromHistogramByLabels(t, reporter.merkleRootBatchSize, chainID, sourceChain)
+	require.Equal(t, 1, size)
+}
@@ -1,12 +1,14 @@
 package metrics
 
 import (
+	"context"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the commit plugin.
@@ -25,8 +27,12 @@ type Reporter interface {
 	TrackRmnReport(latency float64, success bool)
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
 
-	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackProcessorLatency(
+		ctx context.Context, processor string, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
+
+	TrackMerkleRootDeferred(sourceChain cciptypes.ChainSelector)
+	TrackMerkleRootReleased(sourceChain cciptypes.ChainSelector, pendingMessages uint64, latency time.Duration)
 }
 
 type CommitPluginReporter interface {
@@ -44,10 +50,17 @@ func (n *Noop) TrackRmnReport(float64, bool) {}
 
 func (n *Noop) TrackRmnRequest(string, float64, uint64, string) {}
 
-func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
+func (n *Noop) TrackProcessorLatency(
+	context.Context, string, plugincommon.MethodType, time.Duration, error,
+) {
+}
 
 func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
 
+func (n *Noop) TrackMerkleRootDeferred(cciptypes.ChainSelector) {}
+
+func (n *Noop) TrackMerkleRootReleased(cciptypes.ChainSelector, uint64, time.Duration) {}
+
 var _ Reporter = &PromReporter{}
 var _ CommitPluginReporter = &PromReporter{}
 var _ merkleroot.MetricsReporter = &PromReporter{}
@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
@@ -85,6 +86,7 @@ func NewPlugin(
 	reporter metrics.Reporter,
 	addressCodec cciptypes.AddressCodec,
 	reportBuilder builder.ReportBuilderFunc,
+	observationStoreCfg observationstore.Config,
 ) *Plugin {
 	lggr.Infow("creating new plugin instance", "p2pID", oracleIDToP2pID[reportingCfg.OracleID])
 
@@ -129,6 +131,7 @@ func NewPlugin(
 		rmnHomeReader,
 		reporter,
 		addressCodec,
+		observationStoreCfg,
 	)
 
 	tokenPriceProcessor := tokenprice.NewProcessor(
@@ -141,6 +144,7 @@ func NewPlugin(
 		homeChain,
 		reportingCfg.F,
 		reporter,
+		observationStoreCfg,
 	)
 
 	discoveryProcessor := discovery.NewContractDiscoveryProcessor(
@@ -163,6 +167,7 @@ func NewPlugin(
 		chainSupport,
 		reportingCfg.F,
 		reporter,
+		observationStoreCfg,
 	)
 
 	return &Plugin{
@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
+	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// NewReplayPlugin builds a commit plugin to replay a recorded round with roundrecorder.Replay. The plugin is
+// configured as it was when the round was recorded and its readers are mocked from the snapshot. RMN and the token
+// price readers are not set, they are only used in the query and observation phases.
+func NewReplayPlugin(
+	lggr logger.Logger,
+	round roundrecorder.Round,
+	snapshot roundrecorder.Snapshot,
+	reportCodec cciptypes.CommitPluginCodec,
+	msgHasher cciptypes.MessageHasher,
+	addrCodec cciptypes.AddressCodec,
+) (ocr3types.ReportingPlugin[[]byte], error) {
+	if round.Plugin != roundrecorder.PluginCommit {
+		return nil, fmt.Errorf("round %d was recorded by the %s plugin", round.SeqNr, round.Plugin)
+	}
+
+	reportingCfg, err := round.ReportingPluginConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	offchainCfg, err := pluginconfig.DecodeCommitOffchainConfig(round.OffchainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("decode commit offchain config: %w", err)
+	}
+
+	reportBuilder, err := builder.NewReportBuilder(
+		offchainCfg.RMNEnabled,
+		offchainCfg.MaxMerkleRootsPerReport,
+		offchainCfg.MaxPricesPerReport,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report builder: %w", err)
+	}
+
+	return NewPlugin(
+		plugintypes.DonID(snapshot.DonID),
+		snapshot.Oracles,
+		offchainCfg,
+		snapshot.DestChain,
+		snapshot.CCIPReader(reportingCfg.ConfigDigest),
+		nil,
+		reportCodec,
+		msgHasher,
+		lggr,
+		snapshot.HomeChain(),
+		nil,
+		nil,
+		nil,
+		reportingCfg,
+		&metrics.Noop{},
+		addrCodec,
+		reportBuilder,
+		observationstore.Config{},
+	), nil
+}
@@ -0,0 +1,113 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func TestNewReplayPlugin_ReproducesRecordedRound(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+
+	snapshot := roundrecorder.Snapshot{
+		DonID:     1,
+		DestChain: destChain,
+		Oracles:   map[commontypes.OracleID]libocrtypes.PeerID{},
+		Chains: map[ccipocr3.ChainSelector]roundrecorder.ChainSnapshot{
+			destChain:       {FChain: 1, SupportedNodes: peerIDs},
+			sourceEvmChain1: {FChain: 1, SupportedNodes: peerIDs},
+		},
+	}
+	for i, oracleID := range oracleIDs {
+		snapshot.Oracles[oracleID] = peerIDs[i]
+	}
+
+	offchainCfg := pluginconfig.CommitOffchainConfig{}
+	require.NoError(t, offchainCfg.ApplyDefaultsAndValidate())
+	encodedOffchainCfg, err := pluginconfig.EncodeCommitOffchainConfig(offchainCfg)
+	require.NoError(t, err)
+	reportingCfg := ocr3types.ReportingPluginConfig{
+		ConfigDigest:   types.ConfigDigest{1},
+		OracleID:       oracleIDs[0],
+		N:              len(oracleIDs),
+		F:              1,
+		OffchainConfig: encodedOffchainCfg,
+	}
+
+	newPlugin := func(round roundrecorder.Round) ocr3types.ReportingPlugin[[]byte] {
+		p, err := NewReplayPlugin(lggr, round, snapshot,
+			mocks.NewCommitPluginJSONReportCodec(), mocks.NewMessageHasher(), internal.NewMockAddressCodecHex(t))
+		require.NoError(t, err)
+		return p
+	}
+
+	// record a round with a plugin built from the recorded config, as a node would
+	sink := &roundrecorder.MemorySink{}
+	recorder := roundrecorder.NewPlugin(
+		newPlugin(roundrecorder.Round{
+			Plugin:         roundrecorder.PluginCommit,
+			ConfigDigest:   reportingCfg.ConfigDigest.Hex(),
+			OracleID:       reportingCfg.OracleID,
+			N:              reportingCfg.N,
+			F:              reportingCfg.F,
+			OffchainConfig: reportingCfg.OffchainConfig,
+		}),
+		lggr, sink, roundrecorder.PluginCommit, reportingCfg)
+
+	obs, err := ocrTypCodec.EncodeObservation(committypes.Observation{
+		FChain: map[ccipocr3.ChainSelector]int{destChain: 1, sourceEvmChain1: 1},
+	})
+	require.NoError(t, err)
+	aos := make([]types.AttributedObservation, 0, len(oracleIDs))
+	for _, oracleID := range oracleIDs {
+		aos = append(aos, types.AttributedObservation{Observation: obs, Observer: oracleID})
+	}
+
+	outctx := ocr3types.OutcomeContext{SeqNr: 2}
+	outcome, err := recorder.Outcome(ctx, outctx, nil, aos)
+	require.NoError(t, err)
+	_, err = recorder.Reports(ctx, outctx.SeqNr, outcome)
+	require.NoError(t, err)
+
+	rounds := sink.Rounds()
+	require.Len(t, rounds, 1)
+	recorded := rounds[0]
+
+	replayed, err := roundrecorder.Replay(ctx, newPlugin(recorded), recorded)
+	require.NoError(t, err)
+	d, err := roundrecorder.Diff(recorded, replayed, roundrecorder.CommitOutcomeDecoder)
+	require.NoError(t, err)
+	require.Empty(t, d)
+
+	// a different previous outcome state must show up in the diff
+	prev, err := ocrTypCodec.EncodeOutcome(committypes.Outcome{
+		MainOutcome: committypes.MainOutcome{InflightPriceOcrSequenceNumber: 1, RemainingPriceChecks: 1},
+	})
+	require.NoError(t, err)
+	changed := recorded
+	changed.PreviousOutcome = prev
+	replayed, err = roundrecorder.Replay(ctx, newPlugin(changed), changed)
+	require.NoError(t, err)
+	d, err = roundrecorder.Diff(recorded, replayed, roundrecorder.CommitOutcomeDecoder)
+	require.NoError(t, err)
+	require.NotEmpty(t, d)
+
+	_, err = NewReplayPlugin(lggr, roundrecorder.Round{Plugin: roundrecorder.PluginExecute}, snapshot,
+		mocks.NewCommitPluginJSONReportCodec(), mocks.NewMessageHasher(), internal.NewMockAddressCodecHex(t))
+	require.Error(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/asynclib"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	pkgreader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -181,6 +182,7 @@ type asyncObserver struct {
 	lggr       logger.Logger
 	base       *baseObserver
 	cancelFunc func()
+	syncer     *observationstore.Syncer
 	mu         sync.RWMutex
 
 	// cached values, only ever read thru mutex.
@@ -188,9 +190,12 @@ type asyncObserver struct {
 	tokenUpdates  map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig
 }
 
+// newAsyncObserver creates a new asyncObserver, starting with the values persisted by the cache if they are still
+// fresh.
 func newAsyncObserver(
 	lggr logger.Logger,
 	base *baseObserver,
+	cache *observationstore.Cache[observationstore.Observations],
 	tickDuration, syncTimeout time.Duration,
 ) *asyncObserver {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -200,6 +205,32 @@ func newAsyncObserver(
 		base: base,
 		mu:   sync.RWMutex{},
 	}
+	obs.syncer = observationstore.NewSyncer(obs.lggr, cache,
+		observationstore.NewSyncOp("feedTokenPrices",
+			func(ctx context.Context) cciptypes.TokenPriceMap {
+				return obs.base.observeFeedTokenPrices(ctx, obs.lggr)
+			},
+			func(tokenPriceMap cciptypes.TokenPriceMap) {
+				obs.mu.Lock()
+				obs.tokenPriceMap = tokenPriceMap
+				obs.mu.Unlock()
+			},
+		),
+		observationstore.NewSyncOp("feeQuoterTokenUpdates",
+			func(ctx context.Context) map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig {
+				return obs.base.observeFeeQuoterTokenUpdates(ctx, obs.lggr)
+			},
+			func(tokenUpdates map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig) {
+				obs.mu.Lock()
+				obs.tokenUpdates = tokenUpdates
+				obs.mu.Unlock()
+			},
+		),
+	)
+
+	ctxLoad, cancelLoad := context.WithTimeout(ctx, syncTimeout)
+	obs.syncer.Restore(ctxLoad)
+	cancelLoad()
 
 	ticker := time.NewTicker(tickDuration)
 	lggr.Debugw("async tokenprice observer started", "tickDuration", tickDuration, "syncTimeout", syncTimeout)
@@ -220,60 +251,13 @@ func (a *asyncObserver) start(ctx context.Context, tickerC <-chan time.Time, syn
 			case <-ctx.Done():
 				return
 			case <-tickerC:
-				a.sync(ctx, syncTimeout)
+				a.lggr.Debugw("async tokenprice observer is syncing")
+				a.syncer.Sync(ctx, syncTimeout)
 			}
 		}
 	}()
 }
 
-func (a *asyncObserver) sync(ctx context.Context, syncTimeout time.Duration) {
-	a.lggr.Debugw("async tokenprice observer is syncing")
-	ctxSync, cancel := context.WithTimeout(ctx, syncTimeout)
-	defer cancel()
-
-	syncOps := []struct {
-		id string
-		op func(context.Context)
-	}{
-		{
-			id: "feedTokenPrices",
-			op: func(ctx context.Context) {
-				tokenPriceMap := a.base.observeFeedTokenPrices(ctx, a.lggr)
-				a.mu.Lock()
-				a.tokenPriceMap = tokenPriceMap
-				a.mu.Unlock()
-			},
-		},
-		{
-			id: "feeQuoterTokenUpdates",
-			op: func(ctx context.Context) {
-				tokenUpdates := a.base.observeFeeQuoterTokenUpdates(ctx, a.lggr)
-				a.mu.Lock()
-				a.tokenUpdates = tokenUpdates
-				a.mu.Unlock()
-			},
-		},
-	}
-
-	wg := &sync.WaitGroup{}
-	wg.Add(len(syncOps))
-	for _, op := range syncOps {
-		go a.applySyncOp(ctxSync, op.id, wg, op.op)
-	}
-	wg.Wait()
-}
-
-// applySyncOp applies the given operation synchronously.
-func (a *asyncObserver) applySyncOp(
-	ctx context.Context, id string, wg *sync.WaitGroup, op func(ctx context.Context)) {
-	defer wg.Done()
-	tStart := time.Now()
-	a.lggr.Debugw("async observer applying sync operation", "id", id)
-	op(ctx)
-	a.lggr.Debugw("async observer has applied the sync operation",
-		"id", id, "duration", time.Since(tStart))
-}
-
 // observeFeeQuoterTokenUpdates implements observer by returning the cached tokenUpdates.
 func (a *asyncObserver) observeFeeQuoterTokenUpdates(
 	ctx context.Context,
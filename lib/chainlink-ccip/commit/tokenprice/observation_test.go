@@ -19,6 +19,7 @@ import (
 	common_mock "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/plugincommon"
 	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
 	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
@@ -90,6 +91,7 @@ func Test_Observation(t *testing.T) {
 					homeChain,
 					f,
 					plugincommon.NoopReporter{},
+					observationstore.Config{},
 				)
 			},
 			expObs: Observation{
@@ -142,6 +144,7 @@ func Test_Observation(t *testing.T) {
 					homeChain,
 					f,
 					plugincommon.NoopReporter{},
+					observationstore.Config{},
 				)
 			},
 			expObs: Observation{
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/observationstore"
 	pkgreader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -39,6 +40,7 @@ func NewProcessor(
 	homeChain reader.HomeChain,
 	fRoleDON int,
 	metricsReporter plugincommon.MetricsReporter,
+	observationStoreCfg observationstore.Config,
 ) plugincommon.PluginProcessor[Query, Observation, Outcome] {
 	var obs observer
 	baseObs := newBaseObserver(
@@ -52,6 +54,7 @@ func NewProcessor(
 		obs = newAsyncObserver(
 			lggr,
 			baseObs,
+			observationstore.NewCache[observationstore.Observations](lggr, observationStoreCfg, destChain, processorsLabel),
 			offChainCfg.TokenPriceAsyncObserverSyncFreq.Duration(),
 			offChainCfg.TokenPriceAsyncObserverSyncTimeout.Duration(),
 		)
@@ -2,6 +2,7 @@ package exectypes
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/exp/maps"
 
@@ -108,6 +109,29 @@ type NonceObservations map[cciptypes.ChainSelector]map[string]uint64
 //     specific tokendata.TokenDataObserver will be used to populate the TokenData slice.
 type TokenDataObservations map[cciptypes.ChainSelector]map[cciptypes.SeqNum]MessageTokenData
 
+// FeeTokenPrices contain the USD prices of the fee tokens of previously observed messages, organized by source chain
+// selector and hex encoded fee token address. Fee tokens are priced by the FeeQuoter of their source chain.
+type FeeTokenPrices map[cciptypes.ChainSelector]map[string]cciptypes.BigInt
+
+// ExecCostObservation contains the prices used to compare the fee paid by a message with the cost of executing it on
+// the destination chain. Prices that could not be read are left empty.
+type ExecCostObservation struct {
+	// DestExecutionFee is the price of a unit of gas and DestDataAvailabilityFee the price of a unit of data
+	// availability gas, both in the smallest denomination of the destination native token.
+	DestExecutionFee        cciptypes.BigInt `json:"destExecutionFee"`
+	DestDataAvailabilityFee cciptypes.BigInt `json:"destDataAvailabilityFee"`
+	// DestNativePriceUSD is the USD price of 1e18 units of the destination native token, with 18 decimals.
+	DestNativePriceUSD cciptypes.BigInt `json:"destNativePriceUSD"`
+	// FeeTokenPrices are the USD prices of 1e18 units of the fee tokens, with 18 decimals.
+	FeeTokenPrices FeeTokenPrices `json:"feeTokenPrices"`
+	// DAGasConfigs are the data availability gas configs of the destination chain in the FeeQuoter of each source
+	// chain, they convert the size of a message into data availability gas.
+	DAGasConfigs map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig `json:"daGasConfigs"`
+	// Timestamp is the time of the observation. The consensus timestamp is used instead of the local clock to compute
+	// how long messages have been waiting, so that all nodes boost their fees identically.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // Observation is the observation of the ExecutePlugin.
 // TODO: revisit observation types. The maps used here are more space efficient and easier to work
 // with but require more transformations compared to the on-chain representations.
@@ -130,6 +154,10 @@ type Observation struct {
 	// It contains the nonces of senders who are being considered for the final report.
 	Nonces NonceObservations `json:"nonces"`
 
+	// ExecCost is determined during the third phase of execute when the fee check is enabled.
+	// It contains the prices needed to check that messages pay for their execution.
+	ExecCost ExecCostObservation `json:"execCost"`
+
 	// Contracts are part of the initial discovery phase which runs to initialize the CCIP Reader.
 	Contracts dt.Observation `json:"contracts"`
 
@@ -150,6 +178,7 @@ func (o Observation) ToLogFormat() Observation {
 		Hashes:        o.Hashes,
 		TokenData:     o.TokenData,
 		Nonces:        o.Nonces,
+		ExecCost:      o.ExecCost,
 		FChain:        o.FChain,
 		Messages:      msgsWithEmptyData,
 		Contracts:     dt.Observation{},
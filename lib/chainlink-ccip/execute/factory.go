@@ -21,6 +21,8 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
@@ -80,6 +82,7 @@ type PluginFactory struct {
 	tokenDataEncoder cciptypes.TokenDataEncoder
 	contractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	chainWriters     map[cciptypes.ChainSelector]types.ContractWriter
+	roundRecorder    roundrecorder.Sink
 }
 
 type PluginFactoryParams struct {
@@ -94,6 +97,8 @@ type PluginFactoryParams struct {
 	EstimateProvider cciptypes.EstimateProvider
 	ContractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	ContractWriters  map[cciptypes.ChainSelector]types.ContractWriter
+	// RoundRecorder is optional, when set every round is recorded for later replay.
+	RoundRecorder roundrecorder.Sink
 }
 
 // NewExecutePluginFactory creates a new PluginFactory instance. For execute plugin, oracle instances are not managed by
@@ -111,6 +116,7 @@ func NewExecutePluginFactory(params PluginFactoryParams) *PluginFactory {
 		tokenDataEncoder: params.TokenDataEncoder,
 		contractReaders:  params.ContractReaders,
 		chainWriters:     params.ContractWriters,
+		roundRecorder:    params.RoundRecorder,
 	}
 }
 
@@ -154,6 +160,7 @@ func (p PluginFactory) NewReportingPlugin(
 		p.ocrConfig.Config.ChainSelector,
 		p.ocrConfig.Config.OfframpAddress,
 		p.addrCodec,
+		offchainConfig.CCIPReaderCache,
 	)
 
 	tokenDataObserver, err := observer.NewConfigBasedCompositeObservers(
@@ -174,32 +181,38 @@ func (p PluginFactory) NewReportingPlugin(
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create metrics reporter: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			config,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			oracleIDToP2PID,
-			ccipReader,
-			p.execCodec,
-			p.msgHasher,
-			p.homeChainReader,
-			tokenDataObserver,
-			p.estimateProvider,
-			lggr,
-			metricsReporter,
-			p.addrCodec,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleExecute",
-			Limits: ocr3types.ReportingPluginLimits{
-				// No query for this execute implementation.
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	plugin := NewPlugin(
+		p.donID,
+		config,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		oracleIDToP2PID,
+		ccipReader,
+		p.execCodec,
+		p.msgHasher,
+		p.homeChainReader,
+		tokenDataObserver,
+		p.estimateProvider,
+		lggr,
+		metricsReporter,
+		p.addrCodec,
+	)
+	if p.roundRecorder != nil {
+		plugin = roundrecorder.NewPlugin(plugin, lggr, p.roundRecorder, roundrecorder.PluginExecute, config)
+	}
+	plugin = tracing.NewPlugin(plugin, "execute", config)
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleExecute",
+		Limits: ocr3types.ReportingPluginLimits{
+			// No query for this execute implementation.
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func (p PluginFactory) Name() string {
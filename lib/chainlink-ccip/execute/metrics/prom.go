@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -85,6 +87,13 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromExecUnderpaidMessages = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_underpaid_messages",
+			Help: "This metric tracks the number of messages waiting because their fee does not cover the execution cost",
+		},
+		[]string{"chainID", "sourceChain"},
+	)
 )
 
 type PromReporter struct {
@@ -98,6 +107,7 @@ type PromReporter struct {
 	sequenceNumbers           *prometheus.GaugeVec
 	processorLatencyHistogram *prometheus.HistogramVec
 	processorErrors           *prometheus.CounterVec
+	underpaidMessages         *prometheus.GaugeVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -116,6 +126,7 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		sequenceNumbers:           PromSequenceNumbers,
 		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
 		processorErrors:           PromExecProcessorErrors,
+		underpaidMessages:         PromExecUnderpaidMessages,
 	}, nil
 }
 
@@ -139,6 +150,7 @@ func (p *PromReporter) TrackOutcome(outcome exectypes.Outcome, state exectypes.P
 }
 
 func (p *PromReporter) TrackLatency(
+	ctx context.Context,
 	state exectypes.PluginState,
 	method plugincommon.MethodType,
 	latency time.Duration,
@@ -151,12 +163,13 @@ func (p *PromReporter) TrackLatency(
 		return
 	}
 
-	p.latencyHistogram.
-		WithLabelValues(p.chainID, method, string(state)).
-		Observe(float64(latency))
+	tracing.ObserveWithExemplar(ctx,
+		p.latencyHistogram.WithLabelValues(p.chainID, method, string(state)),
+		float64(latency))
 }
 
 func (p *PromReporter) TrackProcessorLatency(
+	ctx context.Context,
 	processor string,
 	method plugincommon.MethodType,
 	latency time.Duration,
@@ -169,9 +182,9 @@ func (p *PromReporter) TrackProcessorLatency(
 		return
 	}
 
-	p.processorLatencyHistogram.
-		WithLabelValues(p.chainID, processor, method).
-		Observe(float64(latency))
+	tracing.ObserveWithExemplar(ctx,
+		p.processorLatencyHistogram.WithLabelValues(p.chainID, processor, method),
+		float64(latency))
 }
 
 func (p *PromReporter) TrackProcessorOutput(
@@ -180,6 +193,18 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackUnderpaidMessages(sourceChainSelector cciptypes.ChainSelector, count int) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.underpaidMessages.
+		WithLabelValues(p.chainID, sourceChain).
+		Set(float64(count))
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
@@ -393,7 +394,7 @@ func Test_ExecLatency(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("single latency observation", func(t *testing.T) {
-		reporter.TrackLatency(exectypes.GetCommitReports, plugincommon.ObservationMethod, 100, nil)
+		reporter.TrackLatency(tests.Context(t), exectypes.GetCommitReports, plugincommon.ObservationMethod, 100, nil)
 		l1 := internal.CounterFromHistogramByLabels(t, reporter.latencyHistogram, chainID, "observation", "GetCommitReports")
 		require.Equal(t, 1, l1)
 
@@ -406,7 +407,7 @@ func Test_ExecLatency(t *testing.T) {
 	t.Run("multiple latency outcomes", func(t *testing.T) {
 		passCounter := 10
 		for i := 0; i < passCounter; i++ {
-			reporter.TrackLatency(exectypes.Filter, plugincommon.OutcomeMethod, time.Second, nil)
+			reporter.TrackLatency(tests.Context(t), exectypes.Filter, plugincommon.OutcomeMethod, time.Second, nil)
 		}
 		l2 := internal.CounterFromHistogramByLabels(t, reporter.latencyHistogram, chainID, "outcome", "Filter")
 		require.Equal(t, passCounter, l2)
@@ -415,7 +416,7 @@ func Test_ExecLatency(t *testing.T) {
 	t.Run("multiple latency observation with errors", func(t *testing.T) {
 		errCounter := 5
 		for i := 0; i < errCounter; i++ {
-			reporter.TrackLatency(exectypes.GetMessages, plugincommon.ObservationMethod, time.Second, fmt.Errorf("error"))
+			reporter.TrackLatency(tests.Context(t), exectypes.GetMessages, plugincommon.ObservationMethod, time.Second, fmt.Errorf("error"))
 		}
 		errs := testutil.ToFloat64(
 			reporter.execErrors.WithLabelValues(chainID, "observation", "GetMessages"),
@@ -432,7 +433,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 		processor := "discovery1"
 		method := "query"
 
-		reporter.TrackProcessorLatency(processor, method, time.Second, nil)
+		reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, nil)
 		l1 := internal.CounterFromHistogramByLabels(t, reporter.processorLatencyHistogram, chainID, processor, method)
 		require.Equal(t, 1, l1)
 
@@ -448,7 +449,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 
 		passCounter := 10
 		for i := 0; i < passCounter; i++ {
-			reporter.TrackProcessorLatency(processor, method, time.Second, nil)
+			reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, nil)
 		}
 		l2 := internal.CounterFromHistogramByLabels(t, reporter.processorLatencyHistogram, chainID, processor, method)
 		require.Equal(t, passCounter, l2)
@@ -460,7 +461,7 @@ func Test_LatencyAndErrors(t *testing.T) {
 
 		errCounter := 5
 		for i := 0; i < errCounter; i++ {
-			reporter.TrackProcessorLatency(processor, method, time.Second, fmt.Errorf("error"))
+			reporter.TrackProcessorLatency(tests.Context(t), processor, method, time.Second, fmt.Errorf("error"))
 		}
 		errs := testutil.ToFloat64(
 			reporter.processorErrors.WithLabelValues(chainID, processor, method),
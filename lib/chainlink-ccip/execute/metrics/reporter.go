@@ -1,11 +1,13 @@
 package metrics
 
 import (
+	"context"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the execution plugin.
@@ -16,9 +18,17 @@ import (
 type Reporter interface {
 	TrackObservation(obs exectypes.Observation, state exectypes.PluginState)
 	TrackOutcome(outcome exectypes.Outcome, state exectypes.PluginState)
-	TrackLatency(state exectypes.PluginState, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackLatency(
+		ctx context.Context,
+		state exectypes.PluginState,
+		method plugincommon.MethodType,
+		latency time.Duration,
+		err error,
+	)
 	TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable)
-	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackProcessorLatency(
+		ctx context.Context, processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackUnderpaidMessages(sourceChain cciptypes.ChainSelector, count int)
 }
 
 type Noop struct{}
@@ -27,11 +37,19 @@ func (n *Noop) TrackObservation(exectypes.Observation, exectypes.PluginState) {}
 
 func (n *Noop) TrackOutcome(exectypes.Outcome, exectypes.PluginState) {}
 
-func (n *Noop) TrackLatency(exectypes.PluginState, plugincommon.MethodType, time.Duration, error) {}
+func (n *Noop) TrackLatency(
+	context.Context, exectypes.PluginState, plugincommon.MethodType, time.Duration, error,
+) {
+}
 
 func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
 
-func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
+func (n *Noop) TrackProcessorLatency(
+	context.Context, string, plugincommon.MethodType, time.Duration, error,
+) {
+}
+
+func (n *Noop) TrackUnderpaidMessages(cciptypes.ChainSelector, int) {}
 
 var _ Reporter = &Noop{}
 var _ Reporter = &PromReporter{}
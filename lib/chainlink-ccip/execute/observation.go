@@ -487,6 +487,13 @@ func (p *Plugin) getFilterObservation(
 	if err != nil {
 		return exectypes.Observation{}, fmt.Errorf("unable to determine if the destination chain is supported: %w", err)
 	}
+	if p.offchainCfg.FeeCheckEnabled {
+		observation.ExecCost, err = p.getExecCostObservation(ctx, lggr, previousOutcome, supportsDest)
+		if err != nil {
+			return exectypes.Observation{}, fmt.Errorf("unable to get exec cost observation: %w", err)
+		}
+	}
+
 	// No observation for non-dest readers.
 	if !supportsDest {
 		return observation, nil
@@ -545,3 +552,84 @@ func (p *Plugin) getFilterObservation(
 	}
 	return observation, nil
 }
+
+// getExecCostObservation observes the prices used to check the fees of the messages in the previous outcome. Fee token
+// prices are read from the FeeQuoter of each supported source chain, the destination chain prices and the observation
+// timestamp only when the destination chain is supported. Prices that can't be read are left out of the observation.
+func (p *Plugin) getExecCostObservation(
+	ctx context.Context,
+	lggr logger.Logger,
+	previousOutcome exectypes.Outcome,
+	supportsDest bool,
+) (exectypes.ExecCostObservation, error) {
+	supportedChains, err := p.supportedChains(p.reportingCfg.OracleID)
+	if err != nil {
+		return exectypes.ExecCostObservation{}, fmt.Errorf("unable to get supported chains: %w", err)
+	}
+
+	feeTokens := make(map[cciptypes.ChainSelector]map[string]cciptypes.UnknownAddress)
+	for _, report := range previousOutcome.CommitReports {
+		if !supportedChains.Contains(report.SourceChain) {
+			continue
+		}
+		for _, msg := range report.Messages {
+			if msg.IsPseudoDeleted() || msg.FeeToken.IsZeroOrEmpty() {
+				continue
+			}
+			if _, ok := feeTokens[report.SourceChain]; !ok {
+				feeTokens[report.SourceChain] = make(map[string]cciptypes.UnknownAddress)
+			}
+			feeTokens[report.SourceChain][msg.FeeToken.String()] = msg.FeeToken
+		}
+	}
+
+	var execCost exectypes.ExecCostObservation
+	for chain, tokens := range feeTokens {
+		tokenAddrs := make([]cciptypes.UnknownAddress, 0, len(tokens))
+		for _, token := range tokens {
+			tokenAddrs = append(tokenAddrs, token)
+		}
+		prices := p.ccipReader.GetFeeTokenPricesUSD(ctx, chain, tokenAddrs)
+		if len(prices) == 0 {
+			continue
+		}
+		if execCost.FeeTokenPrices == nil {
+			execCost.FeeTokenPrices = make(exectypes.FeeTokenPrices)
+		}
+		execCost.FeeTokenPrices[chain] = prices
+
+		daGasConfig, err := p.ccipReader.GetDataAvailabilityGasConfig(ctx, chain)
+		if err != nil {
+			lggr.Warnw("unable to get data availability gas config", "chain", chain, "err", err)
+			continue
+		}
+		if execCost.DAGasConfigs == nil {
+			execCost.DAGasConfigs = make(map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig)
+		}
+		execCost.DAGasConfigs[chain] = daGasConfig
+	}
+
+	if !supportsDest {
+		return execCost, nil
+	}
+
+	feeComponents, err := p.ccipReader.GetDestChainFeeComponents(ctx)
+	if err != nil {
+		lggr.Warnw("unable to get dest chain fee components", "err", err)
+	} else {
+		if feeComponents.ExecutionFee != nil {
+			execCost.DestExecutionFee = cciptypes.NewBigInt(feeComponents.ExecutionFee)
+		}
+		if feeComponents.DataAvailabilityFee != nil {
+			execCost.DestDataAvailabilityFee = cciptypes.NewBigInt(feeComponents.DataAvailabilityFee)
+		}
+	}
+
+	nativePrices := p.ccipReader.GetWrappedNativeTokenPriceUSD(ctx, []cciptypes.ChainSelector{p.destChain})
+	if nativePrice, ok := nativePrices[p.destChain]; ok {
+		execCost.DestNativePriceUSD = nativePrice
+	}
+
+	execCost.Timestamp = time.Now().UTC()
+	return execCost, nil
+}
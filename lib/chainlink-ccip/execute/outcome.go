@@ -175,6 +175,11 @@ func (p *Plugin) getFilterOutcome(
 ) (exectypes.Outcome, error) {
 	commitReports := previousOutcome.CommitReports
 
+	underpaid := make(map[cciptypes.ChainSelector]int)
+	for _, cr := range commitReports {
+		underpaid[cr.SourceChain] = 0
+	}
+
 	builder := report.NewBuilder(
 		lggr,
 		p.msgHasher,
@@ -189,6 +194,7 @@ func (p *Plugin) getFilterOutcome(
 		report.WithExtraMessageCheck(report.CheckIfInflight(p.inflightMessageCache.IsInflight)),
 		report.WithMaxMessages(p.offchainCfg.MaxReportMessages),
 		report.WithMaxSingleChainReports(p.offchainCfg.MaxSingleChainReports),
+		p.feeCheckOption(observation.ExecCost, underpaid),
 	)
 
 	outcomeReports, selectedCommitReports, err := selectReport(
@@ -200,6 +206,12 @@ func (p *Plugin) getFilterOutcome(
 		return exectypes.Outcome{}, fmt.Errorf("unable to select report: %w", err)
 	}
 
+	if p.offchainCfg.FeeCheckEnabled {
+		for sourceChain, count := range underpaid {
+			p.observer.TrackUnderpaidMessages(sourceChain, count)
+		}
+	}
+
 	execReport := cciptypes.ExecutePluginReport{
 		ChainReports: outcomeReports,
 	}
@@ -208,3 +220,24 @@ func (p *Plugin) getFilterOutcome(
 	// TODO: sort in the encoder.
 	return exectypes.NewOutcome(exectypes.Filter, selectedCommitReports, execReport), nil
 }
+
+// feeCheckOption returns the fee check for the report builder, or nil when it is disabled. The check only depends on
+// the consensus exec cost observation so that all nodes skip the same messages. Skipped messages are counted per
+// source chain in underpaid.
+func (p *Plugin) feeCheckOption(
+	execCost exectypes.ExecCostObservation,
+	underpaid map[cciptypes.ChainSelector]int,
+) report.Option {
+	if !p.offchainCfg.FeeCheckEnabled {
+		return nil
+	}
+
+	return report.WithExtraMessageCheck(report.CheckFees(
+		execCost,
+		p.estimateProvider,
+		report.FeeBoostConfig{RelativeBoostPerWaitHour: p.offchainCfg.RelativeBoostPerWaitHour},
+		func(sourceChain cciptypes.ChainSelector, _ cciptypes.Message) {
+			underpaid[sourceChain]++
+		},
+	))
+}
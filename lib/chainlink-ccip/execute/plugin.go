@@ -392,6 +392,10 @@ func (p *Plugin) ValidateObservation(
 		return err
 	}
 
+	if err = validateExecCostReadingEligibility(supportedChains, p.destChain, decodedObservation.ExecCost); err != nil {
+		return fmt.Errorf("validate exec cost reading eligibility: %w", err)
+	}
+
 	// check message related validations when states can contain messages
 	if nextState == exectypes.GetMessages || nextState == exectypes.Filter {
 		if err = validateMsgsReadingEligibility(supportedChains, decodedObservation.Messages); err != nil {
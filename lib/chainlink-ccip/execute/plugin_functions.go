@@ -1,6 +1,7 @@
 package execute
 
 import (
+	"cmp"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -756,6 +757,138 @@ func computeNoncesConsensus(
 	return consensusNonces
 }
 
+// validateExecCostReadingEligibility validates that the observer can read the chains of the observed exec cost prices.
+func validateExecCostReadingEligibility(
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+	destChain cciptypes.ChainSelector,
+	execCost exectypes.ExecCostObservation,
+) error {
+	observesDest := !execCost.DestExecutionFee.IsEmpty() || !execCost.DestDataAvailabilityFee.IsEmpty() ||
+		!execCost.DestNativePriceUSD.IsEmpty() || !execCost.Timestamp.IsZero()
+	if observesDest && !supportedChains.Contains(destChain) {
+		return fmt.Errorf("observer not allowed to read dest chain %d exec cost prices", destChain)
+	}
+	for chainSel := range execCost.FeeTokenPrices {
+		if !supportedChains.Contains(chainSel) {
+			return fmt.Errorf("observer not allowed to read fee token prices from chain %d", chainSel)
+		}
+	}
+	for chainSel := range execCost.DAGasConfigs {
+		if !supportedChains.Contains(chainSel) {
+			return fmt.Errorf("observer not allowed to read data availability gas config from chain %d", chainSel)
+		}
+	}
+	return nil
+}
+
+// computeExecCostConsensus takes the median of the observed exec cost prices and timestamps. Destination chain values
+// require fChainDest+1 observations and fee token prices fChain+1 observations of their source chain. Values without
+// enough observations are left empty, which disables the fee check of the affected messages.
+func computeExecCostConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	fChain map[cciptypes.ChainSelector]int,
+	destChain cciptypes.ChainSelector,
+) exectypes.ExecCostObservation {
+	type chainTokenPair struct {
+		chain cciptypes.ChainSelector
+		token string
+	}
+
+	var execFees, daFees, nativePrices []cciptypes.BigInt
+	var timestamps []time.Time
+	observedFeeTokenPrices := make(map[chainTokenPair][]cciptypes.BigInt)
+	observedDAGasConfigs := make(map[cciptypes.ChainSelector][]cciptypes.DataAvailabilityGasConfig)
+	for _, ao := range observations {
+		obs := ao.Observation.ExecCost
+		if !obs.DestExecutionFee.IsEmpty() {
+			execFees = append(execFees, obs.DestExecutionFee)
+		}
+		if !obs.DestDataAvailabilityFee.IsEmpty() {
+			daFees = append(daFees, obs.DestDataAvailabilityFee)
+		}
+		if !obs.DestNativePriceUSD.IsEmpty() {
+			nativePrices = append(nativePrices, obs.DestNativePriceUSD)
+		}
+		if !obs.Timestamp.IsZero() {
+			timestamps = append(timestamps, obs.Timestamp)
+		}
+		for chain, prices := range obs.FeeTokenPrices {
+			for token, price := range prices {
+				if !price.IsEmpty() {
+					pair := chainTokenPair{chain: chain, token: token}
+					observedFeeTokenPrices[pair] = append(observedFeeTokenPrices[pair], price)
+				}
+			}
+		}
+		for chain, cfg := range obs.DAGasConfigs {
+			observedDAGasConfigs[chain] = append(observedDAGasConfigs[chain], cfg)
+		}
+	}
+
+	execCost := exectypes.ExecCostObservation{}
+	destThresh := int(consensus.FPlus1(fChain[destChain]))
+	if len(execFees) >= destThresh {
+		execCost.DestExecutionFee = consensus.Median(execFees, consensus.BigIntComparator)
+	}
+	if len(daFees) >= destThresh {
+		execCost.DestDataAvailabilityFee = consensus.Median(daFees, consensus.BigIntComparator)
+	}
+	if len(nativePrices) >= destThresh {
+		execCost.DestNativePriceUSD = consensus.Median(nativePrices, consensus.BigIntComparator)
+	}
+	if len(timestamps) >= destThresh {
+		execCost.Timestamp = consensus.TimestampsMedian(timestamps)
+	}
+
+	for pair, prices := range observedFeeTokenPrices {
+		f, ok := fChain[pair.chain]
+		if !ok || len(prices) < int(consensus.FPlus1(f)) {
+			lggr.Debugw("no consensus on fee token price",
+				"chain", pair.chain, "token", pair.token, "prices", prices)
+			continue
+		}
+		if execCost.FeeTokenPrices == nil {
+			execCost.FeeTokenPrices = make(exectypes.FeeTokenPrices)
+		}
+		if _, ok := execCost.FeeTokenPrices[pair.chain]; !ok {
+			execCost.FeeTokenPrices[pair.chain] = make(map[string]cciptypes.BigInt)
+		}
+		execCost.FeeTokenPrices[pair.chain][pair.token] = consensus.Median(prices, consensus.BigIntComparator)
+	}
+
+	for chain, cfgs := range observedDAGasConfigs {
+		f, ok := fChain[chain]
+		if !ok || len(cfgs) < int(consensus.FPlus1(f)) {
+			lggr.Debugw("no consensus on data availability gas config", "chain", chain, "configs", cfgs)
+			continue
+		}
+		if execCost.DAGasConfigs == nil {
+			execCost.DAGasConfigs = make(map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig)
+		}
+		execCost.DAGasConfigs[chain] = medianDAGasConfig(cfgs)
+	}
+
+	return execCost
+}
+
+// medianDAGasConfig takes the median of each data availability gas parameter.
+func medianDAGasConfig(cfgs []cciptypes.DataAvailabilityGasConfig) cciptypes.DataAvailabilityGasConfig {
+	overheads := make([]uint32, 0, len(cfgs))
+	perByte := make([]uint16, 0, len(cfgs))
+	multipliers := make([]uint16, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		overheads = append(overheads, cfg.DestDataAvailabilityOverheadGas)
+		perByte = append(perByte, cfg.DestGasPerDataAvailabilityByte)
+		multipliers = append(multipliers, cfg.DestDataAvailabilityMultiplierBps)
+	}
+	return cciptypes.DataAvailabilityGasConfig{
+		DestDataAvailabilityOverheadGas:   consensus.Median(overheads, cmp.Less[uint32]),
+		DestGasPerDataAvailabilityByte:    consensus.Median(perByte, cmp.Less[uint16]),
+		DestDataAvailabilityMultiplierBps: consensus.Median(multipliers, cmp.Less[uint16]),
+	}
+}
+
 // computeConsensusObservation aggregates multiple attributed observations to produce a single consensus observation.
 // The provided f is required for computing the consensus on fChain prior to computing the observation consensus.
 func computeConsensusObservation(
@@ -779,6 +912,7 @@ func computeConsensusObservation(
 		dt.Observation{},
 		computeMessageHashesConsensus(lggr, observations, fChain),
 	)
+	consensusObservation.ExecCost = computeExecCostConsensus(lggr, observations, fChain, destChain)
 
 	lggr.Debugw("computeConsensusObservation has finished computing the consensus observation",
 		"fChain", fChain,
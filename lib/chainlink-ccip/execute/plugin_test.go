@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
 	"slices"
 	"sort"
 	"strings"
@@ -32,8 +33,11 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers/rand"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
@@ -44,6 +48,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	reader2 "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
 func genRandomChainReports(numReports, numMsgsPerReport int) []cciptypes.ExecutePluginReportSingleChain {
@@ -1246,6 +1251,107 @@ func TestPlugin_Outcome_MessagesMergeError(t *testing.T) {
 	require.Len(t, outcome, 0)
 }
 
+func TestPlugin_Outcome_FeeCheckIsDeterministic(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	srcChain, dstChain := cciptypes.ChainSelector(1), cciptypes.ChainSelector(2)
+	feeToken := cciptypes.UnknownAddress{0xfe}
+	usd := func(v int64) cciptypes.BigInt {
+		return cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(v), big.NewInt(1e18)))
+	}
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	// The execution of each message costs 2 USD, the first message pays 0.2 fee tokens (2 USD)
+	// and the second one only pays half of it.
+	msgHasher := mocks.NewMessageHasher()
+	fees := []int64{2e17, 1e17}
+	commitReport := exectypes.CommitData{
+		SourceChain:         srcChain,
+		SequenceNumberRange: cciptypes.NewSeqNumRange(1, cciptypes.SeqNum(len(fees))),
+		MessageTokenData:    make([]exectypes.MessageTokenData, len(fees)),
+		Timestamp:           now.Add(-time.Minute),
+	}
+	for i, fee := range fees {
+		msg := cciptypes.Message{
+			Header: cciptypes.RampMessageHeader{
+				MessageID:           rand.RandomBytes32(),
+				SourceChainSelector: srcChain,
+				DestChainSelector:   dstChain,
+				SequenceNumber:      cciptypes.SeqNum(i + 1),
+			},
+			FeeToken:       feeToken,
+			FeeTokenAmount: cciptypes.NewBigIntFromInt64(fee),
+		}
+		hash, err := msgHasher.Hash(ctx, msg)
+		require.NoError(t, err)
+		commitReport.Messages = append(commitReport.Messages, msg)
+		commitReport.Hashes = append(commitReport.Hashes, hash)
+	}
+	tree, err := report.ConstructMerkleTree(commitReport, lggr)
+	require.NoError(t, err)
+	commitReport.MerkleRoot = tree.Root()
+
+	previousOutcome, err := ocrTypeCodec.EncodeOutcome(
+		exectypes.NewOutcome(exectypes.GetMessages, []exectypes.CommitData{commitReport}, cciptypes.ExecutePluginReport{}))
+	require.NoError(t, err)
+
+	// The observed prices differ slightly between the oracles, the outcome uses their median.
+	var aos []types.AttributedObservation
+	for i := 0; i < 3; i++ {
+		obs, err := ocrTypeCodec.EncodeObservation(exectypes.Observation{
+			FChain: map[cciptypes.ChainSelector]int{srcChain: 1, dstChain: 1},
+			ExecCost: exectypes.ExecCostObservation{
+				DestExecutionFee:   cciptypes.NewBigIntFromInt64(int64(9e9 + i*1e9)),
+				DestNativePriceUSD: usd(int64(1999 + i)),
+				FeeTokenPrices: exectypes.FeeTokenPrices{
+					srcChain: {feeToken.String(): usd(int64(9 + i))},
+				},
+				Timestamp: now.Add(time.Duration(i) * time.Second),
+			},
+		})
+		require.NoError(t, err)
+		aos = append(aos, types.AttributedObservation{Observation: obs, Observer: commontypes.OracleID(i)})
+	}
+
+	newNode := func() *Plugin {
+		ep := codec_mocks.NewMockEstimateProvider(t)
+		ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(100_000)).Maybe()
+		ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+		return &Plugin{
+			reportingCfg: ocr3types.ReportingPluginConfig{F: 1},
+			offchainCfg: pluginconfig.ExecuteOffchainConfig{
+				BatchGasLimit:   100_000_000,
+				FeeCheckEnabled: true,
+			},
+			destChain: dstChain,
+			// The outcome must not read chain state, the mock fails the test on any call.
+			ccipReader:           readerpkg_mock.NewMockCCIPReader(t),
+			reportCodec:          mocks.NewExecutePluginJSONReportCodec(),
+			msgHasher:            msgHasher,
+			observer:             &metrics.Noop{},
+			estimateProvider:     ep,
+			lggr:                 lggr,
+			ocrTypeCodec:         ocrTypeCodec,
+			addrCodec:            internal.NewMockAddressCodecHex(t),
+			inflightMessageCache: cache.NewInflightMessageCache(time.Minute),
+		}
+	}
+
+	outctx := ocr3types.OutcomeContext{PreviousOutcome: previousOutcome}
+	outcome1, err := newNode().Outcome(ctx, outctx, nil, aos)
+	require.NoError(t, err)
+	outcome2, err := newNode().Outcome(ctx, outctx, nil, aos)
+	require.NoError(t, err)
+	require.Equal(t, outcome1, outcome2)
+
+	decoded, err := ocrTypeCodec.DecodeOutcome(outcome1)
+	require.NoError(t, err)
+	require.Equal(t, exectypes.Filter, decoded.State)
+	require.Len(t, decoded.Report.ChainReports, 1)
+	require.Len(t, decoded.Report.ChainReports[0].Messages, 1)
+	assert.Equal(t, cciptypes.SeqNum(1), decoded.Report.ChainReports[0].Messages[0].Header.SequenceNumber)
+}
+
 func TestPlugin_Reports_UnableToParse(t *testing.T) {
 	ctx := tests.Context(t)
 	p := &Plugin{
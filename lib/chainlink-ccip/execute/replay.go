@@ -0,0 +1,59 @@
+package execute
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/roundrecorder"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// NewReplayPlugin builds an execute plugin to replay a recorded round with roundrecorder.Replay. The plugin is
+// configured as it was when the round was recorded and its readers are mocked from the snapshot. Token data is only
+// observed, a noop observer is used.
+func NewReplayPlugin(
+	lggr logger.Logger,
+	round roundrecorder.Round,
+	snapshot roundrecorder.Snapshot,
+	reportCodec cciptypes.ExecutePluginCodec,
+	msgHasher cciptypes.MessageHasher,
+	addrCodec cciptypes.AddressCodec,
+) (ocr3types.ReportingPlugin[[]byte], error) {
+	if round.Plugin != roundrecorder.PluginExecute {
+		return nil, fmt.Errorf("round %d was recorded by the %s plugin", round.SeqNr, round.Plugin)
+	}
+
+	reportingCfg, err := round.ReportingPluginConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	offchainCfg, err := pluginconfig.DecodeExecuteOffchainConfig(round.OffchainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("decode execute offchain config: %w", err)
+	}
+
+	return NewPlugin(
+		plugintypes.DonID(snapshot.DonID),
+		reportingCfg,
+		offchainCfg,
+		snapshot.DestChain,
+		snapshot.Oracles,
+		snapshot.CCIPReader(reportingCfg.ConfigDigest),
+		reportCodec,
+		msgHasher,
+		snapshot.HomeChain(),
+		&observer.NoopTokenDataObserver{},
+		snapshot.EstimateProvider(),
+		lggr,
+		&metrics.Noop{},
+		addrCodec,
+	), nil
+}
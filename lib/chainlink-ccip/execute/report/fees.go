@@ -0,0 +1,131 @@
+package report
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var e18 = big.NewInt(1e18)
+
+// Message sizes used by the FeeQuoter to compute the data availability gas of a message, see Internal.sol.
+const (
+	messageFixedBytes         = 32 * 15
+	messageFixedBytesPerToken = 32 * (4 + (3 + 2))
+	bpsDivisor                = 1e4
+)
+
+// FeeBoostConfig configures how much the fee of a waiting message is boosted.
+type FeeBoostConfig struct {
+	// RelativeBoostPerWaitHour increases the fee of a message by this fraction of its fee per hour of waiting,
+	// slightly underpaid messages eventually get executed when the chain fees don't come down.
+	RelativeBoostPerWaitHour float64
+}
+
+// UnderpaidTracker is notified of every message skipped because of its fee.
+type UnderpaidTracker func(sourceChain ccipocr3.ChainSelector, msg ccipocr3.Message)
+
+// CheckFees skips messages whose fee, boosted by the time the message has been waiting, does not cover the cost of
+// executing it on the destination chain. The commit report timestamp is used as the send time of its messages and the
+// observation timestamp as the current time, so that the result only depends on the consensus observation.
+//
+// Messages are not checked when a price they depend on has no consensus, rather than stalling their execution.
+func CheckFees(
+	execCost exectypes.ExecCostObservation,
+	estimateProvider ccipocr3.EstimateProvider,
+	boost FeeBoostConfig,
+	onUnderpaid UnderpaidTracker,
+) Check {
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		if execCost.DestNativePriceUSD.IsEmpty() || execCost.DestExecutionFee.IsEmpty() || execCost.Timestamp.IsZero() {
+			return None, nil
+		}
+		feeTokenPrice, ok := execCost.FeeTokenPrices[report.SourceChain][msg.FeeToken.String()]
+		if !ok || feeTokenPrice.IsEmpty() {
+			lggr.Debugw("fee token price not available, skipping fee check",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"feeToken", msg.FeeToken.String())
+			return None, nil
+		}
+
+		waitTime := execCost.Timestamp.Sub(report.Timestamp)
+		fee := waitBoostedFee(waitTime, msgFeeUSD(msg, feeTokenPrice.Int), boost.RelativeBoostPerWaitHour)
+		cost := msgExecCostUSD(msg, report.SourceChain, estimateProvider, execCost)
+		if fee.Cmp(cost) >= 0 {
+			return None, nil
+		}
+
+		lggr.Infow("skipping message - insufficient fee",
+			"messageID", msg.Header.MessageID,
+			"sourceChain", report.SourceChain,
+			"seqNum", msg.Header.SequenceNumber,
+			"boostedFeeUSD", fee,
+			"execCostUSD", cost,
+			"waitTime", waitTime,
+			"messageState", InsufficientFee)
+		if onUnderpaid != nil {
+			onUnderpaid(report.SourceChain, msg)
+		}
+		return InsufficientFee, nil
+	}
+}
+
+// msgFeeUSD returns the fee paid by the message in USD with 18 decimals, feeTokenPrice is the USD price of 1e18 units
+// of the fee token with 18 decimals.
+func msgFeeUSD(msg ccipocr3.Message, feeTokenPrice *big.Int) *big.Int {
+	if msg.FeeTokenAmount.Int == nil {
+		return big.NewInt(0)
+	}
+	fee := new(big.Int).Mul(msg.FeeTokenAmount.Int, feeTokenPrice)
+	return fee.Div(fee, e18)
+}
+
+// msgExecCostUSD returns the cost of executing the message on the destination chain in USD with 18 decimals. The data
+// availability cost is left out when the DA gas config of the source chain has no consensus.
+func msgExecCostUSD(
+	msg ccipocr3.Message,
+	sourceChain ccipocr3.ChainSelector,
+	estimateProvider ccipocr3.EstimateProvider,
+	execCost exectypes.ExecCostObservation,
+) *big.Int {
+	gas := new(big.Int).SetUint64(estimateProvider.CalculateMessageMaxGas(msg))
+	cost := gas.Mul(gas, execCost.DestExecutionFee.Int)
+	if daGasConfig, ok := execCost.DAGasConfigs[sourceChain]; ok && !execCost.DestDataAvailabilityFee.IsEmpty() {
+		daCost := msgDataAvailabilityGas(msg, daGasConfig)
+		cost.Add(cost, daCost.Mul(daCost, execCost.DestDataAvailabilityFee.Int))
+	}
+	cost.Mul(cost, execCost.DestNativePriceUSD.Int)
+	return cost.Div(cost, e18)
+}
+
+// msgDataAvailabilityGas returns the data availability gas of the message as the FeeQuoter computes it, including the
+// multiplier. Token transfer bytes overheads are not known offchain and not included.
+//
+// da_gas(m) = ((fixed_bytes + len(data) + tokens * fixed_bytes_per_token) * gas_per_byte + overhead_gas) * bps / 1e4
+func msgDataAvailabilityGas(msg ccipocr3.Message, cfg ccipocr3.DataAvailabilityGasConfig) *big.Int {
+	length := uint64(messageFixedBytes + len(msg.Data) + len(msg.TokenAmounts)*messageFixedBytesPerToken)
+	gas := new(big.Int).SetUint64(length)
+	gas.Mul(gas, new(big.Int).SetUint64(uint64(cfg.DestGasPerDataAvailabilityByte)))
+	gas.Add(gas, new(big.Int).SetUint64(uint64(cfg.DestDataAvailabilityOverheadGas)))
+	gas.Mul(gas, new(big.Int).SetUint64(uint64(cfg.DestDataAvailabilityMultiplierBps)))
+	return gas.Div(gas, big.NewInt(bpsDivisor))
+}
+
+// waitBoostedFee boosts the fee according to the time the message has been waiting:
+//
+// wait_boosted_fee(m) = (1 + wait_hours(m) * relative_boost_per_wait_hour) * fee(m)
+func waitBoostedFee(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int {
+	if waitTime <= 0 || relativeBoostPerWaitHour <= 0 {
+		return new(big.Int).Set(fee)
+	}
+	k := 1.0 + waitTime.Hours()*relativeBoostPerWaitHour
+
+	boosted := new(big.Float).Mul(big.NewFloat(k), new(big.Float).SetInt(fee))
+	res, _ := boosted.Int(nil)
+	return res
+}
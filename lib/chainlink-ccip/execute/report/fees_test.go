@@ -0,0 +1,173 @@
+package report
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	gasmock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_waitBoostedFee(t *testing.T) {
+	fee := big.NewInt(1_000_000)
+	assert.Equal(t, fee, waitBoostedFee(0, fee, 0.5))
+	assert.Equal(t, fee, waitBoostedFee(time.Hour, fee, 0))
+	assert.Equal(t, big.NewInt(1_500_000), waitBoostedFee(time.Hour, fee, 0.5))
+	assert.Equal(t, big.NewInt(2_000_000), waitBoostedFee(2*time.Hour, fee, 0.5))
+	assert.Equal(t, big.NewInt(1_250_000), waitBoostedFee(30*time.Minute, fee, 0.5))
+}
+
+var daGasConfig = cciptypes.DataAvailabilityGasConfig{
+	DestDataAvailabilityOverheadGas:   10_000,
+	DestGasPerDataAvailabilityByte:    16,
+	DestDataAvailabilityMultiplierBps: 20_000,
+}
+
+func Test_msgDataAvailabilityGas(t *testing.T) {
+	// (480 fixed bytes * 16 + 10k overhead) * 2
+	assert.Equal(t, big.NewInt(35_360), msgDataAvailabilityGas(cciptypes.Message{}, daGasConfig))
+
+	msg := cciptypes.Message{
+		Data:         make(cciptypes.Bytes, 100),
+		TokenAmounts: make([]cciptypes.RampTokenAmount, 2),
+	}
+	// ((480 + 100 + 2 * 288) * 16 + 10k overhead) * 2
+	assert.Equal(t, big.NewInt(56_992), msgDataAvailabilityGas(msg, daGasConfig))
+}
+
+func TestCheckFees(t *testing.T) {
+	lggr := logger.Test(t)
+	now := time.Unix(1_700_000_000, 0)
+	usd := func(v int64) cciptypes.BigInt {
+		return cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(v), big.NewInt(1e18)))
+	}
+	feeToken := cciptypes.UnknownAddress{0x01}
+
+	// 100k gas at 10 gwei = 1e15 wei = 0.001 ETH = 2 USD at 2000 USD/ETH
+	execCost := exectypes.ExecCostObservation{
+		DestExecutionFee:   cciptypes.NewBigInt(big.NewInt(10e9)),
+		DestNativePriceUSD: usd(2000),
+		FeeTokenPrices:     exectypes.FeeTokenPrices{5: {feeToken.String(): usd(10)}},
+		Timestamp:          now,
+	}
+	withCost := func(update func(*exectypes.ExecCostObservation)) exectypes.ExecCostObservation {
+		c := execCost
+		update(&c)
+		return c
+	}
+	ep := gasmock.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(100_000)).Maybe()
+
+	msg := func(amount *big.Int) cciptypes.Message {
+		return cciptypes.Message{
+			Header:         cciptypes.RampMessageHeader{SequenceNumber: 1},
+			FeeToken:       feeToken,
+			FeeTokenAmount: cciptypes.BigInt{Int: amount},
+			// the fee value in juels must not be used
+			FeeValueJuels: cciptypes.NewBigInt(big.NewInt(1e18)),
+		}
+	}
+	// 0.2 fee tokens = 2 USD
+	exactFee := big.NewInt(2e17)
+	halfFee := big.NewInt(1e17)
+
+	testCases := []struct {
+		name      string
+		execCost  exectypes.ExecCostObservation
+		boost     float64
+		waitTime  time.Duration
+		fee       *big.Int
+		expStatus messageStatus
+	}{
+		{name: "fee covers cost", execCost: execCost, fee: exactFee, expStatus: None},
+		{name: "underpaid", execCost: execCost, fee: halfFee, expStatus: InsufficientFee},
+		{name: "no fee", execCost: execCost, fee: nil, expStatus: InsufficientFee},
+		{name: "underpaid boosted enough", execCost: execCost, boost: 1, waitTime: time.Hour, fee: halfFee, expStatus: None},
+		{
+			name:      "underpaid not boosted enough",
+			execCost:  execCost,
+			boost:     1,
+			waitTime:  30 * time.Minute,
+			fee:       halfFee,
+			expStatus: InsufficientFee,
+		},
+		{
+			name: "data availability cost is added",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.DestDataAvailabilityFee = cciptypes.NewBigInt(big.NewInt(1e9))
+				c.DAGasConfigs = map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig{5: daGasConfig}
+			}),
+			fee:       exactFee,
+			expStatus: InsufficientFee,
+		},
+		{
+			name: "data availability cost needs the source chain config",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.DestDataAvailabilityFee = cciptypes.NewBigInt(big.NewInt(1e9))
+				c.DAGasConfigs = map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig{6: daGasConfig}
+			}),
+			fee:       exactFee,
+			expStatus: None,
+		},
+		{
+			name: "fee token price is used",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.FeeTokenPrices = exectypes.FeeTokenPrices{5: {feeToken.String(): usd(20)}}
+			}),
+			fee:       halfFee,
+			expStatus: None,
+		},
+		{
+			name: "missing dest prices fail open",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.DestNativePriceUSD = cciptypes.BigInt{}
+			}),
+			fee:       halfFee,
+			expStatus: None,
+		},
+		{
+			name: "missing fee token price fails open",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.FeeTokenPrices = exectypes.FeeTokenPrices{6: {feeToken.String(): usd(10)}}
+			}),
+			fee:       halfFee,
+			expStatus: None,
+		},
+		{
+			name: "missing timestamp fails open",
+			execCost: withCost(func(c *exectypes.ExecCostObservation) {
+				c.Timestamp = time.Time{}
+			}),
+			fee:       halfFee,
+			expStatus: None,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var underpaid []cciptypes.ChainSelector
+			check := CheckFees(tc.execCost, ep, FeeBoostConfig{RelativeBoostPerWaitHour: tc.boost},
+				func(src cciptypes.ChainSelector, _ cciptypes.Message) {
+					underpaid = append(underpaid, src)
+				})
+
+			report := exectypes.CommitData{SourceChain: 5, Timestamp: now.Add(-tc.waitTime)}
+			status, err := check(lggr, msg(tc.fee), 0, report)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expStatus, status)
+			if tc.expStatus == InsufficientFee {
+				assert.Equal(t, []cciptypes.ChainSelector{5}, underpaid)
+			} else {
+				assert.Empty(t, underpaid)
+			}
+		})
+	}
+}
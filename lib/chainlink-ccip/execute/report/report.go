@@ -134,6 +134,7 @@ const (
 	MissingNoncesForChain         messageStatus = "missing_nonces_for_chain"
 	MissingNonce                  messageStatus = "missing_nonce"
 	InvalidNonce                  messageStatus = "invalid_nonce"
+	InsufficientFee               messageStatus = "insufficient_fee"
 	/*
 		SenderAlreadySkipped                 messageStatus = "sender_already_skipped"
 		MessageMaxGasCalcError               messageStatus = "message_max_gas_calc_error"
@@ -142,7 +143,6 @@ const (
 		AggregateTokenLimitExceeded          messageStatus = "aggregate_token_limit_exceeded"
 		TokenNotInDestTokenPrices            messageStatus = "token_not_in_dest_token_prices"
 		TokenNotInSrcTokenPrices             messageStatus = "token_not_in_src_token_prices"
-		InsufficientRemainingFee             messageStatus = "insufficient_remaining_fee"
 		AddedToBatch                         messageStatus = "added_to_batch"
 	*/
 )
@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/time/rate"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -19,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
 
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -159,6 +161,23 @@ func (h *httpClient) callAPI(
 	method string,
 	url url.URL,
 	body io.Reader,
+) (cciptypes.Bytes, HTTPStatus, error) {
+	ctx, span := tracing.StartSpan(ctx, "AttestationAPI."+method,
+		attribute.String("http.method", method),
+		attribute.String("url.path", url.Path),
+	)
+	response, status, err := h.doCallAPI(ctx, lggr, method, url, body)
+	span.SetAttributes(attribute.Int("http.status_code", int(status)))
+	tracing.EndSpan(span, err)
+	return response, status, err
+}
+
+func (h *httpClient) doCallAPI(
+	ctx context.Context,
+	lggr logger.Logger,
+	method string,
+	url url.URL,
+	body io.Reader,
 ) (cciptypes.Bytes, HTTPStatus, error) {
 	// Terminate immediately when rate limited
 	if coolDown, duration := h.inCoolDownPeriod(); coolDown {
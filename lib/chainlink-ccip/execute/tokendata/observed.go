@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -80,9 +81,7 @@ func (o *ObservedAttestationClient) Attestations(
 	for _, msgIDToAttestation := range attestations {
 		for _, attestation := range msgIDToAttestation {
 			o.trackTimeToAttestation(start, hex.EncodeToString(attestation.ID), attestation.Error)
-			promAttestationDurations.
-				WithLabelValues(o.Type()).
-				Observe(float64(duration))
+			tracing.ObserveWithExemplar(ctx, promAttestationDurations.WithLabelValues(o.Type()), float64(duration))
 		}
 	}
 	return attestations, err
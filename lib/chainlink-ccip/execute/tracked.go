@@ -4,6 +4,9 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -42,6 +45,7 @@ func (p *TrackedPlugin) Query(
 	ctx context.Context, outctx ocr3types.OutcomeContext,
 ) (types.Query, error) {
 	return withTrackedMethod(
+		ctx,
 		p,
 		plugincommon.QueryMethod,
 		outctx,
@@ -53,6 +57,7 @@ func (p *TrackedPlugin) Observation(
 	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query,
 ) (types.Observation, error) {
 	return withTrackedMethod(
+		ctx,
 		p,
 		plugincommon.ObservationMethod,
 		outctx,
@@ -64,6 +69,7 @@ func (p *TrackedPlugin) Outcome(
 	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
 ) (ocr3types.Outcome, error) {
 	return withTrackedMethod(
+		ctx,
 		p,
 		plugincommon.OutcomeMethod,
 		outctx,
@@ -72,6 +78,7 @@ func (p *TrackedPlugin) Outcome(
 }
 
 func withTrackedMethod[T any](
+	ctx context.Context,
 	p *TrackedPlugin,
 	method plugincommon.MethodType,
 	outctx ocr3types.OutcomeContext,
@@ -83,7 +90,8 @@ func withTrackedMethod[T any](
 	latency := time.Since(queryStarted)
 	state := currentState(p, outctx)
 
-	p.reporter.TrackLatency(state, method, latency, err)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("ccip.exec_state", string(state)))
+	p.reporter.TrackLatency(ctx, state, method, latency, err)
 	p.lggr.Debugw("tracking exec latency",
 		"state", state,
 		"method", method,
@@ -129,6 +129,43 @@ func (r InMemoryCCIPReader) ExecutedMessages(
 	return ret, nil
 }
 
+func (r InMemoryCCIPReader) MessageStatus(
+	_ context.Context, chain cciptypes.ChainSelector, lookup cciptypes.MessageLookup,
+) (cciptypes.MessageStatus, error) {
+	for _, msg := range r.Messages[chain] {
+		if msg.Destination != r.Dest {
+			continue
+		}
+		if lookup.MessageID.IsEmpty() && msg.Header.SequenceNumber != lookup.SeqNum ||
+			!lookup.MessageID.IsEmpty() && msg.Header.MessageID != lookup.MessageID {
+			continue
+		}
+
+		status := cciptypes.MessageStatus{SentMessage: cciptypes.SentMessage{Message: msg.Message}}
+		for _, report := range r.UnfinalizedReports {
+			for i, roots := range [][]cciptypes.MerkleRootChain{
+				report.Report.BlessedMerkleRoots, report.Report.UnblessedMerkleRoots,
+			} {
+				for _, root := range roots {
+					if root.ChainSel == chain && root.SeqNumsRange.Contains(msg.Header.SequenceNumber) {
+						status.Commit = &cciptypes.MessageCommit{
+							MerkleRoot: root,
+							Blessed:    i == 0,
+							Timestamp:  report.Timestamp,
+							BlockNum:   report.BlockNum,
+						}
+					}
+				}
+			}
+		}
+		if msg.Executed {
+			status.Execution.State = cciptypes.MessageExecutionStateSuccess
+		}
+		return status, nil
+	}
+	return cciptypes.MessageStatus{}, reader.ErrMessageNotFound
+}
+
 func (r InMemoryCCIPReader) MsgsBetweenSeqNums(
 	_ context.Context, chain cciptypes.ChainSelector, seqNumRange cciptypes.SeqNumRange,
 ) ([]cciptypes.Message, error) {
@@ -186,6 +223,21 @@ func (r InMemoryCCIPReader) GetWrappedNativeTokenPriceUSD(
 	return nil
 }
 
+func (r InMemoryCCIPReader) GetFeeTokenPricesUSD(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+	tokens []cciptypes.UnknownAddress,
+) map[string]cciptypes.BigInt {
+	return nil
+}
+
+func (r InMemoryCCIPReader) GetDataAvailabilityGasConfig(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+) (cciptypes.DataAvailabilityGasConfig, error) {
+	return cciptypes.DataAvailabilityGasConfig{}, nil
+}
+
 func (r InMemoryCCIPReader) GetChainFeePriceUpdate(
 	ctx context.Context,
 	selectors []cciptypes.ChainSelector,
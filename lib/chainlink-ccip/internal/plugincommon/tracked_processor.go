@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 )
 
 type MethodType = string
@@ -19,7 +20,7 @@ const (
 
 type MetricsReporter interface {
 	TrackProcessorOutput(processor string, method MethodType, obs plugintypes.Trackable)
-	TrackProcessorLatency(processor string, method string, latency time.Duration, err error)
+	TrackProcessorLatency(ctx context.Context, processor string, method string, latency time.Duration, err error)
 }
 
 // TrackedProcessor wraps a PluginProcessor and tracks
@@ -48,7 +49,7 @@ func NewTrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome p
 }
 
 func (p *TrackedProcessor[Query, Observation, Outcome]) Query(ctx context.Context, prev Outcome) (Query, error) {
-	return withTrackedMethod[Query](p, QueryMethod, func() (Query, error) {
+	return withTrackedMethod[Query](ctx, p, QueryMethod, func(ctx context.Context) (Query, error) {
 		return p.PluginProcessor.Query(ctx, prev)
 	})
 }
@@ -58,7 +59,7 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Observation(
 	prev Outcome,
 	query Query,
 ) (Observation, error) {
-	obs, err := withTrackedMethod[Observation](p, ObservationMethod, func() (Observation, error) {
+	obs, err := withTrackedMethod[Observation](ctx, p, ObservationMethod, func(ctx context.Context) (Observation, error) {
 		return p.PluginProcessor.Observation(ctx, prev, query)
 	})
 	if err == nil {
@@ -73,7 +74,7 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 	query Query,
 	aos []AttributedObservation[Observation],
 ) (Outcome, error) {
-	out, err := withTrackedMethod[Outcome](p, OutcomeMethod, func() (Outcome, error) {
+	out, err := withTrackedMethod[Outcome](ctx, p, OutcomeMethod, func(ctx context.Context) (Outcome, error) {
 		return p.PluginProcessor.Outcome(ctx, prev, query, aos)
 	})
 	if err == nil {
@@ -83,15 +84,18 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 }
 
 func withTrackedMethod[T any, Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable](
+	ctx context.Context,
 	p *TrackedProcessor[Query, Observation, Outcome],
 	method string,
-	f func() (T, error),
+	f func(ctx context.Context) (T, error),
 ) (T, error) {
+	ctx, span := tracing.StartSpan(ctx, p.processorName+"."+method)
 	queryStarted := time.Now()
-	resp, err := f()
+	resp, err := f(ctx)
 
 	latency := time.Since(queryStarted)
-	p.reporter.TrackProcessorLatency(p.processorName, method, latency, err)
+	p.reporter.TrackProcessorLatency(ctx, p.processorName, method, latency, err)
+	tracing.EndSpan(span, err)
 	p.lggr.Debugw("tracking processor latency",
 		"processor", p.processorName,
 		"method", method,
@@ -103,6 +107,6 @@ func withTrackedMethod[T any, Query any, Observation plugintypes.Trackable, Outc
 
 type NoopReporter struct{}
 
-func (n NoopReporter) TrackProcessorLatency(string, string, time.Duration, error) {}
+func (n NoopReporter) TrackProcessorLatency(context.Context, string, string, time.Duration, error) {}
 
 func (n NoopReporter) TrackProcessorOutput(string, MethodType, plugintypes.Trackable) {}
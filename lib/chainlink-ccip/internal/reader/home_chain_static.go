@@ -0,0 +1,78 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// staticHomeChain serves a fixed set of chain configs, e.g. a snapshot of the home chain when replaying a round.
+type staticHomeChain struct {
+	state state
+}
+
+// NewStaticHomeChain returns a HomeChain that always returns the given chain configs. OCR configs are not part of
+// the chain configs and can't be read from it.
+func NewStaticHomeChain(chainConfigs map[cciptypes.ChainSelector]ChainConfig) HomeChain {
+	return &staticHomeChain{
+		state: state{
+			chainConfigs:        chainConfigs,
+			nodeSupportedChains: createNodesSupportedChains(chainConfigs),
+			knownSourceChains:   createKnownChains(chainConfigs),
+			fChain:              createFChain(chainConfigs),
+		},
+	}
+}
+
+func (r *staticHomeChain) GetChainConfig(chainSelector cciptypes.ChainSelector) (ChainConfig, error) {
+	if chainConfig, ok := r.state.chainConfigs[chainSelector]; ok {
+		return chainConfig, nil
+	}
+	return ChainConfig{}, fmt.Errorf("chain config not found for chain %v", chainSelector)
+}
+
+func (r *staticHomeChain) GetAllChainConfigs() (map[cciptypes.ChainSelector]ChainConfig, error) {
+	return r.state.chainConfigs, nil
+}
+
+func (r *staticHomeChain) GetSupportedChainsForPeer(
+	id libocrtypes.PeerID,
+) (mapset.Set[cciptypes.ChainSelector], error) {
+	if _, ok := r.state.nodeSupportedChains[id]; !ok {
+		// empty set to denote no chains supported
+		return mapset.NewSet[cciptypes.ChainSelector](), nil
+	}
+	return r.state.nodeSupportedChains[id], nil
+}
+
+func (r *staticHomeChain) GetKnownCCIPChains() (mapset.Set[cciptypes.ChainSelector], error) {
+	return r.state.knownSourceChains.Clone(), nil
+}
+
+func (r *staticHomeChain) GetFChain() (map[cciptypes.ChainSelector]int, error) {
+	return r.state.fChain, nil
+}
+
+func (r *staticHomeChain) GetOCRConfigs(context.Context, uint32, uint8) (ActiveAndCandidate, error) {
+	return ActiveAndCandidate{}, errors.New("OCR configs are not available on a static home chain")
+}
+
+func (r *staticHomeChain) Start(context.Context) error { return nil }
+
+func (r *staticHomeChain) Close() error { return nil }
+
+func (r *staticHomeChain) Ready() error { return nil }
+
+func (r *staticHomeChain) HealthReport() map[string]error {
+	return map[string]error{r.Name(): nil}
+}
+
+func (r *staticHomeChain) Name() string {
+	return "StaticHomeChain"
+}
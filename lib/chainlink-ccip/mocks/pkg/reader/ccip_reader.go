@@ -413,6 +413,63 @@ func (_c *MockCCIPReader_GetContractAddress_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// GetDataAvailabilityGasConfig provides a mock function with given fields: ctx, chain
+func (_m *MockCCIPReader) GetDataAvailabilityGasConfig(ctx context.Context, chain ccipocr3.ChainSelector) (ccipocr3.DataAvailabilityGasConfig, error) {
+	ret := _m.Called(ctx, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataAvailabilityGasConfig")
+	}
+
+	var r0 ccipocr3.DataAvailabilityGasConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector) (ccipocr3.DataAvailabilityGasConfig, error)); ok {
+		return rf(ctx, chain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector) ccipocr3.DataAvailabilityGasConfig); ok {
+		r0 = rf(ctx, chain)
+	} else {
+		r0 = ret.Get(0).(ccipocr3.DataAvailabilityGasConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccipocr3.ChainSelector) error); ok {
+		r1 = rf(ctx, chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_GetDataAvailabilityGasConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataAvailabilityGasConfig'
+type MockCCIPReader_GetDataAvailabilityGasConfig_Call struct {
+	*mock.Call
+}
+
+// GetDataAvailabilityGasConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chain ccipocr3.ChainSelector
+func (_e *MockCCIPReader_Expecter) GetDataAvailabilityGasConfig(ctx interface{}, chain interface{}) *MockCCIPReader_GetDataAvailabilityGasConfig_Call {
+	return &MockCCIPReader_GetDataAvailabilityGasConfig_Call{Call: _e.mock.On("GetDataAvailabilityGasConfig", ctx, chain)}
+}
+
+func (_c *MockCCIPReader_GetDataAvailabilityGasConfig_Call) Run(run func(ctx context.Context, chain ccipocr3.ChainSelector)) *MockCCIPReader_GetDataAvailabilityGasConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_GetDataAvailabilityGasConfig_Call) Return(_a0 ccipocr3.DataAvailabilityGasConfig, _a1 error) *MockCCIPReader_GetDataAvailabilityGasConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_GetDataAvailabilityGasConfig_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector) (ccipocr3.DataAvailabilityGasConfig, error)) *MockCCIPReader_GetDataAvailabilityGasConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDestChainFeeComponents provides a mock function with given fields: ctx
 func (_m *MockCCIPReader) GetDestChainFeeComponents(ctx context.Context) (types.ChainFeeComponents, error) {
 	ret := _m.Called(ctx)
@@ -526,6 +583,56 @@ func (_c *MockCCIPReader_GetExpectedNextSequenceNumber_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetFeeTokenPricesUSD provides a mock function with given fields: ctx, chain, tokens
+func (_m *MockCCIPReader) GetFeeTokenPricesUSD(ctx context.Context, chain ccipocr3.ChainSelector, tokens []ccipocr3.UnknownAddress) map[string]ccipocr3.BigInt {
+	ret := _m.Called(ctx, chain, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeeTokenPricesUSD")
+	}
+
+	var r0 map[string]ccipocr3.BigInt
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) map[string]ccipocr3.BigInt); ok {
+		r0 = rf(ctx, chain, tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]ccipocr3.BigInt)
+		}
+	}
+
+	return r0
+}
+
+// MockCCIPReader_GetFeeTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeeTokenPricesUSD'
+type MockCCIPReader_GetFeeTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetFeeTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chain ccipocr3.ChainSelector
+//   - tokens []ccipocr3.UnknownAddress
+func (_e *MockCCIPReader_Expecter) GetFeeTokenPricesUSD(ctx interface{}, chain interface{}, tokens interface{}) *MockCCIPReader_GetFeeTokenPricesUSD_Call {
+	return &MockCCIPReader_GetFeeTokenPricesUSD_Call{Call: _e.mock.On("GetFeeTokenPricesUSD", ctx, chain, tokens)}
+}
+
+func (_c *MockCCIPReader_GetFeeTokenPricesUSD_Call) Run(run func(ctx context.Context, chain ccipocr3.ChainSelector, tokens []ccipocr3.UnknownAddress)) *MockCCIPReader_GetFeeTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector), args[2].([]ccipocr3.UnknownAddress))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_GetFeeTokenPricesUSD_Call) Return(_a0 map[string]ccipocr3.BigInt) *MockCCIPReader_GetFeeTokenPricesUSD_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCCIPReader_GetFeeTokenPricesUSD_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) map[string]ccipocr3.BigInt) *MockCCIPReader_GetFeeTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestPriceSeqNr provides a mock function with given fields: ctx
 func (_m *MockCCIPReader) GetLatestPriceSeqNr(ctx context.Context) (uint64, error) {
 	ret := _m.Called(ctx)
@@ -974,6 +1081,64 @@ func (_c *MockCCIPReader_LinkPriceUSD_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// MessageStatus provides a mock function with given fields: ctx, sourceChain, lookup
+func (_m *MockCCIPReader) MessageStatus(ctx context.Context, sourceChain ccipocr3.ChainSelector, lookup ccipocr3.MessageLookup) (ccipocr3.MessageStatus, error) {
+	ret := _m.Called(ctx, sourceChain, lookup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageStatus")
+	}
+
+	var r0 ccipocr3.MessageStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.MessageLookup) (ccipocr3.MessageStatus, error)); ok {
+		return rf(ctx, sourceChain, lookup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.MessageLookup) ccipocr3.MessageStatus); ok {
+		r0 = rf(ctx, sourceChain, lookup)
+	} else {
+		r0 = ret.Get(0).(ccipocr3.MessageStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.MessageLookup) error); ok {
+		r1 = rf(ctx, sourceChain, lookup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_MessageStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageStatus'
+type MockCCIPReader_MessageStatus_Call struct {
+	*mock.Call
+}
+
+// MessageStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceChain ccipocr3.ChainSelector
+//   - lookup ccipocr3.MessageLookup
+func (_e *MockCCIPReader_Expecter) MessageStatus(ctx interface{}, sourceChain interface{}, lookup interface{}) *MockCCIPReader_MessageStatus_Call {
+	return &MockCCIPReader_MessageStatus_Call{Call: _e.mock.On("MessageStatus", ctx, sourceChain, lookup)}
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) Run(run func(ctx context.Context, sourceChain ccipocr3.ChainSelector, lookup ccipocr3.MessageLookup)) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector), args[2].(ccipocr3.MessageLookup))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) Return(_a0 ccipocr3.MessageStatus, _a1 error) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector, ccipocr3.MessageLookup) (ccipocr3.MessageStatus, error)) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MsgsBetweenSeqNums provides a mock function with given fields: ctx, chain, seqNumRange
 func (_m *MockCCIPReader) MsgsBetweenSeqNums(ctx context.Context, chain ccipocr3.ChainSelector, seqNumRange ccipocr3.SeqNumRange) ([]ccipocr3.Message, error) {
 	ret := _m.Called(ctx, chain, seqNumRange)
@@ -116,6 +116,9 @@ const (
 	EventNameExecutionStateChanged = "ExecutionStateChanged"
 	EventNameCommitReportAccepted  = "CommitReportAccepted"
 	EventNameCCTPMessageSent       = "MessageSent"
+	EventNameSourceChainConfigSet  = "SourceChainConfigSet"
+	EventNameSourceChainAdded      = "SourceChainAdded"
+	EventNameConfigSet             = "ConfigSet"
 )
 
 // Event Attributes
@@ -9,10 +9,13 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 )
 
 var (
@@ -101,14 +104,21 @@ func (o *Observed) GetLatestValue(
 	confidenceLevel primitives.ConfidenceLevel,
 	params, returnVal any,
 ) error {
+	contract, function := unpackReadIdentifier(readIdentifier)
+	ctx, span := tracing.StartSpan(ctx, "ContractReader.GetLatestValue",
+		attribute.String("chain.id", o.chainID),
+		attribute.String("contract", contract),
+		attribute.String("function", function),
+	)
+
 	start := time.Now()
 	err := o.ContractReaderFacade.GetLatestValue(ctx, readIdentifier, confidenceLevel, params, returnVal)
 	duration := time.Since(start)
 
-	contract, function := unpackReadIdentifier(readIdentifier)
-	o.directRequestsDurations.
-		WithLabelValues(o.chainID, contract, function).
-		Observe(float64(duration))
+	tracing.ObserveWithExemplar(ctx,
+		o.directRequestsDurations.WithLabelValues(o.chainID, contract, function),
+		float64(duration))
+	tracing.EndSpan(span, err)
 
 	o.maybeTrackErrors(err, "GetLatestValue", contract+"-"+function)
 	o.lggr.Debugw("Observed GetLatestValue",
@@ -125,13 +135,17 @@ func (o *Observed) BatchGetLatestValues(
 	ctx context.Context,
 	request types.BatchGetLatestValuesRequest,
 ) (types.BatchGetLatestValuesResult, error) {
+	ctx, span := tracing.StartSpan(ctx, "ContractReader.BatchGetLatestValues",
+		attribute.String("chain.id", o.chainID),
+		attribute.Int("batch.size", len(request)),
+	)
+
 	start := time.Now()
 	result, err := o.ContractReaderFacade.BatchGetLatestValues(ctx, request)
 	duration := time.Since(start)
 
-	o.batchRequestsDurations.
-		WithLabelValues(o.chainID).
-		Observe(float64(duration))
+	tracing.ObserveWithExemplar(ctx, o.batchRequestsDurations.WithLabelValues(o.chainID), float64(duration))
+	tracing.EndSpan(span, err)
 
 	o.batchSizes.
 		WithLabelValues(o.chainID).
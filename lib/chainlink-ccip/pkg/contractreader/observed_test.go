@@ -38,11 +38,11 @@ func Test_GetBatchValue(t *testing.T) {
 	}
 	request3 := types.BatchGetLatestValuesRequest{}
 
-	mockedReader.EXPECT().BatchGetLatestValues(ctx, request1).
+	mockedReader.EXPECT().BatchGetLatestValues(mock.Anything, request1).
 		Return(r, nil)
-	mockedReader.EXPECT().BatchGetLatestValues(ctx, request2).
+	mockedReader.EXPECT().BatchGetLatestValues(mock.Anything, request2).
 		Return(r, nil)
-	mockedReader.EXPECT().BatchGetLatestValues(ctx, request3).
+	mockedReader.EXPECT().BatchGetLatestValues(mock.Anything, request3).
 		Return(nil, fmt.Errorf("error"))
 
 	reader := contractreader.NewObserverReader(mockedReader, logger.Test(t), chainID)
@@ -78,9 +78,9 @@ func Test_GetLatestValue(t *testing.T) {
 	contractID1 := "0x1-contract-read"
 	contractID2 := "0x2-contract-faulty"
 
-	mockedReader.EXPECT().GetLatestValue(ctx, contractID1, mock.Anything, mock.Anything, mock.Anything).
+	mockedReader.EXPECT().GetLatestValue(mock.Anything, contractID1, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
-	mockedReader.EXPECT().GetLatestValue(ctx, contractID2, mock.Anything, mock.Anything, mock.Anything).
+	mockedReader.EXPECT().GetLatestValue(mock.Anything, contractID2, mock.Anything, mock.Anything, mock.Anything).
 		Return(fmt.Errorf("error"))
 
 	reader := contractreader.NewObserverReader(mockedReader, logger.Test(t), chainID)
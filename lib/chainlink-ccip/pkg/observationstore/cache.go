@@ -0,0 +1,172 @@
+package observationstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	sel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	PromObserverStalenessGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_async_observer_staleness_seconds",
+			Help: "This metric tracks the age of the values served by the async observers of the commit plugin",
+		},
+		[]string{"chainID", "observer"},
+	)
+)
+
+// Cache keeps the time of the last successful sync of an async observer, persists the sync results to the Store
+// of the Config and reports their staleness. It is safe for concurrent use.
+//
+// A sync result equal to the persisted one is only written again once half of the freshness window passed, so that
+// the store isn't written on every sync while the persisted result stays loadable.
+type Cache[T any] struct {
+	lggr     logger.Logger
+	store    Store
+	maxAge   time.Duration
+	key      string
+	chainID  string
+	observer string
+	gauge    *prometheus.GaugeVec
+	now      func() time.Time
+
+	mu       sync.Mutex
+	syncedAt time.Time
+	// persisted and persistedAt are the data and sync time of the entry in the store
+	persisted   []byte
+	persistedAt time.Time
+}
+
+// NewCache creates the cache of the given observer of the destChain commit plugin.
+func NewCache[T any](
+	lggr logger.Logger,
+	cfg Config,
+	destChain cciptypes.ChainSelector,
+	observer string,
+) *Cache[T] {
+	chainID, err := sel.GetChainIDFromSelector(uint64(destChain))
+	if err != nil {
+		lggr.Errorw("failed to get chainID from selector, using the selector as staleness metric label",
+			"destChain", destChain, "err", err)
+		chainID = strconv.FormatUint(uint64(destChain), 10)
+	}
+
+	key := fmt.Sprintf("commit/%s/%d", observer, destChain)
+	if cfg.scope != "" {
+		key = fmt.Sprintf("commit/%s/%s/%d", cfg.scope, observer, destChain)
+	}
+
+	return &Cache[T]{
+		lggr:     lggr,
+		store:    cfg.Store,
+		maxAge:   cfg.MaxAge,
+		key:      key,
+		chainID:  chainID,
+		observer: observer,
+		gauge:    PromObserverStalenessGauge,
+		now:      time.Now,
+	}
+}
+
+// Load returns the persisted sync result, false when there is no store, nothing was persisted or the persisted result
+// is older than the freshness window.
+func (c *Cache[T]) Load(ctx context.Context) (T, bool) {
+	var v T
+	if c.store == nil {
+		return v, false
+	}
+
+	entry, ok, err := c.store.Load(ctx, c.key)
+	if err != nil {
+		c.lggr.Warnw("failed to load persisted observations", "key", c.key, "err", err)
+		return v, false
+	}
+	if !ok {
+		c.lggr.Debugw("no persisted observations", "key", c.key)
+		return v, false
+	}
+
+	age := c.now().Sub(entry.SyncedAt)
+	if c.maxAge > 0 && age > c.maxAge {
+		c.lggr.Infow("persisted observations are stale, not loading them",
+			"key", c.key, "syncedAt", entry.SyncedAt, "age", age, "maxAge", c.maxAge)
+		return v, false
+	}
+
+	if err := json.Unmarshal(entry.Data, &v); err != nil {
+		c.lggr.Warnw("failed to decode persisted observations", "key", c.key, "err", err)
+		return v, false
+	}
+
+	c.mu.Lock()
+	c.syncedAt = entry.SyncedAt
+	c.persisted, c.persistedAt = entry.Data, entry.SyncedAt
+	c.mu.Unlock()
+	c.lggr.Infow("loaded persisted observations", "key", c.key, "syncedAt", entry.SyncedAt, "age", age)
+	return v, true
+}
+
+// Save records a successful sync and persists its result when there is a store.
+func (c *Cache[T]) Save(ctx context.Context, v T) {
+	syncedAt := c.now()
+	c.mu.Lock()
+	c.syncedAt = syncedAt
+	c.mu.Unlock()
+
+	if c.store == nil {
+		return
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.lggr.Warnw("failed to encode observations", "key", c.key, "err", err)
+		return
+	}
+
+	c.mu.Lock()
+	unchanged := bytes.Equal(data, c.persisted) && (c.maxAge == 0 || syncedAt.Sub(c.persistedAt) < c.maxAge/2)
+	c.mu.Unlock()
+	if unchanged {
+		return
+	}
+
+	if err := c.store.Save(ctx, c.key, Entry{Data: data, SyncedAt: syncedAt}); err != nil {
+		c.lggr.Warnw("failed to persist observations", "key", c.key, "err", err)
+		return
+	}
+	c.mu.Lock()
+	c.persisted, c.persistedAt = data, syncedAt
+	c.mu.Unlock()
+}
+
+// Staleness returns the time since the last successful sync, false before the first sync or load.
+func (c *Cache[T]) Staleness() (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.syncedAt.IsZero() {
+		return 0, false
+	}
+	return c.now().Sub(c.syncedAt), true
+}
+
+// TrackStaleness reports the time since the last successful sync.
+func (c *Cache[T]) TrackStaleness() {
+	staleness, ok := c.Staleness()
+	if !ok {
+		return
+	}
+	c.gauge.WithLabelValues(c.chainID, c.observer).Set(staleness.Seconds())
+}
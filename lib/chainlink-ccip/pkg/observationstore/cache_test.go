@@ -0,0 +1,192 @@
+package observationstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	sel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type observations struct {
+	Prices map[cciptypes.ChainSelector]cciptypes.BigInt `json:"prices"`
+}
+
+type failingStore struct{}
+
+func (failingStore) Load(context.Context, string) (Entry, bool, error) {
+	return Entry{}, false, errors.New("db down")
+}
+
+func (failingStore) Save(context.Context, string, Entry) error { return errors.New("db down") }
+
+type countingStore struct {
+	*MemoryStore
+	saves int
+}
+
+func (s *countingStore) Save(ctx context.Context, key string, entry Entry) error {
+	s.saves++
+	return s.MemoryStore.Save(ctx, key, entry)
+}
+
+func TestCache_SaveAndLoad(t *testing.T) {
+	ctx := tests.Context(t)
+	destChain := cciptypes.ChainSelector(sel.ETHEREUM_TESTNET_SEPOLIA.Selector)
+	obs := observations{Prices: map[cciptypes.ChainSelector]cciptypes.BigInt{1: cciptypes.NewBigIntFromInt64(100)}}
+	syncedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		maxAge   time.Duration
+		loadedAt time.Time
+		expLoad  bool
+	}{
+		{name: "within freshness window", maxAge: time.Minute, loadedAt: syncedAt.Add(30 * time.Second), expLoad: true},
+		{name: "stale", maxAge: time.Minute, loadedAt: syncedAt.Add(2 * time.Minute), expLoad: false},
+		{name: "no freshness window", loadedAt: syncedAt.Add(24 * time.Hour), expLoad: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Config{Store: NewMemoryStore(), MaxAge: tc.maxAge}
+
+			saver := NewCache[observations](logger.Test(t), cfg, destChain, "chainfee")
+			saver.now = func() time.Time { return syncedAt }
+			saver.Save(ctx, obs)
+
+			// a restarted node
+			loader := NewCache[observations](logger.Test(t), cfg, destChain, "chainfee")
+			loader.now = func() time.Time { return tc.loadedAt }
+			loaded, ok := loader.Load(ctx)
+			require.Equal(t, tc.expLoad, ok)
+
+			staleness, known := loader.Staleness()
+			if !tc.expLoad {
+				assert.Empty(t, loaded.Prices)
+				assert.False(t, known)
+				return
+			}
+			assert.Equal(t, obs, loaded)
+			assert.True(t, known)
+			assert.Equal(t, tc.loadedAt.Sub(syncedAt), staleness)
+		})
+	}
+}
+
+func TestCache_KeyedPerObserverAndDestChain(t *testing.T) {
+	ctx := tests.Context(t)
+	cfg := Config{Store: NewMemoryStore()}
+	obs := observations{Prices: map[cciptypes.ChainSelector]cciptypes.BigInt{1: cciptypes.NewBigIntFromInt64(1)}}
+
+	NewCache[observations](logger.Test(t), cfg, 1, "chainfee").Save(ctx, obs)
+
+	_, ok := NewCache[observations](logger.Test(t), cfg, 1, "tokenprice").Load(ctx)
+	assert.False(t, ok)
+	_, ok = NewCache[observations](logger.Test(t), cfg, 2, "chainfee").Load(ctx)
+	assert.False(t, ok)
+	loaded, ok := NewCache[observations](logger.Test(t), cfg, 1, "chainfee").Load(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, obs, loaded)
+}
+
+func TestCache_ScopedPerDONAndConfigDigest(t *testing.T) {
+	ctx := tests.Context(t)
+	cfg := Config{Store: NewMemoryStore()}
+	obs := observations{Prices: map[cciptypes.ChainSelector]cciptypes.BigInt{1: cciptypes.NewBigIntFromInt64(1)}}
+
+	NewCache[observations](logger.Test(t), cfg.WithScope(1, [32]byte{1}), 1, "chainfee").Save(ctx, obs)
+
+	_, ok := NewCache[observations](logger.Test(t), cfg.WithScope(2, [32]byte{1}), 1, "chainfee").Load(ctx)
+	assert.False(t, ok)
+	_, ok = NewCache[observations](logger.Test(t), cfg.WithScope(1, [32]byte{2}), 1, "chainfee").Load(ctx)
+	assert.False(t, ok)
+	loaded, ok := NewCache[observations](logger.Test(t), cfg.WithScope(1, [32]byte{1}), 1, "chainfee").Load(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, obs, loaded)
+}
+
+func TestCache_SkipsUnchangedSaves(t *testing.T) {
+	ctx := tests.Context(t)
+	store := &countingStore{MemoryStore: NewMemoryStore()}
+	obs := observations{Prices: map[cciptypes.ChainSelector]cciptypes.BigInt{1: cciptypes.NewBigIntFromInt64(1)}}
+
+	now := time.Now()
+	c := NewCache[observations](logger.Test(t), Config{Store: store, MaxAge: time.Minute}, 1, "chainfee")
+	c.now = func() time.Time { return now }
+
+	c.Save(ctx, obs)
+	assert.Equal(t, 1, store.saves)
+
+	// unchanged values are not written again while the persisted entry is fresh
+	now = now.Add(10 * time.Second)
+	c.Save(ctx, obs)
+	assert.Equal(t, 1, store.saves)
+	staleness, _ := c.Staleness()
+	assert.Zero(t, staleness)
+
+	// changed values are written
+	obs.Prices[1] = cciptypes.NewBigIntFromInt64(2)
+	c.Save(ctx, obs)
+	assert.Equal(t, 2, store.saves)
+
+	// unchanged values are written again once half of the freshness window passed
+	now = now.Add(30 * time.Second)
+	c.Save(ctx, obs)
+	assert.Equal(t, 3, store.saves)
+}
+
+func TestCache_WithoutStore(t *testing.T) {
+	ctx := tests.Context(t)
+	c := NewCache[observations](logger.Test(t), Config{}, 1, "merkleroot")
+
+	_, ok := c.Load(ctx)
+	assert.False(t, ok)
+	_, known := c.Staleness()
+	assert.False(t, known)
+
+	// syncs are still tracked for the staleness metric
+	c.Save(ctx, observations{})
+	_, known = c.Staleness()
+	assert.True(t, known)
+}
+
+func TestCache_StoreErrors(t *testing.T) {
+	ctx := tests.Context(t)
+	c := NewCache[observations](logger.Test(t), Config{Store: failingStore{}}, 1, "merkleroot")
+
+	c.Save(ctx, observations{})
+	_, ok := c.Load(ctx)
+	assert.False(t, ok)
+}
+
+func TestCache_TrackStaleness(t *testing.T) {
+	t.Cleanup(func() { PromObserverStalenessGauge.Reset() })
+	ctx := tests.Context(t)
+	destChain := cciptypes.ChainSelector(sel.ETHEREUM_TESTNET_SEPOLIA.Selector)
+	chainID := sel.ETHEREUM_TESTNET_SEPOLIA.EvmChainID
+
+	now := time.Now()
+	c := NewCache[observations](logger.Test(t), Config{}, destChain, "tokenprice")
+	c.now = func() time.Time { return now }
+
+	// nothing is reported before the first sync
+	c.TrackStaleness()
+	assert.Equal(t, 0, testutil.CollectAndCount(PromObserverStalenessGauge))
+
+	c.Save(ctx, observations{})
+	now = now.Add(90 * time.Second)
+	c.TrackStaleness()
+	assert.Equal(t, 90.0, testutil.ToFloat64(
+		PromObserverStalenessGauge.WithLabelValues(strconv.FormatUint(chainID, 10), "tokenprice")))
+}
@@ -0,0 +1,56 @@
+package observationstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// DataSource is the subset of the node database connection used by the DBStore, implemented by [*sql.DB],
+// [*sql.Tx] and the sqlx types.
+type DataSource interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// DBStore is the Store backed by the ccip_observations table of the node Postgres database:
+//
+//	CREATE TABLE ccip_observations (
+//		key TEXT PRIMARY KEY,
+//		data BYTEA NOT NULL,
+//		synced_at TIMESTAMPTZ NOT NULL
+//	);
+type DBStore struct {
+	ds DataSource
+}
+
+func NewDBStore(ds DataSource) *DBStore {
+	return &DBStore{ds: ds}
+}
+
+func (s *DBStore) Load(ctx context.Context, key string) (Entry, bool, error) {
+	rows, err := s.ds.QueryContext(ctx, `SELECT data, synced_at FROM ccip_observations WHERE key = $1`, key)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to load observations: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return Entry{}, false, rows.Err()
+	}
+	var e Entry
+	if err := rows.Scan(&e.Data, &e.SyncedAt); err != nil {
+		return Entry{}, false, fmt.Errorf("failed to scan observations: %w", err)
+	}
+	return e, true, rows.Err()
+}
+
+func (s *DBStore) Save(ctx context.Context, key string, entry Entry) error {
+	_, err := s.ds.ExecContext(ctx, `INSERT INTO ccip_observations (key, data, synced_at) VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET data = EXCLUDED.data, synced_at = EXCLUDED.synced_at`,
+		key, entry.Data, entry.SyncedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save observations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Package observationstore persists the last successful sync of the commit plugin async observers, so that a
+// restarted node observes the persisted values until its first sync completes instead of making empty observations.
+//
+// The Store is provided by the node, usually the DBStore backed by its database. Persisted values are scoped by the DON
+// and the OCR config digest of the plugin and are only loaded while they are within the Config.MaxAge freshness window.
+// The age of the values served by an observer is exposed through the ccip_async_observer_staleness_seconds gauge.
+package observationstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Entry is a persisted observer sync result.
+type Entry struct {
+	// Data is the JSON encoded result of the sync.
+	Data []byte
+	// SyncedAt is when the sync completed.
+	SyncedAt time.Time
+}
+
+// Store persists the last Entry of every key.
+type Store interface {
+	// Load returns false when nothing was saved for the key.
+	Load(ctx context.Context, key string) (Entry, bool, error)
+	// Save replaces the entry of the key.
+	Save(ctx context.Context, key string, entry Entry) error
+}
+
+// Config configures the persistence of the async observers, the zero value disables it.
+type Config struct {
+	// Store is optional, observers keep their sync results only in memory when it is not set.
+	Store Store
+	// MaxAge is the freshness window, persisted entries older than MaxAge are not loaded at startup.
+	// Zero means persisted entries are always loaded.
+	MaxAge time.Duration
+
+	scope string
+}
+
+// WithScope returns the config of the plugin instance of the given DON and OCR config digest, so that the values
+// persisted by the plugins of different DONs or configs don't collide.
+func (c Config) WithScope(donID uint32, configDigest [32]byte) Config {
+	c.scope = fmt.Sprintf("%d/%x", donID, configDigest)
+	return c
+}
+
+// MemoryStore keeps entries in memory, mostly useful in tests.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]Entry)}
+}
+
+func (s *MemoryStore) Load(_ context.Context, key string) (Entry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	return e, ok, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, key string, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = entry
+	return nil
+}
@@ -0,0 +1,135 @@
+package observationstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Observations are the values synced by an async observer, keyed by the ID of their SyncOp.
+type Observations map[string]json.RawMessage
+
+// SyncOp is one of the sync operations of an async observer.
+type SyncOp struct {
+	// ID identifies the operation and its value in the persisted Observations.
+	ID string
+	// Sync observes the value, keeps it in the observer and returns it.
+	Sync func(ctx context.Context) any
+	// Restore keeps the JSON encoded value of a previous sync in the observer.
+	Restore func(data []byte) error
+}
+
+// NewSyncOp creates the SyncOp of a value of type T. observe is called on every sync and set keeps both the observed
+// and the restored values in the observer.
+func NewSyncOp[T any](id string, observe func(ctx context.Context) T, set func(T)) SyncOp {
+	return SyncOp{
+		ID: id,
+		Sync: func(ctx context.Context) any {
+			v := observe(ctx)
+			set(v)
+			return v
+		},
+		Restore: func(data []byte) error {
+			var v T
+			if err := json.Unmarshal(data, &v); err != nil {
+				return err
+			}
+			set(v)
+			return nil
+		},
+	}
+}
+
+// Syncer runs the sync operations of an async observer and persists their results with its Cache.
+//
+// The observers report read failures as empty values, so a sync is only persisted when every operation completed
+// within the sync timeout and observed a non-empty value. A failed sync never replaces the last persisted one.
+type Syncer struct {
+	lggr  logger.Logger
+	cache *Cache[Observations]
+	ops   []SyncOp
+}
+
+// NewSyncer creates the Syncer of the given operations.
+func NewSyncer(lggr logger.Logger, cache *Cache[Observations], ops ...SyncOp) *Syncer {
+	return &Syncer{
+		lggr:  lggr,
+		cache: cache,
+		ops:   ops,
+	}
+}
+
+// Restore keeps the persisted values in the observer if they are still fresh.
+func (s *Syncer) Restore(ctx context.Context) {
+	persisted, ok := s.cache.Load(ctx)
+	if !ok {
+		return
+	}
+	for _, op := range s.ops {
+		data, ok := persisted[op.ID]
+		if !ok {
+			continue
+		}
+		if err := op.Restore(data); err != nil {
+			s.lggr.Warnw("failed to restore persisted observations", "id", op.ID, "err", err)
+		}
+	}
+}
+
+// Sync runs the operations concurrently, bounded by syncTimeout, and persists their results.
+func (s *Syncer) Sync(ctx context.Context, syncTimeout time.Duration) {
+	defer s.cache.TrackStaleness()
+
+	ctxSync, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+
+	results := make([]any, len(s.ops))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(s.ops))
+	for i, op := range s.ops {
+		go func() {
+			defer wg.Done()
+			tStart := time.Now()
+			s.lggr.Debugw("async observer applying sync operation", "id", op.ID)
+			results[i] = op.Sync(ctxSync)
+			s.lggr.Debugw("async observer has applied the sync operation",
+				"id", op.ID, "duration", time.Since(tStart))
+		}()
+	}
+	wg.Wait()
+
+	if err := ctxSync.Err(); err != nil {
+		s.lggr.Warnw("async observer sync did not complete, not persisting observations", "err", err)
+		return
+	}
+
+	synced := make(Observations, len(s.ops))
+	for i, op := range s.ops {
+		data, err := json.Marshal(results[i])
+		if err != nil {
+			s.lggr.Warnw("failed to encode observations", "id", op.ID, "err", err)
+			return
+		}
+		if isEmpty(data) {
+			s.lggr.Infow("async observer sync operation observed nothing, not persisting observations", "id", op.ID)
+			return
+		}
+		synced[op.ID] = data
+	}
+	s.cache.Save(ctx, synced)
+}
+
+// isEmpty returns true for the encoding of nil and empty values.
+func isEmpty(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	for _, empty := range []string{"null", "{}", "[]", `""`} {
+		if string(data) == empty {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package observationstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type prices map[cciptypes.ChainSelector]cciptypes.BigInt
+
+func TestSyncer_Sync(t *testing.T) {
+	observed := prices{1: cciptypes.NewBigIntFromInt64(100)}
+	seqNums := []uint64{1, 2}
+
+	testCases := []struct {
+		name       string
+		prices     prices
+		seqNums    []uint64
+		slowOp     bool
+		expPersist bool
+	}{
+		{name: "all values observed", prices: observed, seqNums: seqNums, expPersist: true},
+		{name: "empty map", prices: prices{}, seqNums: seqNums, expPersist: false},
+		{name: "nil slice", prices: observed, seqNums: nil, expPersist: false},
+		{name: "sync timeout", prices: observed, seqNums: seqNums, slowOp: true, expPersist: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+			lggr := logger.Test(t)
+			cfg := Config{Store: NewMemoryStore()}
+
+			var gotPrices prices
+			var gotSeqNums []uint64
+			syncer := NewSyncer(lggr, NewCache[Observations](lggr, cfg, 1, "merkleroot"),
+				NewSyncOp("prices",
+					func(ctx context.Context) prices {
+						if tc.slowOp {
+							<-ctx.Done()
+						}
+						return tc.prices
+					},
+					func(v prices) { gotPrices = v }),
+				NewSyncOp("seqNums",
+					func(context.Context) []uint64 { return tc.seqNums },
+					func(v []uint64) { gotSeqNums = v }),
+			)
+			syncer.Sync(ctx, 50*time.Millisecond)
+
+			// the synced values are always served by the observer
+			assert.Equal(t, tc.prices, gotPrices)
+			assert.Equal(t, tc.seqNums, gotSeqNums)
+
+			// a restarted node
+			var restoredPrices prices
+			var restoredSeqNums []uint64
+			NewSyncer(lggr, NewCache[Observations](lggr, cfg, 1, "merkleroot"),
+				NewSyncOp("prices",
+					func(context.Context) prices { return nil },
+					func(v prices) { restoredPrices = v }),
+				NewSyncOp("seqNums",
+					func(context.Context) []uint64 { return nil },
+					func(v []uint64) { restoredSeqNums = v }),
+			).Restore(ctx)
+
+			if !tc.expPersist {
+				assert.Nil(t, restoredPrices)
+				assert.Nil(t, restoredSeqNums)
+				return
+			}
+			require.Equal(t, tc.prices, restoredPrices)
+			require.Equal(t, tc.seqNums, restoredSeqNums)
+		})
+	}
+}
+
+func TestSyncer_FailedSyncKeepsLastPersisted(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	cfg := Config{Store: NewMemoryStore()}
+	cache := NewCache[Observations](lggr, cfg, 1, "chainfee")
+
+	observed := prices{1: cciptypes.NewBigIntFromInt64(100)}
+	op := func(v *prices) SyncOp {
+		return NewSyncOp("prices", func(context.Context) prices { return observed }, func(p prices) { *v = p })
+	}
+
+	var served prices
+	syncer := NewSyncer(lggr, cache, op(&served))
+	syncer.Sync(ctx, time.Second)
+
+	// the next sync fails to read the prices
+	observed = prices{}
+	syncer.Sync(ctx, time.Second)
+	assert.Empty(t, served)
+
+	var restored prices
+	NewSyncer(lggr, NewCache[Observations](lggr, cfg, 1, "chainfee"), op(&restored)).Restore(ctx)
+	assert.Equal(t, prices{1: cciptypes.NewBigIntFromInt64(100)}, restored)
+}
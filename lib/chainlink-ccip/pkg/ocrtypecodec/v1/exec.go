@@ -41,7 +41,8 @@ func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([
 		TokenDataObservations: &ocrtypecodecpb.TokenDataObservations{
 			TokenData: e.tr.tokenDataObservationsToProto(observation.TokenData),
 		},
-		Nonces: e.tr.nonceObservationsToProto(observation.Nonces),
+		Nonces:   e.tr.nonceObservationsToProto(observation.Nonces),
+		ExecCost: e.tr.execCostObservationToProto(observation.ExecCost),
 		Contracts: &ocrtypecodecpb.DiscoveryObservation{
 			FChain: e.tr.fChainToProto(observation.Contracts.FChain),
 			ContractNames: &ocrtypecodecpb.ContractNameChainAddresses{
@@ -70,6 +71,7 @@ func (e *ExecCodecProto) DecodeObservation(data []byte) (exectypes.Observation,
 		Hashes:        e.tr.messageHashesFromProto(pbObs.MsgHashes),
 		TokenData:     e.tr.tokenDataObservationsFromProto(pbObs.TokenDataObservations.TokenData),
 		Nonces:        e.tr.nonceObservationsFromProto(pbObs.Nonces),
+		ExecCost:      e.tr.execCostObservationFromProto(pbObs.ExecCost),
 		Contracts: discoverytypes.Observation{
 			FChain:    e.tr.fChainFromProto(pbObs.Contracts.FChain),
 			Addresses: e.tr.discoveryAddressesFromProto(pbObs.Contracts.ContractNames.Addresses),
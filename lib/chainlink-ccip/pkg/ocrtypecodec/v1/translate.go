@@ -880,6 +880,93 @@ func (t *protoTranslator) nonceObservationsFromProto(
 	return nonces
 }
 
+func (t *protoTranslator) execCostObservationToProto(
+	observation exectypes.ExecCostObservation,
+) *ocrtypecodecpb.ExecCostObservation {
+	pbObservation := &ocrtypecodecpb.ExecCostObservation{
+		DestExecutionFee:        observation.DestExecutionFee.Bytes(),
+		DestDataAvailabilityFee: observation.DestDataAvailabilityFee.Bytes(),
+		DestNativePriceUsd:      observation.DestNativePriceUSD.Bytes(),
+	}
+	if !observation.Timestamp.IsZero() {
+		pbObservation.Timestamp = timestamppb.New(observation.Timestamp)
+	}
+
+	if len(observation.FeeTokenPrices) > 0 {
+		pbObservation.FeeTokenPrices = make(map[uint64]*ocrtypecodecpb.FeeTokenPrices, len(observation.FeeTokenPrices))
+	}
+	for chainSel, prices := range observation.FeeTokenPrices {
+		pbPrices := make(map[string][]byte, len(prices))
+		for token, price := range prices {
+			pbPrices[token] = price.Bytes()
+		}
+		pbObservation.FeeTokenPrices[uint64(chainSel)] = &ocrtypecodecpb.FeeTokenPrices{Prices: pbPrices}
+	}
+
+	if len(observation.DAGasConfigs) > 0 {
+		pbObservation.DaGasConfigs = make(map[uint64]*ocrtypecodecpb.DataAvailabilityGasConfig, len(observation.DAGasConfigs))
+	}
+	for chainSel, cfg := range observation.DAGasConfigs {
+		pbObservation.DaGasConfigs[uint64(chainSel)] = &ocrtypecodecpb.DataAvailabilityGasConfig{
+			DestDataAvailabilityOverheadGas:   cfg.DestDataAvailabilityOverheadGas,
+			DestGasPerDataAvailabilityByte:    uint32(cfg.DestGasPerDataAvailabilityByte),
+			DestDataAvailabilityMultiplierBps: uint32(cfg.DestDataAvailabilityMultiplierBps),
+		}
+	}
+
+	return pbObservation
+}
+
+func (t *protoTranslator) execCostObservationFromProto(
+	pbObservation *ocrtypecodecpb.ExecCostObservation,
+) exectypes.ExecCostObservation {
+	if pbObservation == nil {
+		return exectypes.ExecCostObservation{}
+	}
+
+	observation := exectypes.ExecCostObservation{
+		DestExecutionFee:        t.optionalBigIntFromProto(pbObservation.DestExecutionFee),
+		DestDataAvailabilityFee: t.optionalBigIntFromProto(pbObservation.DestDataAvailabilityFee),
+		DestNativePriceUSD:      t.optionalBigIntFromProto(pbObservation.DestNativePriceUsd),
+	}
+	if pbObservation.Timestamp != nil {
+		observation.Timestamp = pbObservation.Timestamp.AsTime()
+	}
+
+	if len(pbObservation.FeeTokenPrices) > 0 {
+		observation.FeeTokenPrices = make(exectypes.FeeTokenPrices, len(pbObservation.FeeTokenPrices))
+	}
+	for chainSel, pbPrices := range pbObservation.FeeTokenPrices {
+		prices := make(map[string]cciptypes.BigInt, len(pbPrices.Prices))
+		for token, price := range pbPrices.Prices {
+			prices[token] = cciptypes.NewBigInt(big.NewInt(0).SetBytes(price))
+		}
+		observation.FeeTokenPrices[cciptypes.ChainSelector(chainSel)] = prices
+	}
+
+	if len(pbObservation.DaGasConfigs) > 0 {
+		observation.DAGasConfigs = make(
+			map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig, len(pbObservation.DaGasConfigs))
+	}
+	for chainSel, pbCfg := range pbObservation.DaGasConfigs {
+		observation.DAGasConfigs[cciptypes.ChainSelector(chainSel)] = cciptypes.DataAvailabilityGasConfig{
+			DestDataAvailabilityOverheadGas:   pbCfg.GetDestDataAvailabilityOverheadGas(),
+			DestGasPerDataAvailabilityByte:    uint16(pbCfg.GetDestGasPerDataAvailabilityByte()),
+			DestDataAvailabilityMultiplierBps: uint16(pbCfg.GetDestDataAvailabilityMultiplierBps()),
+		}
+	}
+
+	return observation
+}
+
+// optionalBigIntFromProto keeps values that were not observed empty, rather than decoding them as zero.
+func (t *protoTranslator) optionalBigIntFromProto(b []byte) cciptypes.BigInt {
+	if len(b) == 0 {
+		return cciptypes.BigInt{}
+	}
+	return cciptypes.NewBigInt(big.NewInt(0).SetBytes(b))
+}
+
 func (t *protoTranslator) chainReportsToProto(
 	reports []cciptypes.ExecutePluginReportSingleChain,
 ) []*ocrtypecodecpb.ChainReport {
@@ -464,12 +464,22 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	tokenDataObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]exectypes.MessageTokenData)
 	msgHashObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Bytes32)
 	nonces := make(map[cciptypes.ChainSelector]map[string]uint64)
+	feeTokenPrices := make(exectypes.FeeTokenPrices)
+	daGasConfigs := make(map[cciptypes.ChainSelector]cciptypes.DataAvailabilityGasConfig)
 	msgObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Message)
 	for i := 0; i < d.numSourceChains; i++ {
 		chainSel := cciptypes.ChainSelector(rand.Uint64())
 		nonces[chainSel] = map[string]uint64{
 			genRandomString(5): rand.Uint64(),
 		}
+		feeTokenPrices[chainSel] = map[string]cciptypes.BigInt{
+			genRandomString(5): cciptypes.NewBigInt(big.NewInt(rand.Int63())),
+		}
+		daGasConfigs[chainSel] = cciptypes.DataAvailabilityGasConfig{
+			DestDataAvailabilityOverheadGas:   rand.Uint32(),
+			DestGasPerDataAvailabilityByte:    uint16(rand.Uint32()),
+			DestDataAvailabilityMultiplierBps: uint16(rand.Uint32()),
+		}
 
 		tokenDataObservations[chainSel] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData, d.numMessagesPerChain)
 		msgHashObservations[chainSel] = make(map[cciptypes.SeqNum]cciptypes.Bytes32, d.numMessagesPerChain)
@@ -531,8 +541,16 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 		Hashes:        msgHashObservations,
 		TokenData:     tokenDataObservations,
 		Nonces:        nonces,
-		Contracts:     discoveryObs,
-		FChain:        discoveryObs.FChain,
+		ExecCost: exectypes.ExecCostObservation{
+			DestExecutionFee:        cciptypes.NewBigInt(big.NewInt(rand.Int63())),
+			DestDataAvailabilityFee: cciptypes.NewBigInt(big.NewInt(rand.Int63())),
+			DestNativePriceUSD:      cciptypes.NewBigInt(big.NewInt(rand.Int63())),
+			FeeTokenPrices:          feeTokenPrices,
+			Timestamp:               time.Now().UTC(),
+			DAGasConfigs:            daGasConfigs,
+		},
+		Contracts: discoveryObs,
+		FChain:    discoveryObs.FChain,
 	}
 }
 
@@ -0,0 +1,474 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	sel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const (
+	cacheMethodSourceChainsConfig = "GetOffRampSourceChainsConfig"
+	cacheMethodRMNRemoteConfig    = "GetRMNRemoteConfig"
+	cacheMethodChainFeeComponents = "GetChainsFeeComponents"
+	cacheMethodNonces             = "Nonces"
+)
+
+var (
+	PromCCIPReaderCacheHits = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_reader_cache_hits_total",
+			Help: "This metric tracks the number of CCIPReader results served from the cache",
+		},
+		[]string{"chainID", "method"},
+	)
+	PromCCIPReaderCacheMisses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_reader_cache_misses_total",
+			Help: "This metric tracks the number of CCIPReader results that had to be read from the chain",
+		},
+		[]string{"chainID", "method"},
+	)
+	PromCCIPReaderCacheInvalidations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_reader_cache_invalidations_total",
+			Help: "This metric tracks the number of CCIPReader cache invalidations, by the event or sync that caused it",
+		},
+		[]string{"chainID", "method", "reason"},
+	)
+)
+
+// CacheConfig holds the TTLs of the CCIPReader cache, a zero TTL disables caching of the method.
+type CacheConfig struct {
+	SourceChainsConfigTTL time.Duration
+	RMNRemoteConfigTTL    time.Duration
+	ChainFeeComponentsTTL time.Duration
+	// NoncesTTL should be kept short, nonces change with every executed message and a stale nonce delays ordered
+	// execution until it expires or an execution event invalidates it.
+	NoncesTTL time.Duration
+	// EventPollInterval is how often the destination chain is polled for the events invalidating the cache,
+	// zero disables the event invalidation and entries only expire with their TTL.
+	EventPollInterval time.Duration
+}
+
+// newCacheConfig returns the CacheConfig of the CCIPReaderCache plugin config.
+func newCacheConfig(cfg pluginconfig.CCIPReaderCacheConfig) CacheConfig {
+	return CacheConfig{
+		SourceChainsConfigTTL: cfg.SourceChainsConfigTTL.Duration(),
+		RMNRemoteConfigTTL:    cfg.RMNRemoteConfigTTL.Duration(),
+		ChainFeeComponentsTTL: cfg.ChainFeeComponentsTTL.Duration(),
+		NoncesTTL:             cfg.NoncesTTL.Duration(),
+		EventPollInterval:     cfg.EventPollInterval.Duration(),
+	}
+}
+
+// cacheEventSource gives access to the destination chain contract reader queried for the invalidating events.
+type cacheEventSource interface {
+	getDestChain() cciptypes.ChainSelector
+	getContractReader(chain cciptypes.ChainSelector) (contractreader.Extended, bool)
+}
+
+// cacheInvalidationEvent is an event invalidating the cached results of a method.
+type cacheInvalidationEvent struct {
+	contract string
+	event    string
+	method   string
+}
+
+var cacheInvalidationEvents = []cacheInvalidationEvent{
+	{
+		contract: consts.ContractNameOffRamp,
+		event:    consts.EventNameSourceChainConfigSet,
+		method:   cacheMethodSourceChainsConfig,
+	},
+	// chains read before they were added are cached as disabled, not every chain family emits a config event then
+	{contract: consts.ContractNameOffRamp, event: consts.EventNameSourceChainAdded, method: cacheMethodSourceChainsConfig},
+	{contract: consts.ContractNameRMNRemote, event: consts.EventNameConfigSet, method: cacheMethodRMNRemoteConfig},
+	// executed messages increment the nonces of their senders
+	{contract: consts.ContractNameOffRamp, event: consts.EventNameExecutionStateChanged, method: cacheMethodNonces},
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache is a map whose entries expire after the ttl, a zero ttl disables it.
+//
+// Values are fetched without holding the lock, so a fetch can race with an invalidation. The generation is taken
+// before the fetch and set drops the value if the cache was invalidated since, otherwise the stale value would stay
+// cached for the whole ttl. Invalidation always drops every key, so one generation covers all of them.
+type ttlCache[K comparable, V any] struct {
+	mu         sync.RWMutex
+	ttl        time.Duration
+	entries    map[K]ttlEntry[V]
+	generation uint64
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{ttl: ttl, entries: make(map[K]ttlEntry[V])}
+}
+
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// currentGeneration returns the generation to pass to set for a value fetched from now on.
+func (c *ttlCache[K, V]) currentGeneration() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// set caches the value fetched at the generation, unless the cache was invalidated since.
+func (c *ttlCache[K, V]) set(key K, value V, generation uint64) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+func (c *ttlCache[K, V]) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[K]ttlEntry[V])
+	c.generation++
+}
+
+// nonceKey is the key of a cached nonce, a sender address on a source chain.
+type nonceKey struct {
+	chain   cciptypes.ChainSelector
+	address string
+}
+
+// cachedCCIPReader caches the results of the CCIPReader calls that are re-read every round but rarely change.
+// Cached entries expire with the TTL of their method and are invalidated when the destination chain emits an event
+// changing them, or when the reader is synced to new contracts. Errors are never cached.
+type cachedCCIPReader struct {
+	CCIPReader
+	lggr        logger.Logger
+	destChain   cciptypes.ChainSelector
+	chainID     string
+	cfg         CacheConfig
+	eventSource cacheEventSource
+
+	sourceChainsConfig *ttlCache[cciptypes.ChainSelector, StaticSourceChainConfig]
+	rmnRemoteConfig    *ttlCache[struct{}, cciptypes.RemoteConfig]
+	chainFeeComponents *ttlCache[cciptypes.ChainSelector, types.ChainFeeComponents]
+	nonces             *ttlCache[nonceKey, uint64]
+
+	// lastEventBlocks is the block of the latest invalidation event seen of every event, only accessed by the
+	// polling goroutine.
+	lastEventBlocks map[cacheInvalidationEvent]uint64
+	stopChan        chan struct{}
+	stopOnce        sync.Once
+	wg              sync.WaitGroup
+}
+
+// NewCachedCCIPReader returns a CCIPReader caching the results of the reader, entries only expire with their TTL.
+func NewCachedCCIPReader(
+	lggr logger.Logger,
+	reader CCIPReader,
+	destChain cciptypes.ChainSelector,
+	cfg CacheConfig,
+) CCIPReader {
+	return newCachedCCIPReader(lggr, reader, nil, destChain, cfg)
+}
+
+// newCachedCCIPReader returns a caching CCIPReader, when eventSource is set the cache is also invalidated by the
+// events of the destination chain.
+func newCachedCCIPReader(
+	lggr logger.Logger,
+	reader CCIPReader,
+	eventSource cacheEventSource,
+	destChain cciptypes.ChainSelector,
+	cfg CacheConfig,
+) *cachedCCIPReader {
+	chainID, err := sel.GetChainIDFromSelector(uint64(destChain))
+	if err != nil {
+		chainID = strconv.FormatUint(uint64(destChain), 10)
+	}
+
+	c := &cachedCCIPReader{
+		CCIPReader:         reader,
+		lggr:               logger.Named(lggr, "CachedCCIPReader"),
+		destChain:          destChain,
+		chainID:            chainID,
+		cfg:                cfg,
+		eventSource:        eventSource,
+		sourceChainsConfig: newTTLCache[cciptypes.ChainSelector, StaticSourceChainConfig](cfg.SourceChainsConfigTTL),
+		rmnRemoteConfig:    newTTLCache[struct{}, cciptypes.RemoteConfig](cfg.RMNRemoteConfigTTL),
+		chainFeeComponents: newTTLCache[cciptypes.ChainSelector, types.ChainFeeComponents](cfg.ChainFeeComponentsTTL),
+		nonces:             newTTLCache[nonceKey, uint64](cfg.NoncesTTL),
+		lastEventBlocks:    make(map[cacheInvalidationEvent]uint64),
+		stopChan:           make(chan struct{}),
+	}
+
+	if eventSource != nil && cfg.EventPollInterval > 0 {
+		c.startEventPolling()
+	}
+	return c
+}
+
+func (c *cachedCCIPReader) GetOffRampSourceChainsConfig(
+	ctx context.Context,
+	sourceChains []cciptypes.ChainSelector,
+) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error) {
+	generation := c.sourceChainsConfig.currentGeneration()
+	res := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(sourceChains))
+	missing := make([]cciptypes.ChainSelector, 0, len(sourceChains))
+	for _, chain := range sourceChains {
+		if cfg, ok := c.sourceChainsConfig.get(chain); ok {
+			res[chain] = cfg
+			continue
+		}
+		missing = append(missing, chain)
+	}
+	c.trackLookups(cacheMethodSourceChainsConfig, len(res), len(missing))
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	fetched, err := c.CCIPReader.GetOffRampSourceChainsConfig(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for chain, cfg := range fetched {
+		c.sourceChainsConfig.set(chain, cfg, generation)
+		res[chain] = cfg
+	}
+	return res, nil
+}
+
+func (c *cachedCCIPReader) GetRMNRemoteConfig(ctx context.Context) (cciptypes.RemoteConfig, error) {
+	generation := c.rmnRemoteConfig.currentGeneration()
+	if cfg, ok := c.rmnRemoteConfig.get(struct{}{}); ok {
+		c.trackLookups(cacheMethodRMNRemoteConfig, 1, 0)
+		return cfg, nil
+	}
+	c.trackLookups(cacheMethodRMNRemoteConfig, 0, 1)
+
+	cfg, err := c.CCIPReader.GetRMNRemoteConfig(ctx)
+	if err != nil {
+		return cciptypes.RemoteConfig{}, err
+	}
+	c.rmnRemoteConfig.set(struct{}{}, cfg, generation)
+	return cfg, nil
+}
+
+func (c *cachedCCIPReader) GetChainsFeeComponents(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	generation := c.chainFeeComponents.currentGeneration()
+	res := make(map[cciptypes.ChainSelector]types.ChainFeeComponents, len(chains))
+	missing := make([]cciptypes.ChainSelector, 0, len(chains))
+	for _, chain := range chains {
+		if fees, ok := c.chainFeeComponents.get(chain); ok {
+			res[chain] = fees
+			continue
+		}
+		missing = append(missing, chain)
+	}
+	c.trackLookups(cacheMethodChainFeeComponents, len(res), len(missing))
+	if len(missing) == 0 {
+		return res
+	}
+
+	// chains that failed to be read are missing from the result and are not cached
+	for chain, fees := range c.CCIPReader.GetChainsFeeComponents(ctx, missing) {
+		c.chainFeeComponents.set(chain, fees, generation)
+		res[chain] = fees
+	}
+	return res
+}
+
+func (c *cachedCCIPReader) Nonces(
+	ctx context.Context,
+	addressesByChain map[cciptypes.ChainSelector][]string,
+) (map[cciptypes.ChainSelector]map[string]uint64, error) {
+	generation := c.nonces.currentGeneration()
+	res := make(map[cciptypes.ChainSelector]map[string]uint64, len(addressesByChain))
+	missing := make(map[cciptypes.ChainSelector][]string)
+	var hits, misses int
+	for chain, addresses := range addressesByChain {
+		res[chain] = make(map[string]uint64, len(addresses))
+		for _, address := range addresses {
+			if nonce, ok := c.nonces.get(nonceKey{chain: chain, address: address}); ok {
+				res[chain][address] = nonce
+				hits++
+				continue
+			}
+			missing[chain] = append(missing[chain], address)
+			misses++
+		}
+	}
+	c.trackLookups(cacheMethodNonces, hits, misses)
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	fetched, err := c.CCIPReader.Nonces(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for chain, nonces := range fetched {
+		if res[chain] == nil {
+			res[chain] = make(map[string]uint64, len(nonces))
+		}
+		for address, nonce := range nonces {
+			c.nonces.set(nonceKey{chain: chain, address: address}, nonce, generation)
+			res[chain][address] = nonce
+		}
+	}
+	return res, nil
+}
+
+// Sync binds the reader to new contracts, everything read from the previous ones is dropped.
+func (c *cachedCCIPReader) Sync(ctx context.Context, contracts ContractAddresses) error {
+	if err := c.CCIPReader.Sync(ctx, contracts); err != nil {
+		return err
+	}
+	for _, method := range []string{
+		cacheMethodSourceChainsConfig, cacheMethodRMNRemoteConfig, cacheMethodChainFeeComponents, cacheMethodNonces,
+	} {
+		c.invalidate(method, "Sync")
+	}
+	return nil
+}
+
+// Close stops the event polling and closes the underlying reader.
+func (c *cachedCCIPReader) Close() error {
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+		c.wg.Wait()
+	})
+	return c.CCIPReader.Close()
+}
+
+func (c *cachedCCIPReader) invalidate(method, reason string) {
+	switch method {
+	case cacheMethodSourceChainsConfig:
+		c.sourceChainsConfig.invalidate()
+	case cacheMethodRMNRemoteConfig:
+		c.rmnRemoteConfig.invalidate()
+	case cacheMethodChainFeeComponents:
+		c.chainFeeComponents.invalidate()
+	case cacheMethodNonces:
+		c.nonces.invalidate()
+	default:
+		return
+	}
+	PromCCIPReaderCacheInvalidations.WithLabelValues(c.chainID, method, reason).Inc()
+}
+
+func (c *cachedCCIPReader) trackLookups(method string, hits, misses int) {
+	if hits > 0 {
+		PromCCIPReaderCacheHits.WithLabelValues(c.chainID, method).Add(float64(hits))
+	}
+	if misses > 0 {
+		PromCCIPReaderCacheMisses.WithLabelValues(c.chainID, method).Add(float64(misses))
+	}
+}
+
+// startEventPolling starts a goroutine that periodically polls the destination chain for the invalidating events.
+func (c *cachedCCIPReader) startEventPolling() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.cfg.EventPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.pollInvalidationEvents()
+			case <-c.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// pollInvalidationEvents invalidates the methods whose events were emitted since the previous poll.
+// Every event has a block cursor, the block of the latest event seen, so that the poll does not depend on the clocks
+// of the node and the chain. Events are not known before the first poll, which invalidates the method when the event
+// was ever emitted. Contract readers without a binding for an event fail the query, the method then only relies on
+// its TTL.
+func (c *cachedCCIPReader) pollInvalidationEvents() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.EventPollInterval)
+	defer cancel()
+
+	destReader, ok := c.eventSource.getContractReader(c.eventSource.getDestChain())
+	if !ok {
+		c.lggr.Debugw("no contract reader for the destination chain, cache invalidation relies on the TTLs")
+		return
+	}
+
+	for _, ev := range cacheInvalidationEvents {
+		expressions := []query.Expression{query.Confidence(primitives.Unconfirmed)}
+		if lastBlock, ok := c.lastEventBlocks[ev]; ok {
+			expressions = append(expressions, query.Block(strconv.FormatUint(lastBlock, 10), primitives.Gt))
+		}
+
+		seqs, err := destReader.ExtendedQueryKey(
+			ctx,
+			ev.contract,
+			query.KeyFilter{Key: ev.event, Expressions: expressions},
+			query.LimitAndSort{
+				SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+				Limit:  query.Limit{Count: 1},
+			},
+			new(map[string]any),
+		)
+		if err != nil {
+			c.lggr.Debugw("failed to query cache invalidation event",
+				"contract", ev.contract, "event", ev.event, "err", err)
+			continue
+		}
+		if len(seqs) == 0 {
+			if _, ok := c.lastEventBlocks[ev]; !ok {
+				c.lastEventBlocks[ev] = 0
+			}
+			continue
+		}
+
+		block, err := strconv.ParseUint(seqs[0].Head.Height, 10, 64)
+		if err != nil {
+			c.lggr.Warnw("failed to parse the block of the cache invalidation event",
+				"contract", ev.contract, "event", ev.event, "block", seqs[0].Head.Height, "err", err)
+			continue
+		}
+		c.lastEventBlocks[ev] = block
+		c.lggr.Debugw("invalidating cache", "method", ev.method, "event", ev.event, "block", block)
+		c.invalidate(ev.method, ev.event)
+	}
+}
+
+var _ CCIPReader = &cachedCCIPReader{}
@@ -0,0 +1,333 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	reader_mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// countingCCIPReader counts the calls reaching the chain.
+type countingCCIPReader struct {
+	CCIPReader
+	calls     map[string]int
+	rmnErr    error
+	syncCalls int
+}
+
+func (r *countingCCIPReader) GetOffRampSourceChainsConfig(
+	_ context.Context, chains []cciptypes.ChainSelector,
+) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error) {
+	r.calls[cacheMethodSourceChainsConfig] += len(chains)
+	res := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(chains))
+	for _, chain := range chains {
+		res[chain] = StaticSourceChainConfig{IsEnabled: true, OnRamp: []byte{byte(chain)}}
+	}
+	return res, nil
+}
+
+func (r *countingCCIPReader) GetRMNRemoteConfig(context.Context) (cciptypes.RemoteConfig, error) {
+	r.calls[cacheMethodRMNRemoteConfig]++
+	return cciptypes.RemoteConfig{FSign: 1}, r.rmnErr
+}
+
+func (r *countingCCIPReader) GetChainsFeeComponents(
+	_ context.Context, chains []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	r.calls[cacheMethodChainFeeComponents] += len(chains)
+	res := make(map[cciptypes.ChainSelector]types.ChainFeeComponents)
+	for _, chain := range chains {
+		// chain 3 fails to be read
+		if chain != 3 {
+			res[chain] = types.ChainFeeComponents{ExecutionFee: big.NewInt(int64(chain))}
+		}
+	}
+	return res
+}
+
+func (r *countingCCIPReader) Nonces(
+	_ context.Context, addressesByChain map[cciptypes.ChainSelector][]string,
+) (map[cciptypes.ChainSelector]map[string]uint64, error) {
+	res := make(map[cciptypes.ChainSelector]map[string]uint64)
+	for chain, addresses := range addressesByChain {
+		res[chain] = make(map[string]uint64)
+		for _, address := range addresses {
+			r.calls[cacheMethodNonces]++
+			res[chain][address] = uint64(len(address))
+		}
+	}
+	return res, nil
+}
+
+func (r *countingCCIPReader) Sync(context.Context, ContractAddresses) error {
+	r.syncCalls++
+	return nil
+}
+
+func (r *countingCCIPReader) Close() error { return nil }
+
+type stubEventSource struct {
+	destChain cciptypes.ChainSelector
+	reader    contractreader.Extended
+}
+
+func (s stubEventSource) getDestChain() cciptypes.ChainSelector { return s.destChain }
+
+func (s stubEventSource) getContractReader(cciptypes.ChainSelector) (contractreader.Extended, bool) {
+	return s.reader, s.reader != nil
+}
+
+func newTestCacheConfig(ttl time.Duration) CacheConfig {
+	return CacheConfig{
+		SourceChainsConfigTTL: ttl,
+		RMNRemoteConfigTTL:    ttl,
+		ChainFeeComponentsTTL: ttl,
+		NoncesTTL:             ttl,
+	}
+}
+
+func TestCachedCCIPReader_CachesResults(t *testing.T) {
+	ctx := tests.Context(t)
+	origin := &countingCCIPReader{calls: make(map[string]int)}
+	r := newCachedCCIPReader(logger.Test(t), origin, nil, chainC, newTestCacheConfig(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		cfgs, err := r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1, 2})
+		require.NoError(t, err)
+		require.Len(t, cfgs, 2)
+		assert.Equal(t, cciptypes.UnknownAddress{2}, cfgs[2].OnRamp)
+
+		_, err = r.GetRMNRemoteConfig(ctx)
+		require.NoError(t, err)
+
+		fees := r.GetChainsFeeComponents(ctx, []cciptypes.ChainSelector{1, 2, 3})
+		require.Len(t, fees, 2)
+
+		nonces, err := r.Nonces(ctx, map[cciptypes.ChainSelector][]string{1: {"a", "bb"}, 2: {"ccc"}})
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.ChainSelector]map[string]uint64{1: {"a": 1, "bb": 2}, 2: {"ccc": 3}}, nonces)
+	}
+
+	assert.Equal(t, 2, origin.calls[cacheMethodSourceChainsConfig])
+	assert.Equal(t, 1, origin.calls[cacheMethodRMNRemoteConfig])
+	// the failed chain is read again every time
+	assert.Equal(t, 3+1+1, origin.calls[cacheMethodChainFeeComponents])
+	assert.Equal(t, 3, origin.calls[cacheMethodNonces])
+
+	// only the missing nonces are read
+	nonces, err := r.Nonces(ctx, map[cciptypes.ChainSelector][]string{1: {"a", "dddd"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[cciptypes.ChainSelector]map[string]uint64{1: {"a": 1, "dddd": 4}}, nonces)
+	assert.Equal(t, 4, origin.calls[cacheMethodNonces])
+
+	// only the missing keys are read
+	_, err = r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1, 2, 4})
+	require.NoError(t, err)
+	assert.Equal(t, 3, origin.calls[cacheMethodSourceChainsConfig])
+
+	// sync drops everything
+	require.NoError(t, r.Sync(ctx, ContractAddresses{}))
+	assert.Equal(t, 1, origin.syncCalls)
+	_, err = r.GetRMNRemoteConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, origin.calls[cacheMethodRMNRemoteConfig])
+}
+
+func TestCachedCCIPReader_TTL(t *testing.T) {
+	ctx := tests.Context(t)
+	origin := &countingCCIPReader{calls: make(map[string]int), rmnErr: errors.New("rpc down")}
+	r := newCachedCCIPReader(logger.Test(t), origin, nil, chainC, newTestCacheConfig(50*time.Millisecond))
+
+	// errors are not cached
+	_, err := r.GetRMNRemoteConfig(ctx)
+	require.Error(t, err)
+	_, err = r.GetRMNRemoteConfig(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 2, origin.calls[cacheMethodRMNRemoteConfig])
+
+	_, err = r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+	require.NoError(t, err)
+	_, err = r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, origin.calls[cacheMethodSourceChainsConfig])
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, origin.calls[cacheMethodSourceChainsConfig])
+
+	// zero TTL disables caching
+	uncached := newCachedCCIPReader(logger.Test(t), origin, nil, chainC, CacheConfig{})
+	_, err = uncached.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+	require.NoError(t, err)
+	_, err = uncached.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+	require.NoError(t, err)
+	assert.Equal(t, 4, origin.calls[cacheMethodSourceChainsConfig])
+}
+
+func TestCachedCCIPReader_EventInvalidation(t *testing.T) {
+	ctx := tests.Context(t)
+	origin := &countingCCIPReader{calls: make(map[string]int)}
+	destReader := reader_mocks.NewMockExtended(t)
+	cfg := newTestCacheConfig(time.Hour)
+	cfg.EventPollInterval = time.Second
+	// the event source is set but polling is triggered by hand
+	r := newCachedCCIPReader(logger.Test(t), origin, nil, chainC, cfg)
+	r.eventSource = stubEventSource{destChain: chainC, reader: destReader}
+
+	readAll := func() {
+		_, err := r.GetOffRampSourceChainsConfig(ctx, []cciptypes.ChainSelector{1})
+		require.NoError(t, err)
+		_, err = r.GetRMNRemoteConfig(ctx)
+		require.NoError(t, err)
+	}
+	// eventQuery matches the query of the event, after the given block when afterBlock is set.
+	eventQuery := func(key string, afterBlock string) any {
+		return mock.MatchedBy(func(f query.KeyFilter) bool {
+			if f.Key != key {
+				return false
+			}
+			var blocks []string
+			for _, expr := range f.Expressions {
+				if b, ok := expr.Primitive.(*primitives.Block); ok {
+					require.Equal(t, primitives.Gt, b.Operator)
+					blocks = append(blocks, b.Block)
+				}
+			}
+			if afterBlock == "" {
+				return len(blocks) == 0
+			}
+			return len(blocks) == 1 && blocks[0] == afterBlock
+		})
+	}
+	readAll()
+	// no chain is added and no message is executed during the test
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+		mock.MatchedBy(func(f query.KeyFilter) bool {
+			return f.Key == consts.EventNameSourceChainAdded || f.Key == consts.EventNameExecutionStateChanged
+		}),
+		mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	// first poll: the latest source chain config event is at block 10, the RMNRemote config was never set
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+		eventQuery(consts.EventNameSourceChainConfigSet, ""), mock.Anything, mock.Anything).
+		Return([]types.Sequence{{Head: types.Head{Height: "10"}}}, nil).Once()
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameRMNRemote,
+		eventQuery(consts.EventNameConfigSet, ""), mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	r.pollInvalidationEvents()
+	readAll()
+	assert.Equal(t, 2, origin.calls[cacheMethodSourceChainsConfig])
+	assert.Equal(t, 1, origin.calls[cacheMethodRMNRemoteConfig])
+
+	// second poll: only the events after the cursors are queried, the RMNRemote config is set at block 12
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+		eventQuery(consts.EventNameSourceChainConfigSet, "10"), mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameRMNRemote,
+		eventQuery(consts.EventNameConfigSet, "0"), mock.Anything, mock.Anything).
+		Return([]types.Sequence{{Head: types.Head{Height: "12"}}}, nil).Once()
+	r.pollInvalidationEvents()
+	readAll()
+	assert.Equal(t, 2, origin.calls[cacheMethodSourceChainsConfig])
+	assert.Equal(t, 2, origin.calls[cacheMethodRMNRemoteConfig])
+
+	// third poll: a reader without a binding for the event, the source chain config only expires with its TTL
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+		eventQuery(consts.EventNameSourceChainConfigSet, "10"), mock.Anything, mock.Anything).
+		Return(nil, errors.New("no binding")).Once()
+	destReader.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameRMNRemote,
+		eventQuery(consts.EventNameConfigSet, "12"), mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	r.pollInvalidationEvents()
+	readAll()
+	assert.Equal(t, 2, origin.calls[cacheMethodSourceChainsConfig])
+	assert.Equal(t, 2, origin.calls[cacheMethodRMNRemoteConfig])
+	require.NoError(t, r.Close())
+}
+
+// blockingCCIPReader blocks GetRMNRemoteConfig until released, to invalidate the cache during the fetch.
+type blockingCCIPReader struct {
+	CCIPReader
+	fetching chan struct{}
+	release  chan struct{}
+	fsign    uint64
+}
+
+func (r *blockingCCIPReader) GetRMNRemoteConfig(context.Context) (cciptypes.RemoteConfig, error) {
+	r.fetching <- struct{}{}
+	<-r.release
+	return cciptypes.RemoteConfig{FSign: r.fsign}, nil
+}
+
+func TestCachedCCIPReader_InvalidationDuringFetch(t *testing.T) {
+	ctx := tests.Context(t)
+	origin := &blockingCCIPReader{fetching: make(chan struct{}), release: make(chan struct{}), fsign: 1}
+	r := newCachedCCIPReader(logger.Test(t), origin, nil, chainC, newTestCacheConfig(time.Hour))
+
+	done := make(chan cciptypes.RemoteConfig)
+	go func() {
+		cfg, err := r.GetRMNRemoteConfig(ctx)
+		assert.NoError(t, err)
+		done <- cfg
+	}()
+	<-origin.fetching
+	// the config changes while the previous one is being fetched
+	r.invalidate(cacheMethodRMNRemoteConfig, consts.EventNameConfigSet)
+	close(origin.release)
+	assert.Equal(t, uint64(1), (<-done).FSign)
+
+	// the stale value was not cached, the new one is fetched
+	origin.fsign = 2
+	go func() { <-origin.fetching }()
+	cfg, err := r.GetRMNRemoteConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.FSign)
+
+	// and the new one is cached
+	cfg, err = r.GetRMNRemoteConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.FSign)
+}
+
+func TestNewCCIPChainReader_CacheIsOptIn(t *testing.T) {
+	ctx := tests.Context(t)
+	newReader := func(cacheCfg *pluginconfig.CCIPReaderCacheConfig) CCIPReader {
+		return NewCCIPChainReader(ctx, logger.Test(t), nil, nil, chainC, []byte{0x1},
+			internal.NewMockAddressCodecHex(t), cacheCfg)
+	}
+	isCached := func(r CCIPReader) bool {
+		observed, ok := r.(*tracedCCIPReader).CCIPReader.(*observedCCIPReader)
+		require.True(t, ok)
+		_, cached := observed.CCIPReader.(*cachedCCIPReader)
+		return cached
+	}
+
+	assert.False(t, isCached(newReader(nil)))
+
+	cached := newReader(&pluginconfig.CCIPReaderCacheConfig{
+		RMNRemoteConfigTTL: *commonconfig.MustNewDuration(time.Minute),
+	})
+	require.True(t, isCached(cached))
+	require.NoError(t, cached.Close())
+}
@@ -921,6 +921,84 @@ func (r *ccipChainReader) GetWrappedNativeTokenPriceUSD(
 	return prices
 }
 
+// GetFeeTokenPricesUSD reads the prices of the provided fee tokens from the FeeQuoter of the given chain, fee tokens
+// of messages sent from a chain are priced by that chain's FeeQuoter.
+func (r *ccipChainReader) GetFeeTokenPricesUSD(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+	tokens []cciptypes.UnknownAddress,
+) map[string]cciptypes.BigInt {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+	prices := make(map[string]cciptypes.BigInt)
+	reader, ok := r.contractReaders[chain]
+	if !ok {
+		lggr.Warnw("contract reader not found", "chain", chain)
+		return prices
+	}
+
+	for _, token := range tokens {
+		if token.IsZeroOrEmpty() {
+			continue
+		}
+
+		var update cciptypes.TimestampedUnixBig
+		err := reader.ExtendedGetLatestValue(
+			ctx,
+			consts.ContractNameFeeQuoter,
+			consts.MethodNameFeeQuoterGetTokenPrice,
+			primitives.Unconfirmed,
+			map[string]any{
+				"token": token,
+			},
+			&update,
+		)
+		if err != nil {
+			lggr.Errorw("failed to get fee token price", "chain", chain, "token", token.String(), "err", err)
+			continue
+		}
+
+		if update.Timestamp == 0 || update.Value == nil || update.Value.Cmp(big.NewInt(0)) <= 0 {
+			lggr.Warnw("no fee token price available", "chain", chain, "token", token.String())
+			continue
+		}
+		prices[token.String()] = cciptypes.NewBigInt(update.Value)
+	}
+	return prices
+}
+
+// GetDataAvailabilityGasConfig reads the config of the destination chain from the FeeQuoter of the given chain and
+// returns its data availability gas parameters.
+func (r *ccipChainReader) GetDataAvailabilityGasConfig(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+) (cciptypes.DataAvailabilityGasConfig, error) {
+	reader, ok := r.contractReaders[chain]
+	if !ok {
+		return cciptypes.DataAvailabilityGasConfig{}, fmt.Errorf("contract reader not found for chain %d", chain)
+	}
+
+	var destChainConfig cciptypes.FeeQuoterDestChainConfig
+	err := reader.ExtendedGetLatestValue(
+		ctx,
+		consts.ContractNameFeeQuoter,
+		consts.MethodNameGetDestChainConfig,
+		primitives.Unconfirmed,
+		map[string]any{
+			"destChainSelector": r.destChain,
+		},
+		&destChainConfig,
+	)
+	if err != nil {
+		return cciptypes.DataAvailabilityGasConfig{}, fmt.Errorf("get fee quoter dest chain config: %w", err)
+	}
+
+	return cciptypes.DataAvailabilityGasConfig{
+		DestDataAvailabilityOverheadGas:   destChainConfig.DestDataAvailabilityOverheadGas,
+		DestGasPerDataAvailabilityByte:    destChainConfig.DestGasPerDataAvailabilityByte,
+		DestDataAvailabilityMultiplierBps: destChainConfig.DestDataAvailabilityMultiplierBps,
+	}, nil
+}
+
 // GetChainFeePriceUpdate Read from Destination chain FeeQuoter latest fee updates for the provided chains.
 // It unpacks the packed fee into the ChainFeeUSDPrices struct.
 // https://github.com/smartcontractkit/chainlink/blob/60e8b1181dd74b66903cf5b9a8427557b85357ec/contracts/src/v0.8/ccip/FeeQuoter.sol#L263-L263
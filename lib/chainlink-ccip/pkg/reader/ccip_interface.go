@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
 var (
@@ -117,6 +118,7 @@ func (s StaticSourceChainConfig) check() (bool /* enabled */, error) {
 	return s.IsEnabled, nil
 }
 
+// NewCCIPChainReader creates the CCIPReader of the plugins, its reads are only cached when cacheCfg is set.
 func NewCCIPChainReader(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -125,20 +127,26 @@ func NewCCIPChainReader(
 	destChain cciptypes.ChainSelector,
 	offrampAddress []byte,
 	addrCodec cciptypes.AddressCodec,
+	cacheCfg *pluginconfig.CCIPReaderCacheConfig,
 ) CCIPReader {
-	return NewObservedCCIPReader(
-		newCCIPChainReaderInternal(
-			ctx,
-			lggr,
-			contractReaders,
-			contractWriters,
-			destChain,
-			offrampAddress,
-			addrCodec,
-		),
+	chainReader := newCCIPChainReaderInternal(
+		ctx,
 		lggr,
+		contractReaders,
+		contractWriters,
 		destChain,
+		offrampAddress,
+		addrCodec,
 	)
+	var reader CCIPReader = chainReader
+	if cacheCfg != nil {
+		reader = newCachedCCIPReader(lggr, chainReader, chainReader, destChain, newCacheConfig(*cacheCfg))
+	}
+	return NewTracedCCIPReader(NewObservedCCIPReader(
+		reader,
+		lggr,
+		destChain,
+	))
 }
 
 // NewCCIPReaderWithExtendedContractReaders can be used when you want to directly provide contractreader.Extended
@@ -175,6 +183,15 @@ type CCIPReader interface {
 		confidence primitives.ConfidenceLevel,
 	) (map[cciptypes.ChainSelector][]cciptypes.SeqNum, error)
 
+	// MessageStatus finds a message sent by the source chain, by sequence number or message ID, and returns the
+	// merkle root committing it and its latest execution state on the destination chain.
+	// Returns ErrMessageNotFound if the source chain onRamp did not send the message.
+	MessageStatus(
+		ctx context.Context,
+		sourceChain cciptypes.ChainSelector,
+		lookup cciptypes.MessageLookup,
+	) (cciptypes.MessageStatus, error)
+
 	// MsgsBetweenSeqNums reads the provided chains, finds and returns ccip messages
 	// submitted between the provided sequence numbers. Messages are sorted ascending based on
 	// their timestamp and limited up to the provided limit.
@@ -225,6 +242,22 @@ type CCIPReader interface {
 		selectors []cciptypes.ChainSelector,
 	) map[cciptypes.ChainSelector]cciptypes.BigInt
 
+	// GetFeeTokenPricesUSD Gets the USD prices of the provided fee tokens from the FeeQuoter of the given chain,
+	// with the same denomination as GetWrappedNativeTokenPriceUSD. Prices are keyed by the hex encoded token address,
+	// tokens without a price are omitted.
+	GetFeeTokenPricesUSD(
+		ctx context.Context,
+		chain cciptypes.ChainSelector,
+		tokens []cciptypes.UnknownAddress,
+	) map[string]cciptypes.BigInt
+
+	// GetDataAvailabilityGasConfig Gets the data availability gas config of the destination chain from the
+	// FeeQuoter of the given chain, used to price the data availability cost of messages sent from that chain.
+	GetDataAvailabilityGasConfig(
+		ctx context.Context,
+		chain cciptypes.ChainSelector,
+	) (cciptypes.DataAvailabilityGasConfig, error)
+
 	// GetChainFeePriceUpdate Gets latest chain fee price update for the provided chains.
 	GetChainFeePriceUpdate(
 		ctx context.Context,
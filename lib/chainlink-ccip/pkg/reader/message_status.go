@@ -0,0 +1,223 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	// messageIDScanLimit is the number of latest messages sent to the destination searched for a message ID.
+	messageIDScanLimit = 256
+	// commitReportScanLimit is the number of commit reports accepted after a message was sent searched for the
+	// merkle root committing it.
+	commitReportScanLimit = 256
+)
+
+// ErrMessageNotFound is returned by MessageStatus when the message was not sent by the source chain onRamp.
+var ErrMessageNotFound = errors.New("message not found")
+
+func (r *ccipChainReader) MessageStatus(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	lookup cciptypes.MessageLookup,
+) (cciptypes.MessageStatus, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+	if err := validateExtendedReaderExistence(r.contractReaders, sourceChain, r.destChain); err != nil {
+		return cciptypes.MessageStatus{}, err
+	}
+
+	sent, err := r.sentMessage(ctx, sourceChain, lookup)
+	if err != nil {
+		return cciptypes.MessageStatus{}, err
+	}
+	seqNum := sent.Message.Header.SequenceNumber
+
+	commit, err := r.messageCommit(ctx, sourceChain, seqNum, sent.SentAt)
+	if err != nil {
+		return cciptypes.MessageStatus{}, fmt.Errorf("get commit of message %d: %w", seqNum, err)
+	}
+
+	execution, err := r.latestExecutionStateChange(ctx, sourceChain, seqNum)
+	if err != nil {
+		return cciptypes.MessageStatus{}, fmt.Errorf("get execution state of message %d: %w", seqNum, err)
+	}
+
+	lggr.Debugw("got message status", "sourceChain", sourceChain, "seqNum", seqNum,
+		"messageID", sent.Message.Header.MessageID, "committed", commit != nil, "execState", execution.State)
+	return cciptypes.MessageStatus{SentMessage: sent, Commit: commit, Execution: execution}, nil
+}
+
+// sentMessage returns the message sent by the source chain onRamp to the destination chain.
+func (r *ccipChainReader) sentMessage(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	lookup cciptypes.MessageLookup,
+) (cciptypes.SentMessage, error) {
+	onRampAddress, err := r.GetContractAddress(consts.ContractNameOnRamp, sourceChain)
+	if err != nil {
+		return cciptypes.SentMessage{}, fmt.Errorf("get onRamp address: %w", err)
+	}
+
+	byID := !lookup.MessageID.IsEmpty()
+	expressions := []query.Expression{
+		query.Comparator(consts.EventAttributeSourceChain, primitives.ValueComparator{
+			Value:    sourceChain,
+			Operator: primitives.Eq,
+		}),
+		query.Comparator(consts.EventAttributeDestChain, primitives.ValueComparator{
+			Value:    r.destChain,
+			Operator: primitives.Eq,
+		}),
+		// the status of a message is polled right after sending it
+		query.Confidence(primitives.Unconfirmed),
+	}
+	limit := uint64(messageIDScanLimit)
+	if !byID {
+		expressions = append(expressions, query.Comparator(consts.EventAttributeSequenceNumber,
+			primitives.ValueComparator{Value: lookup.SeqNum, Operator: primitives.Eq}))
+		limit = 1
+	}
+
+	seqs, err := r.contractReaders[sourceChain].ExtendedQueryKey(
+		ctx,
+		consts.ContractNameOnRamp,
+		query.KeyFilter{Key: consts.EventNameCCIPMessageSent, Expressions: expressions},
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+			Limit:  query.Limit{Count: limit},
+		},
+		&SendRequestedEvent{},
+	)
+	if err != nil {
+		return cciptypes.SentMessage{}, fmt.Errorf("failed to query onRamp: %w", err)
+	}
+
+	for _, item := range seqs {
+		ev, ok := item.Data.(*SendRequestedEvent)
+		if !ok {
+			return cciptypes.SentMessage{}, fmt.Errorf("failed to cast %T to SendRequestedEvent", item.Data)
+		}
+		if byID && ev.Message.Header.MessageID != lookup.MessageID {
+			continue
+		}
+		seqNumRange := cciptypes.NewSeqNumRange(ev.SequenceNumber, ev.SequenceNumber)
+		if err := validateSendRequestedEvent(ev, sourceChain, r.destChain, seqNumRange); err != nil {
+			return cciptypes.SentMessage{}, fmt.Errorf("validate send requested event: %w", err)
+		}
+		ev.Message.Header.OnRamp = onRampAddress
+		return cciptypes.SentMessage{Message: ev.Message, SentAt: time.Unix(int64(item.Timestamp), 0)}, nil
+	}
+	return cciptypes.SentMessage{}, ErrMessageNotFound
+}
+
+// messageCommit returns the merkle root committing the message, nil if it was not committed yet.
+func (r *ccipChainReader) messageCommit(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+	sentAt time.Time,
+) (*cciptypes.MessageCommit, error) {
+	reports, err := r.CommitReportsGTETimestamp(ctx, sentAt, primitives.Unconfirmed, commitReportScanLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, report := range reports {
+		for _, root := range report.Report.BlessedMerkleRoots {
+			if root.ChainSel == sourceChain && root.SeqNumsRange.Contains(seqNum) {
+				return &cciptypes.MessageCommit{
+					MerkleRoot: root, Blessed: true, Timestamp: report.Timestamp, BlockNum: report.BlockNum,
+				}, nil
+			}
+		}
+		for _, root := range report.Report.UnblessedMerkleRoots {
+			if root.ChainSel == sourceChain && root.SeqNumsRange.Contains(seqNum) {
+				return &cciptypes.MessageCommit{
+					MerkleRoot: root, Timestamp: report.Timestamp, BlockNum: report.BlockNum,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// latestExecutionStateChange returns the latest execution state change of the message, the zero value if it is
+// untouched. A failed message can be manually executed again, in which case it has several state changes.
+func (r *ccipChainReader) latestExecutionStateChange(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+) (cciptypes.MessageExecution, error) {
+	seqs, err := r.contractReaders[r.destChain].ExtendedQueryKey(
+		ctx,
+		consts.ContractNameOffRamp,
+		query.KeyFilter{
+			Key: consts.EventNameExecutionStateChanged,
+			Expressions: []query.Expression{
+				query.Comparator(consts.EventAttributeSourceChain, primitives.ValueComparator{
+					Value:    sourceChain,
+					Operator: primitives.Eq,
+				}),
+				query.Comparator(consts.EventAttributeSequenceNumber, primitives.ValueComparator{
+					Value:    seqNum,
+					Operator: primitives.Eq,
+				}),
+				query.Confidence(primitives.Unconfirmed),
+			},
+		},
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+			Limit:  query.Limit{Count: 1},
+		},
+		&ExecutionStateChangedEvent{},
+	)
+	if err != nil {
+		return cciptypes.MessageExecution{}, fmt.Errorf("failed to query offRamp: %w", err)
+	}
+	if len(seqs) == 0 {
+		return cciptypes.MessageExecution{}, nil
+	}
+
+	item := seqs[0]
+	ev, ok := item.Data.(*ExecutionStateChangedEvent)
+	if !ok {
+		return cciptypes.MessageExecution{}, fmt.Errorf("failed to cast %T to ExecutionStateChangedEvent", item.Data)
+	}
+	if ev.SourceChainSelector != sourceChain || ev.SequenceNumber != seqNum {
+		return cciptypes.MessageExecution{}, fmt.Errorf("got execution state change of message %d from chain %d, "+
+			"expected message %d from chain %d", ev.SequenceNumber, ev.SourceChainSelector, seqNum, sourceChain)
+	}
+
+	blockNum, err := strconv.ParseUint(item.Height, 10, 64)
+	if err != nil {
+		return cciptypes.MessageExecution{}, fmt.Errorf("failed to parse block number %s: %w", item.Height, err)
+	}
+	return cciptypes.MessageExecution{
+		State:     cciptypes.MessageExecutionState(ev.State),
+		TxHash:    txHashFromCursor(item.Cursor),
+		BlockNum:  blockNum,
+		Timestamp: time.Unix(int64(item.Timestamp), 0),
+	}, nil
+}
+
+// txHashFromCursor returns the transaction hash of an event from its contract reader cursor.
+// The EVM and Solana log pollers format cursors as "<block>-<logIndex>-<txHash>", EVM hashes and Solana signatures
+// don't contain dashes. Returns an empty string for cursors of other formats.
+func txHashFromCursor(cursor string) string {
+	parts := strings.Split(cursor, "-")
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[2]
+}
@@ -0,0 +1,154 @@
+package reader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	reader_mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestCCIPChainReader_MessageStatus(t *testing.T) {
+	const onRampAddr = "0x1000000000000000000000000000000000000000"
+	sentAt := time.Unix(1_700_000_000, 0)
+
+	sentEvent := func(seqNum cciptypes.SeqNum, msgID cciptypes.Bytes32) types.Sequence {
+		return types.Sequence{
+			Head: types.Head{Timestamp: uint64(sentAt.Unix())},
+			Data: &SendRequestedEvent{
+				DestChainSelector: chainC,
+				SequenceNumber:    seqNum,
+				Message: cciptypes.Message{
+					Header: cciptypes.RampMessageHeader{
+						MessageID:           msgID,
+						SourceChainSelector: chainA,
+						DestChainSelector:   chainC,
+						SequenceNumber:      seqNum,
+					},
+					Sender:         cciptypes.UnknownAddress{0x1},
+					Receiver:       cciptypes.UnknownAddress{0x2},
+					FeeToken:       cciptypes.UnknownAddress{0x3},
+					FeeTokenAmount: cciptypes.NewBigIntFromInt64(1),
+				},
+			},
+		}
+	}
+	commitEvent := types.Sequence{
+		Head: types.Head{Height: "100", Timestamp: uint64(sentAt.Add(time.Minute).Unix())},
+		Data: &CommitReportAcceptedEvent{
+			UnblessedMerkleRoots: []MerkleRoot{{
+				SourceChainSelector: uint64(chainA),
+				OnRampAddress:       cciptypes.UnknownAddress{0x1},
+				MinSeqNr:            10,
+				MaxSeqNr:            20,
+				MerkleRoot:          cciptypes.Bytes32{0xaa},
+			}},
+		},
+	}
+	execEvent := types.Sequence{
+		Cursor: "120-3-0xabcdef",
+		Head:   types.Head{Height: "120", Timestamp: uint64(sentAt.Add(2 * time.Minute).Unix())},
+		Data: &ExecutionStateChangedEvent{
+			SourceChainSelector: chainA,
+			SequenceNumber:      15,
+			State:               uint8(cciptypes.MessageExecutionStateFailure),
+		},
+	}
+
+	keyFilter := func(key string) any {
+		return mock.MatchedBy(func(f query.KeyFilter) bool { return f.Key == key })
+	}
+	newReader := func(t *testing.T) (*ccipChainReader, *reader_mocks.MockExtended, *reader_mocks.MockExtended) {
+		sourceCR := reader_mocks.NewMockExtended(t)
+		destCR := reader_mocks.NewMockExtended(t)
+		sourceCR.EXPECT().GetBindings(consts.ContractNameOnRamp).Return([]contractreader.ExtendedBoundContract{{
+			BoundAt: time.Now(),
+			Binding: types.BoundContract{Address: onRampAddr, Name: consts.ContractNameOnRamp},
+		}}).Maybe()
+		return &ccipChainReader{
+			lggr:            logger.Test(t),
+			destChain:       chainC,
+			contractReaders: map[cciptypes.ChainSelector]contractreader.Extended{chainA: sourceCR, chainC: destCR},
+			addrCodec:       internal.NewMockAddressCodecHex(t),
+		}, sourceCR, destCR
+	}
+
+	t.Run("committed and failed by sequence number", func(t *testing.T) {
+		r, sourceCR, destCR := newReader(t)
+		sourceCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOnRamp,
+			keyFilter(consts.EventNameCCIPMessageSent), query.LimitAndSort{
+				SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+				Limit:  query.Limit{Count: 1},
+			}, mock.Anything).Return([]types.Sequence{sentEvent(15, cciptypes.Bytes32{0x15})}, nil)
+		destCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+			keyFilter(consts.EventNameCommitReportAccepted), mock.Anything, mock.Anything).
+			Return([]types.Sequence{commitEvent}, nil)
+		destCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+			keyFilter(consts.EventNameExecutionStateChanged), mock.Anything, mock.Anything).
+			Return([]types.Sequence{execEvent}, nil)
+
+		status, err := r.MessageStatus(tests.Context(t), chainA, cciptypes.MessageLookup{SeqNum: 15})
+		require.NoError(t, err)
+		assert.Equal(t, cciptypes.Bytes32{0x15}, status.Message.Header.MessageID)
+		assert.Equal(t, sentAt, status.SentAt)
+		require.NotNil(t, status.Commit)
+		assert.Equal(t, cciptypes.Bytes32{0xaa}, status.Commit.MerkleRoot.MerkleRoot)
+		assert.False(t, status.Commit.Blessed)
+		assert.Equal(t, uint64(100), status.Commit.BlockNum)
+		assert.Equal(t, cciptypes.MessageExecution{
+			State:     cciptypes.MessageExecutionStateFailure,
+			TxHash:    "0xabcdef",
+			BlockNum:  120,
+			Timestamp: sentAt.Add(2 * time.Minute),
+		}, status.Execution)
+	})
+
+	t.Run("pending by message ID", func(t *testing.T) {
+		r, sourceCR, destCR := newReader(t)
+		sourceCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOnRamp,
+			keyFilter(consts.EventNameCCIPMessageSent), mock.Anything, mock.Anything).
+			Return([]types.Sequence{sentEvent(22, cciptypes.Bytes32{0x22}), sentEvent(21, cciptypes.Bytes32{0x21})}, nil)
+		destCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+			keyFilter(consts.EventNameCommitReportAccepted), mock.Anything, mock.Anything).
+			Return([]types.Sequence{commitEvent}, nil)
+		destCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp,
+			keyFilter(consts.EventNameExecutionStateChanged), mock.Anything, mock.Anything).
+			Return(nil, nil)
+
+		status, err := r.MessageStatus(tests.Context(t), chainA, cciptypes.MessageLookup{MessageID: cciptypes.Bytes32{0x21}})
+		require.NoError(t, err)
+		assert.Equal(t, cciptypes.SeqNum(21), status.Message.Header.SequenceNumber)
+		assert.Nil(t, status.Commit)
+		assert.Equal(t, cciptypes.MessageExecution{}, status.Execution)
+		assert.Equal(t, "untouched", status.Execution.State.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r, sourceCR, _ := newReader(t)
+		sourceCR.EXPECT().ExtendedQueryKey(mock.Anything, consts.ContractNameOnRamp,
+			keyFilter(consts.EventNameCCIPMessageSent), mock.Anything, mock.Anything).
+			Return([]types.Sequence{sentEvent(22, cciptypes.Bytes32{0x22})}, nil)
+
+		_, err := r.MessageStatus(tests.Context(t), chainA, cciptypes.MessageLookup{MessageID: cciptypes.Bytes32{0x99}})
+		require.ErrorIs(t, err, ErrMessageNotFound)
+	})
+}
+
+func Test_txHashFromCursor(t *testing.T) {
+	assert.Equal(t, "0xabc", txHashFromCursor("12-1-0xabc"))
+	solanaSig := "5VERv8NMvzbJMEkV8xnrLkEaWRtSz9CosKDYjCJjBRnb"
+	assert.Equal(t, solanaSig, txHashFromCursor("7-0-"+solanaSig))
+	assert.Empty(t, txHashFromCursor("opaque"))
+}
@@ -0,0 +1,222 @@
+package reader
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	attrChain     = attribute.Key("ccip.chain")
+	attrNumChains = attribute.Key("ccip.chains")
+)
+
+// tracedCCIPReader starts a span for every CCIPReader call.
+type tracedCCIPReader struct {
+	CCIPReader
+}
+
+func NewTracedCCIPReader(reader CCIPReader) CCIPReader {
+	return &tracedCCIPReader{CCIPReader: reader}
+}
+
+func (t *tracedCCIPReader) CommitReportsGTETimestamp(
+	ctx context.Context,
+	ts time.Time,
+	confidence primitives.ConfidenceLevel,
+	limit int,
+) (reports []cciptypes.CommitPluginReportWithMeta, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.CommitReportsGTETimestamp", attribute.Int("ccip.limit", limit))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.CommitReportsGTETimestamp(ctx, ts, confidence, limit)
+}
+
+func (t *tracedCCIPReader) ExecutedMessages(
+	ctx context.Context,
+	rangesPerChain map[cciptypes.ChainSelector][]cciptypes.SeqNumRange,
+	confidence primitives.ConfidenceLevel,
+) (executed map[cciptypes.ChainSelector][]cciptypes.SeqNum, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.ExecutedMessages", attrNumChains.Int(len(rangesPerChain)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.ExecutedMessages(ctx, rangesPerChain, confidence)
+}
+
+func (t *tracedCCIPReader) MessageStatus(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	lookup cciptypes.MessageLookup,
+) (status cciptypes.MessageStatus, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.MessageStatus", attrChain.Int64(int64(sourceChain)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.MessageStatus(ctx, sourceChain, lookup)
+}
+
+func (t *tracedCCIPReader) MsgsBetweenSeqNums(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+	seqNumRange cciptypes.SeqNumRange,
+) (msgs []cciptypes.Message, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.MsgsBetweenSeqNums",
+		attrChain.Int64(int64(chain)), attribute.String("ccip.seq_num_range", seqNumRange.String()))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.MsgsBetweenSeqNums(ctx, chain, seqNumRange)
+}
+
+func (t *tracedCCIPReader) LatestMsgSeqNum(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+) (seqNum cciptypes.SeqNum, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.LatestMsgSeqNum", attrChain.Int64(int64(chain)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.LatestMsgSeqNum(ctx, chain)
+}
+
+func (t *tracedCCIPReader) GetExpectedNextSequenceNumber(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+) (seqNum cciptypes.SeqNum, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetExpectedNextSequenceNumber",
+		attrChain.Int64(int64(sourceChainSelector)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetExpectedNextSequenceNumber(ctx, sourceChainSelector)
+}
+
+func (t *tracedCCIPReader) NextSeqNum(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+) (seqNums map[cciptypes.ChainSelector]cciptypes.SeqNum, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.NextSeqNum", attrNumChains.Int(len(chains)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.NextSeqNum(ctx, chains)
+}
+
+func (t *tracedCCIPReader) Nonces(
+	ctx context.Context,
+	addressesByChain map[cciptypes.ChainSelector][]string,
+) (nonces map[cciptypes.ChainSelector]map[string]uint64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.Nonces", attrNumChains.Int(len(addressesByChain)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.Nonces(ctx, addressesByChain)
+}
+
+func (t *tracedCCIPReader) GetChainsFeeComponents(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetChainsFeeComponents", attrNumChains.Int(len(chains)))
+	defer span.End()
+	return t.CCIPReader.GetChainsFeeComponents(ctx, chains)
+}
+
+func (t *tracedCCIPReader) GetDestChainFeeComponents(
+	ctx context.Context,
+) (fees types.ChainFeeComponents, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetDestChainFeeComponents")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetDestChainFeeComponents(ctx)
+}
+
+func (t *tracedCCIPReader) GetWrappedNativeTokenPriceUSD(
+	ctx context.Context,
+	selectors []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]cciptypes.BigInt {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetWrappedNativeTokenPriceUSD", attrNumChains.Int(len(selectors)))
+	defer span.End()
+	return t.CCIPReader.GetWrappedNativeTokenPriceUSD(ctx, selectors)
+}
+
+func (t *tracedCCIPReader) GetFeeTokenPricesUSD(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+	tokens []cciptypes.UnknownAddress,
+) map[string]cciptypes.BigInt {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetFeeTokenPricesUSD", attrChain.Int64(int64(chain)))
+	defer span.End()
+	return t.CCIPReader.GetFeeTokenPricesUSD(ctx, chain, tokens)
+}
+
+func (t *tracedCCIPReader) GetDataAvailabilityGasConfig(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+) (cciptypes.DataAvailabilityGasConfig, error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetDataAvailabilityGasConfig", attrChain.Int64(int64(chain)))
+	defer span.End()
+	return t.CCIPReader.GetDataAvailabilityGasConfig(ctx, chain)
+}
+
+func (t *tracedCCIPReader) GetChainFeePriceUpdate(
+	ctx context.Context,
+	selectors []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]cciptypes.TimestampedBig {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetChainFeePriceUpdate", attrNumChains.Int(len(selectors)))
+	defer span.End()
+	return t.CCIPReader.GetChainFeePriceUpdate(ctx, selectors)
+}
+
+func (t *tracedCCIPReader) GetRMNRemoteConfig(ctx context.Context) (cfg cciptypes.RemoteConfig, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetRMNRemoteConfig")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetRMNRemoteConfig(ctx)
+}
+
+func (t *tracedCCIPReader) GetRmnCurseInfo(ctx context.Context) (info CurseInfo, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetRmnCurseInfo")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetRmnCurseInfo(ctx)
+}
+
+func (t *tracedCCIPReader) DiscoverContracts(
+	ctx context.Context,
+	allChains []cciptypes.ChainSelector,
+) (addrs ContractAddresses, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.DiscoverContracts", attrNumChains.Int(len(allChains)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.DiscoverContracts(ctx, allChains)
+}
+
+func (t *tracedCCIPReader) LinkPriceUSD(ctx context.Context) (price cciptypes.BigInt, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.LinkPriceUSD")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.LinkPriceUSD(ctx)
+}
+
+func (t *tracedCCIPReader) Sync(ctx context.Context, contracts ContractAddresses) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.Sync")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.Sync(ctx, contracts)
+}
+
+func (t *tracedCCIPReader) GetLatestPriceSeqNr(ctx context.Context) (seqNr uint64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetLatestPriceSeqNr")
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetLatestPriceSeqNr(ctx)
+}
+
+func (t *tracedCCIPReader) GetOffRampConfigDigest(
+	ctx context.Context,
+	pluginType uint8,
+) (digest [32]byte, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetOffRampConfigDigest",
+		attribute.Int("ccip.plugin_type", int(pluginType)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetOffRampConfigDigest(ctx, pluginType)
+}
+
+func (t *tracedCCIPReader) GetOffRampSourceChainsConfig(
+	ctx context.Context,
+	sourceChains []cciptypes.ChainSelector,
+) (cfgs map[cciptypes.ChainSelector]StaticSourceChainConfig, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CCIPReader.GetOffRampSourceChainsConfig",
+		attrNumChains.Int(len(sourceChains)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.CCIPReader.GetOffRampSourceChainsConfig(ctx, sourceChains)
+}
+
+var _ CCIPReader = &tracedCCIPReader{}
@@ -0,0 +1,53 @@
+package reader_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	mock_reader "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_TracedCCIPReader(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	origin := mock_reader.NewMockCCIPReader(t)
+	r := reader.NewTracedCCIPReader(origin)
+	ctx := tracing.WithRound(tests.Context(t), types.ConfigDigest{1}, 7)
+
+	chains := []cciptypes.ChainSelector{1, 2}
+	origin.EXPECT().NextSeqNum(mock.Anything, chains).
+		Return(map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 10, 2: 20}, nil)
+	origin.EXPECT().GetRMNRemoteConfig(mock.Anything).Return(cciptypes.RemoteConfig{}, errors.New("rpc down"))
+
+	seqNums, err := r.NextSeqNum(ctx, chains)
+	require.NoError(t, err)
+	require.Len(t, seqNums, 2)
+	_, err = r.GetRMNRemoteConfig(ctx)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "CCIPReader.NextSeqNum", spans[0].Name())
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	require.Contains(t, spans[0].Attributes(), tracing.AttrSeqNr.Int64(7))
+	require.Contains(t, spans[0].Attributes(), tracing.AttrConfigDigest.String(types.ConfigDigest{1}.Hex()))
+
+	require.Equal(t, "CCIPReader.GetRMNRemoteConfig", spans[1].Name())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -0,0 +1,231 @@
+// Package roundrecorder records the inputs and results of OCR rounds of the CCIP plugins and replays them against a
+// plugin instance to reproduce an outcome.
+//
+// A Round holds the query, attributed observations, outcome and reports exactly as exchanged by the plugin, i.e.
+// encoded with the plugin ocrtypecodec (pkg/ocrtypecodec/v1). Rounds are persisted by a Sink, FileSink writes them
+// as JSON lines to be read back with ReadRounds.
+//
+// Recording is opt-in, a node enables it by setting RoundRecorder in the commit and execute factory params. Recorded
+// rounds are replayed with cmd/roundreplay, which rebuilds the plugins with commit.NewReplayPlugin and
+// execute.NewReplayPlugin from the recorded config and readers mocked from a Snapshot.
+package roundrecorder
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// maxPendingRounds bounds the rounds waiting for their Reports call, outcomes of rounds that never reach the reports
+// phase on this node are dropped.
+const maxPendingRounds = 16
+
+// Names of the recorded plugins.
+const (
+	PluginCommit  = "commit"
+	PluginExecute = "execute"
+)
+
+// Round is one recorded OCR round.
+type Round struct {
+	Plugin       string               `json:"plugin"`
+	ConfigDigest string               `json:"configDigest"`
+	OracleID     commontypes.OracleID `json:"oracleID"`
+	SeqNr        uint64               `json:"seqNr"`
+	Epoch        uint64               `json:"epoch"`
+	Round        uint64               `json:"round"`
+	RecordedAt   time.Time            `json:"recordedAt"`
+
+	// N, F and OffchainConfig are the reporting plugin config of the instance, a replay rebuilds the plugin from them.
+	N              int    `json:"n"`
+	F              int    `json:"f"`
+	OffchainConfig []byte `json:"offchainConfig"`
+
+	PreviousOutcome []byte        `json:"previousOutcome"`
+	Query           []byte        `json:"query"`
+	Observations    []Observation `json:"observations"`
+	Outcome         []byte        `json:"outcome"`
+	OutcomeErr      string        `json:"outcomeErr,omitempty"`
+	Reports         []Report      `json:"reports"`
+	ReportsErr      string        `json:"reportsErr,omitempty"`
+}
+
+// Observation is an observation attributed to the oracle that made it.
+type Observation struct {
+	Observer    commontypes.OracleID `json:"observer"`
+	Observation []byte               `json:"observation"`
+}
+
+// Report is a report generated from the round outcome.
+type Report struct {
+	Report []byte `json:"report"`
+	Info   []byte `json:"info"`
+}
+
+// OutcomeContext returns the outcome context the round was executed with.
+func (r Round) OutcomeContext() ocr3types.OutcomeContext {
+	return ocr3types.OutcomeContext{
+		SeqNr:           r.SeqNr,
+		PreviousOutcome: r.PreviousOutcome,
+		Epoch:           r.Epoch,
+		Round:           r.Round,
+	}
+}
+
+// ReportingPluginConfig returns the reporting plugin config the round was executed with.
+func (r Round) ReportingPluginConfig() (ocr3types.ReportingPluginConfig, error) {
+	digest, err := hex.DecodeString(r.ConfigDigest)
+	if err != nil {
+		return ocr3types.ReportingPluginConfig{}, fmt.Errorf("decode config digest: %w", err)
+	}
+	configDigest, err := types.BytesToConfigDigest(digest)
+	if err != nil {
+		return ocr3types.ReportingPluginConfig{}, fmt.Errorf("decode config digest: %w", err)
+	}
+	return ocr3types.ReportingPluginConfig{
+		ConfigDigest:   configDigest,
+		OracleID:       r.OracleID,
+		N:              r.N,
+		F:              r.F,
+		OffchainConfig: r.OffchainConfig,
+	}, nil
+}
+
+// AttributedObservations returns the round observations in the form expected by the plugin.
+func (r Round) AttributedObservations() []types.AttributedObservation {
+	aos := make([]types.AttributedObservation, 0, len(r.Observations))
+	for _, o := range r.Observations {
+		aos = append(aos, types.AttributedObservation{Observation: o.Observation, Observer: o.Observer})
+	}
+	return aos
+}
+
+// Sink persists recorded rounds.
+type Sink interface {
+	Write(ctx context.Context, round Round) error
+}
+
+// Plugin wraps a ReportingPlugin and records every round that reaches the outcome phase. A round is written to the
+// sink after its reports are generated, or right away when the outcome fails.
+type Plugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	lggr   logger.Logger
+	sink   Sink
+	plugin string
+	config ocr3types.ReportingPluginConfig
+
+	mu      sync.Mutex
+	pending map[uint64]Round
+}
+
+func NewPlugin(
+	plugin ocr3types.ReportingPlugin[[]byte],
+	lggr logger.Logger,
+	sink Sink,
+	pluginName string,
+	config ocr3types.ReportingPluginConfig,
+) *Plugin {
+	return &Plugin{
+		ReportingPlugin: plugin,
+		lggr:            lggr,
+		sink:            sink,
+		plugin:          pluginName,
+		config:          config,
+		pending:         make(map[uint64]Round),
+	}
+}
+
+func (p *Plugin) Outcome(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	outcome, err := p.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+
+	round := Round{
+		Plugin:          p.plugin,
+		ConfigDigest:    p.config.ConfigDigest.Hex(),
+		OracleID:        p.config.OracleID,
+		N:               p.config.N,
+		F:               p.config.F,
+		OffchainConfig:  p.config.OffchainConfig,
+		SeqNr:           outctx.SeqNr,
+		Epoch:           outctx.Epoch,
+		Round:           outctx.Round,
+		RecordedAt:      time.Now().UTC(),
+		PreviousOutcome: clone(outctx.PreviousOutcome),
+		Query:           clone(query),
+		Observations:    make([]Observation, 0, len(aos)),
+		Outcome:         clone(outcome),
+	}
+	for _, ao := range aos {
+		round.Observations = append(round.Observations, Observation{Observer: ao.Observer, Observation: clone(ao.Observation)})
+	}
+
+	if err != nil {
+		round.OutcomeErr = err.Error()
+		p.write(ctx, round)
+		return outcome, err
+	}
+
+	p.mu.Lock()
+	p.pending[outctx.SeqNr] = round
+	for seqNr := range p.pending {
+		if seqNr+maxPendingRounds < outctx.SeqNr {
+			delete(p.pending, seqNr)
+		}
+	}
+	p.mu.Unlock()
+
+	return outcome, nil
+}
+
+func (p *Plugin) Reports(
+	ctx context.Context, seqNr uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	reports, err := p.ReportingPlugin.Reports(ctx, seqNr, outcome)
+
+	p.mu.Lock()
+	round, ok := p.pending[seqNr]
+	delete(p.pending, seqNr)
+	p.mu.Unlock()
+	if !ok {
+		// outcome computed before a restart, nothing to pair the reports with
+		return reports, err
+	}
+
+	round.Reports = toReports(reports)
+	if err != nil {
+		round.ReportsErr = err.Error()
+	}
+	p.write(ctx, round)
+	return reports, err
+}
+
+// write never fails the round, recording is best effort.
+func (p *Plugin) write(ctx context.Context, round Round) {
+	if err := p.sink.Write(ctx, round); err != nil {
+		p.lggr.Warnw("failed to record round", "seqNr", round.SeqNr, "err", err)
+	}
+}
+
+func toReports(reports []ocr3types.ReportPlus[[]byte]) []Report {
+	out := make([]Report, 0, len(reports))
+	for _, r := range reports {
+		out = append(out, Report{Report: clone(r.ReportWithInfo.Report), Info: clone(r.ReportWithInfo.Info)})
+	}
+	return out
+}
+
+func clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
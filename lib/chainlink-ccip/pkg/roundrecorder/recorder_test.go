@@ -0,0 +1,143 @@
+package roundrecorder
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+)
+
+// concatPlugin outcome is the concatenation of the query and the observations, with a single report echoing the
+// outcome.
+type concatPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	suffix     []byte
+	outcomeErr error
+}
+
+func (p *concatPlugin) Outcome(
+	_ context.Context, _ ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	if p.outcomeErr != nil {
+		return nil, p.outcomeErr
+	}
+	out := append([]byte{}, query...)
+	for _, ao := range aos {
+		out = append(out, ao.Observation...)
+	}
+	return append(out, p.suffix...), nil
+}
+
+func (p *concatPlugin) Reports(
+	_ context.Context, _ uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	return []ocr3types.ReportPlus[[]byte]{
+		{ReportWithInfo: ocr3types.ReportWithInfo[[]byte]{Report: types.Report(outcome), Info: []byte("info")}},
+	}, nil
+}
+
+func TestPlugin_RecordAndReplay(t *testing.T) {
+	ctx := tests.Context(t)
+	sink := &MemorySink{}
+	cfg := ocr3types.ReportingPluginConfig{
+		OracleID: 2, ConfigDigest: types.ConfigDigest{1}, N: 4, F: 1, OffchainConfig: []byte("{}"),
+	}
+	p := NewPlugin(&concatPlugin{}, logger.Test(t), sink, "test", cfg)
+
+	outctx := ocr3types.OutcomeContext{SeqNr: 10, PreviousOutcome: []byte("prev"), Epoch: 1, Round: 3}
+	aos := []types.AttributedObservation{
+		{Observation: []byte("a"), Observer: 0},
+		{Observation: []byte("b"), Observer: 1},
+	}
+	outcome, err := p.Outcome(ctx, outctx, []byte("q"), aos)
+	require.NoError(t, err)
+	assert.Empty(t, sink.Rounds(), "round is written after reports")
+
+	_, err = p.Reports(ctx, outctx.SeqNr, outcome)
+	require.NoError(t, err)
+
+	rounds := sink.Rounds()
+	require.Len(t, rounds, 1)
+	r := rounds[0]
+	assert.Equal(t, "test", r.Plugin)
+	assert.Equal(t, cfg.ConfigDigest.Hex(), r.ConfigDigest)
+	rcfg, err := r.ReportingPluginConfig()
+	require.NoError(t, err)
+	assert.Equal(t, cfg, rcfg)
+	assert.Equal(t, outctx, r.OutcomeContext())
+	assert.Equal(t, aos, r.AttributedObservations())
+	assert.Equal(t, []byte("qab"), r.Outcome)
+	require.Len(t, r.Reports, 1)
+	assert.Equal(t, []byte("qab"), r.Reports[0].Report)
+
+	// persisted rounds are read back unchanged
+	path := filepath.Join(t.TempDir(), "rounds.jsonl")
+	fileSink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, fileSink.Write(ctx, r))
+	require.NoError(t, fileSink.Close())
+	fromFile, err := ReadRoundsFile(path)
+	require.NoError(t, err)
+	require.Len(t, fromFile, 1)
+	assert.Equal(t, r.Outcome, fromFile[0].Outcome)
+	assert.Equal(t, r.Observations, fromFile[0].Observations)
+
+	// same code, same result
+	replayed, err := Replay(ctx, &concatPlugin{}, fromFile[0])
+	require.NoError(t, err)
+	diff, err := Diff(fromFile[0], replayed, nil)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// changed code
+	replayed, err = Replay(ctx, &concatPlugin{suffix: []byte("!")}, fromFile[0])
+	require.NoError(t, err)
+	diff, err = Diff(fromFile[0], replayed, nil)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "outcome: recorded")
+	assert.Contains(t, diff, "report 0")
+}
+
+func TestPlugin_OutcomeError(t *testing.T) {
+	ctx := tests.Context(t)
+	sink := &MemorySink{}
+	p := NewPlugin(&concatPlugin{outcomeErr: errors.New("boom")}, logger.Test(t), sink, "test",
+		ocr3types.ReportingPluginConfig{})
+
+	_, err := p.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 1}, nil, nil)
+	require.Error(t, err)
+	rounds := sink.Rounds()
+	require.Len(t, rounds, 1)
+	assert.Equal(t, "boom", rounds[0].OutcomeErr)
+
+	// reports without a recorded outcome are not written
+	_, err = p.Reports(ctx, 5, nil)
+	require.NoError(t, err)
+	assert.Len(t, sink.Rounds(), 1)
+}
+
+func TestDiff_DecodedOutcome(t *testing.T) {
+	codec := ocrtypecodec.NewExecCodecProto()
+	a, err := codec.EncodeOutcome(exectypes.Outcome{State: exectypes.GetCommitReports})
+	require.NoError(t, err)
+	b, err := codec.EncodeOutcome(exectypes.Outcome{State: exectypes.Filter})
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(a, b))
+
+	diff, err := Diff(Round{Outcome: a}, Round{Outcome: b}, ExecOutcomeDecoder)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- recorded outcome")
+	assert.Contains(t, diff, "+++ replayed outcome")
+}
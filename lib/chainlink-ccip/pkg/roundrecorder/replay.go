@@ -0,0 +1,128 @@
+package roundrecorder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+)
+
+// OutcomeDecoder decodes an encoded outcome into a value that can be diffed as JSON.
+type OutcomeDecoder func(outcome []byte) (any, error)
+
+// CommitOutcomeDecoder decodes commit plugin outcomes.
+func CommitOutcomeDecoder(outcome []byte) (any, error) {
+	return ocrtypecodec.DefaultCommitCodec.DecodeOutcome(outcome)
+}
+
+// ExecOutcomeDecoder decodes execute plugin outcomes.
+func ExecOutcomeDecoder(outcome []byte) (any, error) {
+	return ocrtypecodec.NewExecCodecProto().DecodeOutcome(outcome)
+}
+
+// Replay feeds the recorded query and observations of a round into the plugin and returns the round as computed by
+// the plugin. The plugin is expected to be built with readers mocked to return the state at the time of the round,
+// only Outcome and Reports are called.
+func Replay(ctx context.Context, plugin ocr3types.ReportingPlugin[[]byte], recorded Round) (Round, error) {
+	replayed := recorded
+	replayed.RecordedAt = time.Now().UTC()
+	replayed.Outcome, replayed.OutcomeErr = nil, ""
+	replayed.Reports, replayed.ReportsErr = nil, ""
+
+	outcome, err := plugin.Outcome(ctx, recorded.OutcomeContext(), recorded.Query, recorded.AttributedObservations())
+	if err != nil {
+		replayed.OutcomeErr = err.Error()
+		return replayed, nil
+	}
+	replayed.Outcome = outcome
+
+	reports, err := plugin.Reports(ctx, recorded.SeqNr, outcome)
+	if err != nil {
+		replayed.ReportsErr = err.Error()
+	}
+	replayed.Reports = toReports(reports)
+	return replayed, nil
+}
+
+// Diff returns a human readable difference between a recorded and a replayed round, empty if they produced the same
+// outcome and reports. Outcomes are decoded with the given decoder to diff their content, raw bytes are compared when
+// the decoder is nil or fails.
+func Diff(recorded, replayed Round, decode OutcomeDecoder) (string, error) {
+	var sb strings.Builder
+
+	if recorded.OutcomeErr != replayed.OutcomeErr {
+		fmt.Fprintf(&sb, "outcome error: recorded %q, replayed %q\n", recorded.OutcomeErr, replayed.OutcomeErr)
+	}
+	if !bytes.Equal(recorded.Outcome, replayed.Outcome) {
+		d, err := outcomeDiff(recorded.Outcome, replayed.Outcome, decode)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(d)
+	}
+
+	if recorded.ReportsErr != replayed.ReportsErr {
+		fmt.Fprintf(&sb, "reports error: recorded %q, replayed %q\n", recorded.ReportsErr, replayed.ReportsErr)
+	}
+	if len(recorded.Reports) != len(replayed.Reports) {
+		fmt.Fprintf(&sb, "reports: recorded %d, replayed %d\n", len(recorded.Reports), len(replayed.Reports))
+	}
+	for i := 0; i < min(len(recorded.Reports), len(replayed.Reports)); i++ {
+		if !bytes.Equal(recorded.Reports[i].Report, replayed.Reports[i].Report) {
+			fmt.Fprintf(&sb, "report %d: recorded %x, replayed %x\n", i, recorded.Reports[i].Report, replayed.Reports[i].Report)
+		}
+		if !bytes.Equal(recorded.Reports[i].Info, replayed.Reports[i].Info) {
+			fmt.Fprintf(&sb, "report %d info: recorded %x, replayed %x\n", i, recorded.Reports[i].Info, replayed.Reports[i].Info)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func outcomeDiff(recorded, replayed []byte, decode OutcomeDecoder) (string, error) {
+	raw := fmt.Sprintf("outcome: recorded %x, replayed %x\n", recorded, replayed)
+	if decode == nil {
+		return raw, nil
+	}
+
+	a, errA := decodeJSON(recorded, decode)
+	b, errB := decodeJSON(replayed, decode)
+	if errA != nil || errB != nil {
+		return raw, nil
+	}
+
+	d, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: "recorded outcome",
+		ToFile:   "replayed outcome",
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("diff outcomes: %w", err)
+	}
+	if d == "" {
+		// encodings differ but the decoded outcomes are equal, e.g. map ordering
+		return "", nil
+	}
+	return d, nil
+}
+
+func decodeJSON(outcome []byte, decode OutcomeDecoder) (string, error) {
+	v, err := decode(outcome)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b) + "\n", nil
+}
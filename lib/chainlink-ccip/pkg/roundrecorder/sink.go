@@ -0,0 +1,91 @@
+package roundrecorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileSink appends rounds as JSON lines to a file.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open round file: %w", err)
+	}
+	return &FileSink{file: f}, nil
+}
+
+func (s *FileSink) Write(_ context.Context, round Round) error {
+	b, err := json.Marshal(round)
+	if err != nil {
+		return fmt.Errorf("marshal round: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(b, '\n'))
+	return err
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// MemorySink keeps rounds in memory, mostly useful in tests.
+type MemorySink struct {
+	mu     sync.Mutex
+	rounds []Round
+}
+
+func (s *MemorySink) Write(_ context.Context, round Round) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rounds = append(s.rounds, round)
+	return nil
+}
+
+func (s *MemorySink) Rounds() []Round {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Round{}, s.rounds...)
+}
+
+// ReadRounds reads the rounds written by a FileSink.
+func ReadRounds(r io.Reader) ([]Round, error) {
+	var rounds []Round
+	scanner := bufio.NewScanner(r)
+	// outcomes and reports can be large
+	scanner.Buffer(make([]byte, 0, 1<<20), 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var round Round
+		if err := json.Unmarshal(scanner.Bytes(), &round); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, scanner.Err()
+}
+
+// ReadRoundsFile reads the rounds of a file written by a FileSink.
+func ReadRoundsFile(path string) ([]Round, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadRounds(f)
+}
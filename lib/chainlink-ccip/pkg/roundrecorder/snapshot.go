@@ -0,0 +1,89 @@
+package roundrecorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks/inmem"
+	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Snapshot is the state the readers are mocked with when replaying rounds. Outcome and Reports only read the home
+// chain, on-chain state reaches them through the recorded observations.
+type Snapshot struct {
+	DonID     uint32                                      `json:"donID"`
+	DestChain cciptypes.ChainSelector                     `json:"destChain"`
+	Oracles   map[commontypes.OracleID]libocrtypes.PeerID `json:"oracles"`
+	Chains    map[cciptypes.ChainSelector]ChainSnapshot   `json:"chains"`
+
+	// MerkleTreeGas and MessageGas are the fixed estimates the execute plugin fits messages in a report with, they
+	// should be taken from the estimate provider of the destination chain family.
+	MerkleTreeGas uint64 `json:"merkleTreeGas"`
+	MessageGas    uint64 `json:"messageGas"`
+}
+
+// ChainSnapshot is the home chain config of a chain.
+type ChainSnapshot struct {
+	FChain         int                     `json:"fChain"`
+	SupportedNodes []libocrtypes.PeerID    `json:"supportedNodes"`
+	Config         chainconfig.ChainConfig `json:"config"`
+}
+
+// ReadSnapshotFile reads a JSON encoded snapshot.
+func ReadSnapshotFile(path string) (Snapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("read snapshot file: %w", err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(b, &s); err != nil {
+		return Snapshot{}, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	return s, nil
+}
+
+// HomeChain returns a home chain reader serving the snapshot chain configs.
+func (s Snapshot) HomeChain() reader.HomeChain {
+	chainConfigs := make(map[cciptypes.ChainSelector]reader.ChainConfig, len(s.Chains))
+	for chain, c := range s.Chains {
+		chainConfigs[chain] = reader.ChainConfig{
+			FChain:         c.FChain,
+			SupportedNodes: mapset.NewSet(c.SupportedNodes...),
+			Config:         c.Config,
+		}
+	}
+	return reader.NewStaticHomeChain(chainConfigs)
+}
+
+// CCIPReader returns an in-memory CCIP reader without any messages or reports, the plugins only use it in the
+// outcome phase to sync the discovered contracts.
+func (s Snapshot) CCIPReader(configDigest [32]byte) readerpkg.CCIPReader {
+	return inmem.InMemoryCCIPReader{Dest: s.DestChain, ConfigDigest: configDigest}
+}
+
+// EstimateProvider returns an estimate provider returning the snapshot gas estimates.
+func (s Snapshot) EstimateProvider() cciptypes.EstimateProvider {
+	return fixedEstimateProvider{merkleTreeGas: s.MerkleTreeGas, messageGas: s.MessageGas}
+}
+
+type fixedEstimateProvider struct {
+	merkleTreeGas uint64
+	messageGas    uint64
+}
+
+func (p fixedEstimateProvider) CalculateMerkleTreeGas(int) uint64 {
+	return p.merkleTreeGas
+}
+
+func (p fixedEstimateProvider) CalculateMessageMaxGas(cciptypes.Message) uint64 {
+	return p.messageGas
+}
@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+)
+
+// Plugin wraps a ReportingPlugin and starts a span for every OCR phase, the phase context carries the round.
+type Plugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	plugin       string
+	configDigest types.ConfigDigest
+	attrs        []attribute.KeyValue
+}
+
+func NewPlugin(
+	plugin ocr3types.ReportingPlugin[[]byte],
+	pluginName string,
+	config ocr3types.ReportingPluginConfig,
+) *Plugin {
+	return &Plugin{
+		ReportingPlugin: plugin,
+		plugin:          pluginName,
+		configDigest:    config.ConfigDigest,
+		attrs: []attribute.KeyValue{
+			AttrPlugin.String(pluginName),
+			AttrOracleID.Int(int(config.OracleID)),
+		},
+	}
+}
+
+func (p *Plugin) Query(ctx context.Context, outctx ocr3types.OutcomeContext) (q types.Query, err error) {
+	ctx, span := p.startPhase(ctx, outctx.SeqNr, "Query", logutil.PhaseQuery)
+	defer func() { EndSpan(span, err) }()
+	return p.ReportingPlugin.Query(ctx, outctx)
+}
+
+func (p *Plugin) Observation(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query,
+) (obs types.Observation, err error) {
+	ctx, span := p.startPhase(ctx, outctx.SeqNr, "Observation", logutil.PhaseObservation)
+	defer func() { EndSpan(span, err) }()
+	return p.ReportingPlugin.Observation(ctx, outctx, query)
+}
+
+func (p *Plugin) ValidateObservation(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, ao types.AttributedObservation,
+) (err error) {
+	ctx, span := p.startPhase(ctx, outctx.SeqNr, "ValidateObservation", logutil.PhaseObservation)
+	span.SetAttributes(attribute.Int("ocr.observer", int(ao.Observer)))
+	defer func() { EndSpan(span, err) }()
+	return p.ReportingPlugin.ValidateObservation(ctx, outctx, query, ao)
+}
+
+func (p *Plugin) ObservationQuorum(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (quorumReached bool, err error) {
+	ctx, span := p.startPhase(ctx, outctx.SeqNr, "ObservationQuorum", logutil.PhaseObservation)
+	defer func() { EndSpan(span, err) }()
+	return p.ReportingPlugin.ObservationQuorum(ctx, outctx, query, aos)
+}
+
+func (p *Plugin) Outcome(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (outcome ocr3types.Outcome, err error) {
+	ctx, span := p.startPhase(ctx, outctx.SeqNr, "Outcome", logutil.PhaseOutcome)
+	span.SetAttributes(attribute.Int("ocr.observations", len(aos)))
+	defer func() { EndSpan(span, err) }()
+	return p.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+}
+
+func (p *Plugin) Reports(
+	ctx context.Context, seqNr uint64, outcome ocr3types.Outcome,
+) (reports []ocr3types.ReportPlus[[]byte], err error) {
+	ctx, span := p.startPhase(ctx, seqNr, "Reports", logutil.PhaseReports)
+	defer func() {
+		span.SetAttributes(attribute.Int("ocr.reports", len(reports)))
+		EndSpan(span, err)
+	}()
+	return p.ReportingPlugin.Reports(ctx, seqNr, outcome)
+}
+
+func (p *Plugin) ShouldAcceptAttestedReport(
+	ctx context.Context, seqNr uint64, report ocr3types.ReportWithInfo[[]byte],
+) (accept bool, err error) {
+	ctx, span := p.startPhase(ctx, seqNr, "ShouldAcceptAttestedReport", logutil.PhaseShouldAccept)
+	defer func() {
+		span.SetAttributes(attribute.Bool("ocr.accepted", accept))
+		EndSpan(span, err)
+	}()
+	return p.ReportingPlugin.ShouldAcceptAttestedReport(ctx, seqNr, report)
+}
+
+func (p *Plugin) ShouldTransmitAcceptedReport(
+	ctx context.Context, seqNr uint64, report ocr3types.ReportWithInfo[[]byte],
+) (transmit bool, err error) {
+	ctx, span := p.startPhase(ctx, seqNr, "ShouldTransmitAcceptedReport", logutil.PhaseShouldTransmit)
+	defer func() {
+		span.SetAttributes(attribute.Bool("ocr.transmitted", transmit))
+		EndSpan(span, err)
+	}()
+	return p.ReportingPlugin.ShouldTransmitAcceptedReport(ctx, seqNr, report)
+}
+
+func (p *Plugin) startPhase(
+	ctx context.Context, seqNr uint64, method, phase string,
+) (context.Context, trace.Span) {
+	ctx = WithRound(ctx, p.configDigest, seqNr)
+	return StartSpan(ctx, p.plugin+"."+method, append(p.attrs, AttrPhase.String(phase))...)
+}
+
+var _ ocr3types.ReportingPlugin[[]byte] = &Plugin{}
@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+// readerPlugin makes a traced "reader" call in its outcome, like the plugins do through the CCIPReader.
+type readerPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	outcomeErr error
+}
+
+func (p *readerPlugin) Outcome(
+	ctx context.Context, _ ocr3types.OutcomeContext, _ types.Query, _ []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	_, span := StartSpan(ctx, "reader.call")
+	span.End()
+	return nil, p.outcomeErr
+}
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+func attrs(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestPlugin_PhaseSpans(t *testing.T) {
+	recorder := newRecorder(t)
+	ctx := tests.Context(t)
+	cfg := ocr3types.ReportingPluginConfig{OracleID: 3, ConfigDigest: types.ConfigDigest{1, 2}}
+	p := NewPlugin(&readerPlugin{outcomeErr: errors.New("boom")}, "commit", cfg)
+
+	_, err := p.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 42}, nil, nil)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	readerSpan, phaseSpan := spans[0], spans[1]
+
+	assert.Equal(t, "commit.Outcome", phaseSpan.Name())
+	assert.Equal(t, codes.Error, phaseSpan.Status().Code)
+	phaseAttrs := attrs(phaseSpan.Attributes())
+	assert.Equal(t, int64(42), phaseAttrs[AttrSeqNr].AsInt64())
+	assert.Equal(t, cfg.ConfigDigest.Hex(), phaseAttrs[AttrConfigDigest].AsString())
+	assert.Equal(t, int64(3), phaseAttrs[AttrOracleID].AsInt64())
+	assert.Equal(t, "commit", phaseAttrs[AttrPlugin].AsString())
+
+	// nested spans are children of the phase span and carry the round
+	assert.Equal(t, "reader.call", readerSpan.Name())
+	assert.Equal(t, phaseSpan.SpanContext().SpanID(), readerSpan.Parent().SpanID())
+	readerAttrs := attrs(readerSpan.Attributes())
+	assert.Equal(t, int64(42), readerAttrs[AttrSeqNr].AsInt64())
+	assert.Equal(t, cfg.ConfigDigest.Hex(), readerAttrs[AttrConfigDigest].AsString())
+}
+
+func TestObserveWithExemplar(t *testing.T) {
+	recorder := newRecorder(t)
+	_ = recorder
+
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_latency", Buckets: []float64{1, 10}})
+
+	// no span, plain observation
+	ObserveWithExemplar(tests.Context(t), histogram, 0.5)
+
+	ctx, span := StartSpan(tests.Context(t), "traced")
+	ObserveWithExemplar(ctx, histogram, 5)
+	span.End()
+
+	m := &dto.Metric{}
+	require.NoError(t, histogram.Write(m))
+	buckets := m.GetHistogram().GetBucket()
+	require.Len(t, buckets, 2)
+	assert.Nil(t, buckets[0].GetExemplar())
+	require.NotNil(t, buckets[1].GetExemplar())
+	labels := buckets[1].GetExemplar().GetLabel()
+	require.Len(t, labels, 1)
+	assert.Equal(t, ExemplarTraceIDLabel, labels[0].GetName())
+	assert.Equal(t, span.SpanContext().TraceID().String(), labels[0].GetValue())
+}
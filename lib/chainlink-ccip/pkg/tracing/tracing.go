@@ -0,0 +1,90 @@
+// Package tracing provides the OpenTelemetry spans of the CCIP plugins.
+//
+// Plugin wraps a ReportingPlugin with a span for every OCR phase. The round (config digest and sequence number) is
+// added to the phase context, so that the spans started with StartSpan down the call chain, e.g. by the CCIPReader or
+// the contract readers, carry the round as attributes and rounds can be followed across nodes. Spans are exported by
+// the tracer provider registered with otel.SetTracerProvider, when there is none spans are no-ops.
+//
+// ObserveWithExemplar attaches the trace ID of the context span to the observations of the latency histograms.
+package tracing
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+const (
+	tracerName = "github.com/smartcontractkit/chainlink-ccip"
+
+	// ExemplarTraceIDLabel is the exemplar label holding the trace ID.
+	ExemplarTraceIDLabel = "trace_id"
+
+	AttrConfigDigest = attribute.Key("ocr.config_digest")
+	AttrSeqNr        = attribute.Key("ocr.seq_nr")
+	AttrOracleID     = attribute.Key("ocr.oracle_id")
+	AttrPlugin       = attribute.Key("ccip.plugin")
+	AttrPhase        = attribute.Key("ocr.phase")
+)
+
+type roundKey struct{}
+
+// round is the OCR round a context belongs to.
+type round struct {
+	configDigest types.ConfigDigest
+	seqNr        uint64
+}
+
+// Tracer returns the tracer of the CCIP plugins.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// WithRound returns a context carrying the OCR round, added as attributes to the spans started with StartSpan.
+func WithRound(ctx context.Context, configDigest types.ConfigDigest, seqNr uint64) context.Context {
+	return context.WithValue(ctx, roundKey{}, round{configDigest: configDigest, seqNr: seqNr})
+}
+
+// RoundAttributes returns the attributes of the OCR round of the context, nil if the context has no round.
+func RoundAttributes(ctx context.Context) []attribute.KeyValue {
+	r, ok := ctx.Value(roundKey{}).(round)
+	if !ok {
+		return nil
+	}
+	return []attribute.KeyValue{
+		AttrConfigDigest.String(r.configDigest.Hex()),
+		AttrSeqNr.Int64(int64(r.seqNr)),
+	}
+}
+
+// StartSpan starts a span with the given attributes and the ones of the OCR round of the context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(append(RoundAttributes(ctx), attrs...)...))
+}
+
+// EndSpan records the error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ObserveWithExemplar observes v with the trace ID of the context span as exemplar, falling back to a plain
+// observation when the span is not sampled or the observer doesn't support exemplars.
+func ObserveWithExemplar(ctx context.Context, observer prometheus.Observer, v float64) {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !ok || !spanCtx.IsSampled() {
+		observer.Observe(v)
+		return
+	}
+	exemplarObserver.ObserveWithExemplar(v, prometheus.Labels{ExemplarTraceIDLabel: spanCtx.TraceID().String()})
+}
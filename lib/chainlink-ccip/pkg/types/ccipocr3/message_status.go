@@ -0,0 +1,70 @@
+package ccipocr3
+
+import (
+	"fmt"
+	"time"
+)
+
+// MessageExecutionState is the execution state of a message, as tracked by the OffRamp.
+// Mirrors Internal.MessageExecutionState.
+type MessageExecutionState uint8
+
+const (
+	MessageExecutionStateUntouched MessageExecutionState = iota
+	MessageExecutionStateInProgress
+	MessageExecutionStateSuccess
+	MessageExecutionStateFailure
+)
+
+func (s MessageExecutionState) String() string {
+	switch s {
+	case MessageExecutionStateUntouched:
+		return "untouched"
+	case MessageExecutionStateInProgress:
+		return "in-progress"
+	case MessageExecutionStateSuccess:
+		return "success"
+	case MessageExecutionStateFailure:
+		return "failure"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// MessageLookup identifies a message sent by a source chain, by MessageID when set and by SeqNum otherwise.
+type MessageLookup struct {
+	SeqNum    SeqNum  `json:"seqNum"`
+	MessageID Bytes32 `json:"messageId"`
+}
+
+// SentMessage is a message along with the time it was sent by the source chain.
+type SentMessage struct {
+	Message Message   `json:"message"`
+	SentAt  time.Time `json:"sentAt"`
+}
+
+// MessageCommit is the merkle root committing a message on the destination chain.
+type MessageCommit struct {
+	MerkleRoot MerkleRootChain `json:"merkleRoot"`
+	Blessed    bool            `json:"blessed"`
+	Timestamp  time.Time       `json:"timestamp"`
+	BlockNum   uint64          `json:"blockNum"`
+}
+
+// MessageExecution is the latest execution state change of a message on the destination chain.
+// The zero value is an untouched message.
+type MessageExecution struct {
+	State MessageExecutionState `json:"state"`
+	// TxHash is the hash of the transaction that changed the state, encoded as by the destination chain.
+	TxHash    string    `json:"txHash"`
+	BlockNum  uint64    `json:"blockNum"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// MessageStatus is the progress of a message towards the destination chain.
+type MessageStatus struct {
+	SentMessage
+	// Commit is nil until a commit report containing the message is accepted on the destination chain.
+	Commit    *MessageCommit   `json:"commit"`
+	Execution MessageExecution `json:"execution"`
+}
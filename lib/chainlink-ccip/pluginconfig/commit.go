@@ -173,6 +173,57 @@ type CommitOffchainConfig struct {
 	// in order to avoid delays when there are reports from multiple sources.
 	// NOTE: this can only be used if RMNEnabled == false.
 	MultipleReportsEnabled bool `json:"multipleReports"`
+
+	// MerkleRootBatching optionally delays the merkle root of a source chain until enough messages are pending or
+	// the oldest of them waited long enough, trading commit latency for fewer commit reports.
+	// Source chains without a policy are committed as soon as messages are observed.
+	MerkleRootBatching map[cciptypes.ChainSelector]MerkleRootBatchingPolicy `json:"merkleRootBatching,omitempty"`
+
+	// CCIPReaderCache enables the caching of the CCIPReader config and fee reads, disabled when not set.
+	CCIPReaderCache *CCIPReaderCacheConfig `json:"ccipReaderCache,omitempty"`
+}
+
+// MerkleRootBatchingPolicy is the commit cadence of the merkle roots of a single source chain.
+//
+// A merkle root is committed once MinMessages messages are pending or the first pending message has been observed
+// MaxLatency ago. When the destination gas price is known, both limits are scaled linearly between 0 at
+// CheapGasPrice and their configured value at ExpensiveGasPrice, i.e. roots are committed right away when gas is
+// cheap and batched up to the configured limits when it is expensive.
+type MerkleRootBatchingPolicy struct {
+	// MinMessages is the number of pending messages that triggers a commit.
+	MinMessages uint64 `json:"minMessages"`
+
+	// MaxLatency is the maximum time a pending message waits for a commit.
+	MaxLatency commonconfig.Duration `json:"maxLatency"`
+
+	// CheapGasPrice is the destination gas price at or below which messages are committed without waiting.
+	// Optional, the limits are not scaled when either gas price is not set.
+	CheapGasPrice cciptypes.BigInt `json:"cheapGasPrice"`
+
+	// ExpensiveGasPrice is the destination gas price at or above which the configured limits apply.
+	ExpensiveGasPrice cciptypes.BigInt `json:"expensiveGasPrice"`
+}
+
+func (p MerkleRootBatchingPolicy) Validate() error {
+	if p.MaxLatency.Duration() <= 0 {
+		return errors.New("maxLatency not set")
+	}
+
+	if p.CheapGasPrice.IsEmpty() != p.ExpensiveGasPrice.IsEmpty() {
+		return errors.New("cheapGasPrice and expensiveGasPrice must be set together")
+	}
+
+	if !p.CheapGasPrice.IsEmpty() {
+		if p.CheapGasPrice.Sign() < 0 {
+			return errors.New("cheapGasPrice is negative")
+		}
+		if p.CheapGasPrice.Cmp(p.ExpensiveGasPrice.Int) >= 0 {
+			return fmt.Errorf("cheapGasPrice (%s) must be lower than expensiveGasPrice (%s)",
+				p.CheapGasPrice, p.ExpensiveGasPrice)
+		}
+	}
+
+	return nil
 }
 
 //nolint:gocyclo // it is considered ok since we don't have complicated logic here
@@ -311,6 +362,18 @@ func (c *CommitOffchainConfig) Validate() error {
 		errs = append(errs, fmt.Errorf("maxPricesPerReport cannot be used without MultipleReportsEnabled"))
 	}
 
+	for chain, policy := range c.MerkleRootBatching {
+		if err := policy.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid merkle root batching policy for chain %d: %w", chain, err))
+		}
+	}
+
+	if c.CCIPReaderCache != nil {
+		if err := c.CCIPReaderCache.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid ccipReaderCache: %w", err))
+		}
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
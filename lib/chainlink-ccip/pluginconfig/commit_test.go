@@ -588,3 +588,46 @@ func TestPreventCommitOffchainConfigEncodingBeingChanged(t *testing.T) {
 	require.Equal(t, string(jsonCfg), string(encodedCfg),
 		"CommitOffchainConfig encoding has changed, please make sure you are in sync with the RMN team")
 }
+
+func TestMerkleRootBatchingPolicy_Validate(t *testing.T) {
+	minute := *commonconfig.MustNewDuration(time.Minute)
+	gwei := func(v int64) cciptypes.BigInt { return cciptypes.NewBigInt(big.NewInt(v * 1e9)) }
+
+	tests := []struct {
+		name    string
+		policy  MerkleRootBatchingPolicy
+		wantErr bool
+	}{
+		{"valid", MerkleRootBatchingPolicy{MinMessages: 10, MaxLatency: minute}, false},
+		{
+			"valid, with gas prices",
+			MerkleRootBatchingPolicy{MinMessages: 10, MaxLatency: minute, CheapGasPrice: gwei(1), ExpensiveGasPrice: gwei(50)},
+			false,
+		},
+		{"max latency not set", MerkleRootBatchingPolicy{MinMessages: 10}, true},
+		{"only cheap gas price", MerkleRootBatchingPolicy{MaxLatency: minute, CheapGasPrice: gwei(1)}, true},
+		{
+			"cheap gas price above expensive",
+			MerkleRootBatchingPolicy{MaxLatency: minute, CheapGasPrice: gwei(50), ExpensiveGasPrice: gwei(1)},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	cfg := CommitOffchainConfig{
+		RemoteGasPriceBatchWriteFrequency: minute,
+		MerkleRootBatching: map[cciptypes.ChainSelector]MerkleRootBatchingPolicy{
+			1: {MinMessages: 10},
+		},
+	}
+	require.ErrorContains(t, cfg.ApplyDefaultsAndValidate(), "merkle root batching policy for chain 1")
+}
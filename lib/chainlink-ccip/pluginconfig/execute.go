@@ -3,6 +3,7 @@ package pluginconfig
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -45,6 +46,16 @@ type ExecuteOffchainConfig struct {
 	// MaxSingleChainReports is the maximum number of single chain reports that can be included in a report.
 	// When set to 0, this setting is ignored.
 	MaxSingleChainReports uint64 `json:"maxSingleChainReports"`
+
+	// FeeCheckEnabled skips messages whose fee no longer covers their execution cost on the destination chain.
+	FeeCheckEnabled bool `json:"feeCheckEnabled"`
+
+	// RelativeBoostPerWaitHour boosts the fee of a waiting message by this fraction of its fee per hour of waiting
+	// when checking it against the execution cost. Only used when FeeCheckEnabled is set.
+	RelativeBoostPerWaitHour float64 `json:"relativeBoostPerWaitHour"`
+
+	// CCIPReaderCache enables the caching of the CCIPReader config and fee reads, disabled when not set.
+	CCIPReaderCache *CCIPReaderCacheConfig `json:"ccipReaderCache,omitempty"`
 }
 
 func (e *ExecuteOffchainConfig) ApplyDefaultsAndValidate() error {
@@ -78,6 +89,16 @@ func (e *ExecuteOffchainConfig) Validate() error {
 		return errors.New("MessageVisibilityInterval not set")
 	}
 
+	if e.RelativeBoostPerWaitHour < 0 {
+		return errors.New("RelativeBoostPerWaitHour must not be negative")
+	}
+
+	if e.CCIPReaderCache != nil {
+		if err := e.CCIPReaderCache.Validate(); err != nil {
+			return fmt.Errorf("invalid CCIPReaderCache: %w", err)
+		}
+	}
+
 	set := make(map[string]struct{})
 	for _, ob := range e.TokenDataObservers {
 		if err := ob.Validate(); err != nil {
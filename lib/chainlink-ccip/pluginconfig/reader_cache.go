@@ -0,0 +1,41 @@
+package pluginconfig
+
+import (
+	"errors"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+// CCIPReaderCacheConfig enables the caching of the CCIPReader reads that are repeated every round but rarely change.
+// A zero TTL leaves the results of the method uncached.
+type CCIPReaderCacheConfig struct {
+	// SourceChainsConfigTTL caches the OffRamp source chain configs, they are also invalidated by the
+	// SourceChainConfigSet events of the OffRamp.
+	SourceChainsConfigTTL commonconfig.Duration `json:"sourceChainsConfigTTL"`
+
+	// RMNRemoteConfigTTL caches the RMNRemote config, it is also invalidated by the ConfigSet events of the RMNRemote.
+	RMNRemoteConfigTTL commonconfig.Duration `json:"rmnRemoteConfigTTL"`
+
+	// ChainFeeComponentsTTL caches the fee components of the chains. There is no event invalidating them, the TTL
+	// should be kept short.
+	ChainFeeComponentsTTL commonconfig.Duration `json:"chainFeeComponentsTTL"`
+
+	// NoncesTTL caches the nonces of the message senders, they are also invalidated by the ExecutionStateChanged
+	// events of the OffRamp. A stale nonce delays the execution of the next message of its sender, the TTL should be
+	// kept short.
+	NoncesTTL commonconfig.Duration `json:"noncesTTL"`
+
+	// EventPollInterval is how often the destination chain is polled for the invalidating events.
+	// When not set the cached configs only expire with their TTL.
+	EventPollInterval commonconfig.Duration `json:"eventPollInterval"`
+}
+
+func (c CCIPReaderCacheConfig) Validate() error {
+	if c.SourceChainsConfigTTL.Duration() == 0 &&
+		c.RMNRemoteConfigTTL.Duration() == 0 &&
+		c.ChainFeeComponentsTTL.Duration() == 0 &&
+		c.NoncesTTL.Duration() == 0 {
+		return errors.New("no TTL set, nothing would be cached")
+	}
+	return nil
+}
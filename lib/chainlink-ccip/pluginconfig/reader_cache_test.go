@@ -0,0 +1,32 @@
+package pluginconfig
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+func TestCCIPReaderCacheConfig_Validate(t *testing.T) {
+	require.Error(t, CCIPReaderCacheConfig{}.Validate())
+	require.Error(t, CCIPReaderCacheConfig{EventPollInterval: *commonconfig.MustNewDuration(time.Second)}.Validate())
+	require.NoError(t, CCIPReaderCacheConfig{ChainFeeComponentsTTL: *commonconfig.MustNewDuration(time.Second)}.Validate())
+	require.NoError(t, CCIPReaderCacheConfig{NoncesTTL: *commonconfig.MustNewDuration(time.Second)}.Validate())
+}
+
+func TestCCIPReaderCacheConfig_OptIn(t *testing.T) {
+	var execCfg ExecuteOffchainConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"batchGasLimit":1}`), &execCfg))
+	require.Nil(t, execCfg.CCIPReaderCache)
+
+	var commitCfg CommitOffchainConfig
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"ccipReaderCache":{"rmnRemoteConfigTTL":"5m","eventPollInterval":"5s"}}`), &commitCfg))
+	require.NotNil(t, commitCfg.CCIPReaderCache)
+	require.Equal(t, 5*time.Minute, commitCfg.CCIPReaderCache.RMNRemoteConfigTTL.Duration())
+	require.Equal(t, 5*time.Second, commitCfg.CCIPReaderCache.EventPollInterval.Duration())
+	require.Zero(t, commitCfg.CCIPReaderCache.SourceChainsConfigTTL.Duration())
+}
@@ -75,6 +75,10 @@ func (d ExtraDataCodec) DecodeExtraArgsToMap(extraArgs cciptypes.Bytes) (map[str
 
 // DecodeDestExecDataToMap is a helper function for converting dest exec data bytes into map[string]any
 func (d ExtraDataCodec) DecodeDestExecDataToMap(destExecData cciptypes.Bytes) (map[string]any, error) {
+	if len(destExecData) < 4 {
+		return nil, fmt.Errorf("dest exec data too short: %d, should be at least 4 (i.e the dest gas amount)", len(destExecData))
+	}
+
 	return map[string]any{
 		svmDestExecDataKey: binary.BigEndian.Uint32(destExecData),
 	}, nil
@@ -0,0 +1,798 @@
+package defaults_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	agbinary "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/message_hasher"
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/fee_quoter"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/ccip"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipsolana"
+	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// The fuzz tests below generate reports and messages both the EVM and Solana codecs can represent, round-trip them
+// through every chain codec and check that the decoded values carry the same information. The message hashers are
+// checked against the onchain EVM hasher and the Solana offRamp hashing utilities, the extra data codec against the
+// values encoded by either source chain family.
+
+const (
+	ethMainnetSelector = cciptypes.ChainSelector(5009297550715157269)
+	numSeeds           = 16
+)
+
+var chainFamilies = []string{chainsel.FamilyEVM, chainsel.FamilySolana}
+
+func FuzzCommitPluginCodecs(f *testing.F) {
+	for seed := int64(0); seed < numSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	codecs := map[string]cciptypes.CommitPluginCodec{
+		chainsel.FamilyEVM:    ccipevm.NewCommitPluginCodecV1(),
+		chainsel.FamilySolana: ccipsolana.NewCommitPluginCodecV1(),
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		ctx := testutils.Context(t)
+		spec := randomCommitReportSpec(rand.New(rand.NewSource(seed)))
+
+		decoded := make(map[string]commitReportView, len(chainFamilies))
+		for _, family := range chainFamilies {
+			report := spec.report(family)
+			encoded, err := codecs[family].Encode(ctx, report)
+			require.NoError(t, err, "%s encode", family)
+			decodedReport, err := codecs[family].Decode(ctx, encoded)
+			require.NoError(t, err, "%s decode", family)
+
+			decoded[family] = viewCommitReport(t, family, decodedReport)
+			require.Equal(t, viewCommitReport(t, family, report), decoded[family], "%s round trip", family)
+		}
+		require.Equal(t, decoded[chainsel.FamilyEVM], decoded[chainsel.FamilySolana])
+	})
+}
+
+func FuzzExecutePluginCodecs(f *testing.F) {
+	for seed := int64(0); seed < numSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	codecs := map[string]cciptypes.ExecutePluginCodec{
+		chainsel.FamilyEVM:    ccipevm.NewExecutePluginCodecV1(defaults.DefaultExtraDataCodec),
+		chainsel.FamilySolana: ccipsolana.NewExecutePluginCodecV1(defaults.DefaultExtraDataCodec),
+	}
+	// the EVM codec decodes the dest exec data it encoded, the Solana codec decodes the dest gas amount little endian
+	destGasAmountDecoders := map[string]func([]byte) uint32{
+		chainsel.FamilyEVM:    abiDecodeUint32,
+		chainsel.FamilySolana: binary.LittleEndian.Uint32,
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		ctx := testutils.Context(t)
+		spec := randomExecuteReportSpec(rand.New(rand.NewSource(seed)))
+
+		decoded := make(map[string]cciptypes.ExecutePluginReport, len(chainFamilies))
+		views := make(map[string]executeReportView, len(chainFamilies))
+		for _, family := range chainFamilies {
+			report := spec.report(t, family)
+			encoded, err := codecs[family].Encode(ctx, report)
+			require.NoError(t, err, "%s encode", family)
+			decoded[family], err = codecs[family].Decode(ctx, encoded)
+			require.NoError(t, err, "%s decode", family)
+
+			// the dest exec data of the source chain is always ABI encoded since the source chain is EVM
+			views[family] = viewExecuteReport(t, decoded[family], destGasAmountDecoders[family])
+			require.Equal(t, viewExecuteReport(t, report, abiDecodeUint32), views[family], "%s round trip", family)
+		}
+		require.Equal(t, views[chainsel.FamilyEVM], views[chainsel.FamilySolana])
+
+		// fields only one of the codecs carries
+		require.Zero(t, spec.proofFlagBits.Cmp(decoded[chainsel.FamilyEVM].ChainReports[0].ProofFlagBits.Int))
+		svmExtraArgs := ccip_offramp.Any2SVMRampExtraArgs{
+			ComputeUnits:     spec.computeUnits,
+			IsWritableBitmap: spec.writableBitmap,
+		}
+		var buf bytes.Buffer
+		require.NoError(t, svmExtraArgs.MarshalWithEncoder(agbinary.NewBorshEncoder(&buf)))
+		require.Equal(t, buf.Bytes(), []byte(decoded[chainsel.FamilySolana].ChainReports[0].Messages[0].ExtraArgs))
+	})
+}
+
+func FuzzMessageHasherV1EVM(f *testing.F) {
+	// real messages sent on sepolia, see ccipevm/msghasher_test.go
+	data, err := os.ReadFile("../../ccipevm/msgs_test_vector.json")
+	require.NoError(f, err)
+	var msgs []cciptypes.Message
+	require.NoError(f, json.Unmarshal(data, &msgs))
+	require.NotEmpty(f, msgs)
+
+	// seed 0 hashes the test vector as is, other seeds randomize its fields
+	for i := range msgs {
+		f.Add(uint8(i), int64(0))
+	}
+	for seed := int64(1); seed < numSeeds; seed++ {
+		f.Add(uint8(seed), seed)
+	}
+
+	contract := deployMessageHasher(f)
+
+	f.Fuzz(func(t *testing.T, vector uint8, seed int64) {
+		ctx := testutils.Context(t)
+		msg := msgs[int(vector)%len(msgs)]
+		if seed != 0 {
+			msg = randomizeMessage(t, rand.New(rand.NewSource(seed)), msg)
+		}
+
+		any2EVMMessage, err := ccipevm.CCIPMsgToAny2EVMMessage(msg, defaults.DefaultExtraDataCodec)
+		require.NoError(t, err)
+		onchainHash, err := contract.Hash(&bind.CallOpts{Context: ctx},
+			toMessageHasherMessage(any2EVMMessage), common.LeftPadBytes(msg.Header.OnRamp, 32))
+		require.NoError(t, err)
+
+		hash, err := ccipevm.NewMessageHasherV1(logger.Test(t), defaults.DefaultExtraDataCodec).Hash(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, onchainHash, [32]byte(hash), "offchain hash does not match the onchain hash")
+	})
+}
+
+func FuzzMessageHasherV1Solana(f *testing.F) {
+	for seed := int64(0); seed < numSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		ctx := testutils.Context(t)
+		spec := randomSVMMessageSpec(rand.New(rand.NewSource(seed)))
+		msg := spec.message(t)
+
+		hasher := ccipsolana.NewMessageHasherV1(logger.Test(t), defaults.DefaultExtraDataCodec)
+		hash, err := hasher.Hash(ctx, msg)
+		require.NoError(t, err)
+		expected, err := ccip.HashAnyToSVMMessage(spec.any2SVMMessage(), msg.Header.OnRamp, spec.accountsWithReceiver())
+		require.NoError(t, err)
+		require.Equal(t, expected, hash[:], "offchain hash does not match the offRamp hash")
+
+		// the hash commits to the message data
+		if len(msg.Data) > 0 {
+			msg.Data = bytes.Clone(msg.Data)
+			msg.Data[0] ^= 0xff
+			changed, err := hasher.Hash(ctx, msg)
+			require.NoError(t, err)
+			require.NotEqual(t, hash, changed)
+		}
+	})
+}
+
+func FuzzExtraDataCodec(f *testing.F) {
+	for seed := int64(0); seed < numSeeds; seed++ {
+		f.Add(seed, []byte{})
+	}
+	// arbitrary bytes behind each of the known tags
+	f.Add(int64(0), hexutil.MustDecode("0x97a657c9"))
+	f.Add(int64(0), hexutil.MustDecode("0x181dcf10ff"))
+	f.Add(int64(0), hexutil.MustDecode("0x1f3b3aba00000000ffffffff"))
+	f.Add(int64(0), []byte{0x01, 0x02})
+
+	sourceChains := map[string]cciptypes.ChainSelector{
+		chainsel.FamilyEVM:    ethMainnetSelector,
+		chainsel.FamilySolana: cciptypes.ChainSelector(chainsel.SOLANA_MAINNET.Selector),
+	}
+	codec := defaults.DefaultExtraDataCodec
+
+	f.Fuzz(func(t *testing.T, seed int64, raw []byte) {
+		rng := rand.New(rand.NewSource(seed))
+
+		// extra args of either source chain family carry the same values
+		svmArgs := randomSVMExtraArgs(rng)
+		evmDecoded, err := codec.DecodeExtraArgs(abiEncodeSVMExtraArgs(t, svmArgs), sourceChains[chainsel.FamilyEVM])
+		require.NoError(t, err)
+		svmDecoded, err := codec.DecodeExtraArgs(borshEncodeSVMExtraArgs(t, svmArgs), sourceChains[chainsel.FamilySolana])
+		require.NoError(t, err)
+		evmView, svmView := lowercaseKeys(evmDecoded), lowercaseKeys(svmDecoded)
+		if len(svmArgs.Accounts) == 0 {
+			// Borsh leaves empty vectors nil
+			require.Empty(t, evmView["accounts"])
+			require.Empty(t, svmView["accounts"])
+			delete(evmView, "accounts")
+			delete(svmView, "accounts")
+		}
+		require.Equal(t, evmView, svmView)
+		require.Equal(t, svmArgs.ComputeUnits, evmDecoded["computeUnits"])
+		require.Equal(t, svmArgs.AccountIsWritableBitmap, evmDecoded["accountIsWritableBitmap"])
+		require.Equal(t, svmArgs.TokenReceiver, evmDecoded["tokenReceiver"])
+
+		gasLimit := agbinary.Uint128{Lo: rng.Uint64(), Hi: rng.Uint64()}
+		allowOOO := rng.Intn(2) == 0
+		evmArgs, err := ccipevm.SerializeClientGenericExtraArgsV2(message_hasher.ClientGenericExtraArgsV2{
+			GasLimit:                 uint128ToBigInt(gasLimit),
+			AllowOutOfOrderExecution: allowOOO,
+		})
+		require.NoError(t, err)
+		evmDecoded, err = codec.DecodeExtraArgs(evmArgs, sourceChains[chainsel.FamilyEVM])
+		require.NoError(t, err)
+		require.Zero(t, uint128ToBigInt(gasLimit).Cmp(evmDecoded["gasLimit"].(*big.Int)))
+		require.Equal(t, allowOOO, evmDecoded["allowOutOfOrderExecution"])
+
+		var buf bytes.Buffer
+		require.NoError(t, fee_quoter.GenericExtraArgsV2{GasLimit: gasLimit, AllowOutOfOrderExecution: allowOOO}.
+			MarshalWithEncoder(agbinary.NewBorshEncoder(&buf)))
+		svmDecoded, err = codec.DecodeExtraArgs(append(hexutil.MustDecode("0x181dcf10"), buf.Bytes()...),
+			sourceChains[chainsel.FamilySolana])
+		require.NoError(t, err)
+		require.Equal(t, gasLimit, svmDecoded["GasLimit"])
+		require.Equal(t, allowOOO, svmDecoded["AllowOutOfOrderExecution"])
+
+		// the dest gas amount of both families
+		destGasAmount := rng.Uint32()
+		evmDecoded, err = codec.DecodeTokenAmountDestExecData(abiEncodeUint32(t, destGasAmount), sourceChains[chainsel.FamilyEVM])
+		require.NoError(t, err)
+		require.Equal(t, destGasAmount, evmDecoded["destGasAmount"])
+		svmDecoded, err = codec.DecodeTokenAmountDestExecData(binary.BigEndian.AppendUint32(nil, destGasAmount),
+			sourceChains[chainsel.FamilySolana])
+		require.NoError(t, err)
+		require.Equal(t, destGasAmount, svmDecoded["destGasAmount"])
+
+		// arbitrary bytes are either decoded or rejected, never panic
+		for _, family := range chainFamilies {
+			_, _ = codec.DecodeExtraArgs(raw, sourceChains[family])
+			_, _ = codec.DecodeTokenAmountDestExecData(raw, sourceChains[family])
+		}
+	})
+}
+
+// commitReportSpec is a commit report within what both commit codecs support: the Solana offRamp accepts at most one
+// merkle root per report, blessed with exactly one RMN signature, and prices of at most 224 bits.
+type commitReportSpec struct {
+	blessed       bool
+	roots         []cciptypes.MerkleRootChain
+	tokens        []common.Address
+	tokenPrices   []*big.Int
+	gasPrices     []cciptypes.GasPriceChain
+	rmnSignatures []cciptypes.RMNECDSASignature
+}
+
+func randomCommitReportSpec(rng *rand.Rand) commitReportSpec {
+	var spec commitReportSpec
+	if rng.Intn(3) > 0 {
+		start := cciptypes.SeqNum(rng.Uint64() >> 1)
+		spec.roots = []cciptypes.MerkleRootChain{{
+			ChainSel:      cciptypes.ChainSelector(rng.Uint64()),
+			OnRampAddress: randomBytes(rng, 32),
+			SeqNumsRange:  cciptypes.NewSeqNumRange(start, start+cciptypes.SeqNum(rng.Intn(256))),
+			MerkleRoot:    randomBytes32(rng),
+		}}
+		spec.blessed = rng.Intn(2) == 0
+	}
+	if spec.blessed {
+		spec.rmnSignatures = []cciptypes.RMNECDSASignature{{R: randomBytes32(rng), S: randomBytes32(rng)}}
+	}
+
+	numTokens := rng.Intn(4)
+	for i := 0; i < numTokens; i++ {
+		spec.tokens = append(spec.tokens, common.BytesToAddress(randomBytes(rng, common.AddressLength)))
+		spec.tokenPrices = append(spec.tokenPrices, randomBigInt(rng, 224))
+	}
+	numGasPrices := rng.Intn(4)
+	for i := 0; i < numGasPrices; i++ {
+		spec.gasPrices = append(spec.gasPrices, cciptypes.GasPriceChain{
+			ChainSel: cciptypes.ChainSelector(rng.Uint64()),
+			GasPrice: cciptypes.NewBigInt(randomBigInt(rng, 224)),
+		})
+	}
+	return spec
+}
+
+// report returns the commit report with token addresses encoded for the given chain family.
+func (s commitReportSpec) report(family string) cciptypes.CommitPluginReport {
+	report := cciptypes.CommitPluginReport{
+		PriceUpdates:  cciptypes.PriceUpdates{GasPriceUpdates: s.gasPrices},
+		RMNSignatures: s.rmnSignatures,
+	}
+	if s.blessed {
+		report.BlessedMerkleRoots = s.roots
+	} else {
+		report.UnblessedMerkleRoots = s.roots
+	}
+	for i, token := range s.tokens {
+		tokenID := cciptypes.UnknownEncodedAddress(token.Hex())
+		if family == chainsel.FamilySolana {
+			pubKey := solanago.PublicKeyFromBytes(common.LeftPadBytes(token.Bytes(), solanago.PublicKeyLength))
+			tokenID = cciptypes.UnknownEncodedAddress(pubKey.String())
+		}
+		report.PriceUpdates.TokenPriceUpdates = append(report.PriceUpdates.TokenPriceUpdates, cciptypes.TokenPrice{
+			TokenID: tokenID,
+			Price:   cciptypes.NewBigInt(s.tokenPrices[i]),
+		})
+	}
+	return report
+}
+
+// commitReportView is the chain agnostic content of a commit report.
+type commitReportView struct {
+	MerkleRoots   []string
+	TokenPrices   []string
+	GasPrices     []string
+	RMNSignatures []string
+}
+
+func viewCommitReport(t *testing.T, family string, report cciptypes.CommitPluginReport) commitReportView {
+	var v commitReportView
+	for _, root := range report.BlessedMerkleRoots {
+		v.MerkleRoots = append(v.MerkleRoots, viewMerkleRoot(root, true))
+	}
+	for _, root := range report.UnblessedMerkleRoots {
+		v.MerkleRoots = append(v.MerkleRoots, viewMerkleRoot(root, false))
+	}
+	for _, price := range report.PriceUpdates.TokenPriceUpdates {
+		var token []byte
+		if family == chainsel.FamilySolana {
+			pubKey, err := solanago.PublicKeyFromBase58(string(price.TokenID))
+			require.NoError(t, err)
+			token = pubKey.Bytes()
+		} else {
+			require.True(t, common.IsHexAddress(string(price.TokenID)), "invalid token address %s", price.TokenID)
+			token = common.LeftPadBytes(common.HexToAddress(string(price.TokenID)).Bytes(), 32)
+		}
+		v.TokenPrices = append(v.TokenPrices, fmt.Sprintf("%x:%s", token, price.Price.Int))
+	}
+	for _, price := range report.PriceUpdates.GasPriceUpdates {
+		v.GasPrices = append(v.GasPrices, fmt.Sprintf("%d:%s", price.ChainSel, price.GasPrice.Int))
+	}
+	for _, sig := range report.RMNSignatures {
+		v.RMNSignatures = append(v.RMNSignatures, fmt.Sprintf("%x:%x", sig.R, sig.S))
+	}
+	return v
+}
+
+func viewMerkleRoot(root cciptypes.MerkleRootChain, blessed bool) string {
+	return fmt.Sprintf("%d:%x:%s:%x:blessed=%t",
+		root.ChainSel, common.LeftPadBytes(root.OnRampAddress, 32), root.SeqNumsRange, root.MerkleRoot, blessed)
+}
+
+// executeReportSpec is an execute report within what both execute codecs support: the Solana offRamp executes a
+// single message at a time and only holds receivers and tokens the EVM can address as well. The message is sent by
+// an EVM chain.
+type executeReportSpec struct {
+	header         cciptypes.RampMessageHeader
+	sender         []byte
+	receiver       common.Address
+	data           []byte
+	tokens         []tokenTransferSpec
+	gasLimit       *big.Int
+	computeUnits   uint32
+	writableBitmap uint64
+	accounts       [][32]byte
+	proofs         []cciptypes.Bytes32
+	proofFlagBits  *big.Int
+}
+
+type tokenTransferSpec struct {
+	sourcePool        common.Address
+	destToken         common.Address
+	extraData         []byte
+	amount            *big.Int
+	destGasAmount     uint32
+	offchainTokenData []byte
+}
+
+func randomExecuteReportSpec(rng *rand.Rand) executeReportSpec {
+	spec := executeReportSpec{
+		header: cciptypes.RampMessageHeader{
+			MessageID:           randomBytes32(rng),
+			SourceChainSelector: ethMainnetSelector,
+			DestChainSelector:   cciptypes.ChainSelector(rng.Uint64()),
+			SequenceNumber:      cciptypes.SeqNum(rng.Uint64()),
+			Nonce:               rng.Uint64(),
+			MsgHash:             randomBytes32(rng),
+			OnRamp:              randomBytes(rng, 32),
+		},
+		sender:         common.LeftPadBytes(randomBytes(rng, common.AddressLength), 32),
+		receiver:       common.BytesToAddress(randomBytes(rng, common.AddressLength)),
+		data:           randomBytes(rng, rng.Intn(128)),
+		gasLimit:       randomBigInt(rng, 256),
+		computeUnits:   rng.Uint32(),
+		writableBitmap: rng.Uint64(),
+		proofFlagBits:  randomBigInt(rng, 256),
+	}
+
+	numTokens := rng.Intn(4)
+	for i := 0; i < numTokens; i++ {
+		spec.tokens = append(spec.tokens, tokenTransferSpec{
+			sourcePool:        common.BytesToAddress(randomBytes(rng, common.AddressLength)),
+			destToken:         common.BytesToAddress(randomBytes(rng, common.AddressLength)),
+			extraData:         randomBytes(rng, rng.Intn(64)),
+			amount:            randomBigInt(rng, 256),
+			destGasAmount:     rng.Uint32(),
+			offchainTokenData: randomBytes(rng, rng.Intn(64)),
+		})
+	}
+	numAccounts := rng.Intn(4)
+	for i := 0; i < numAccounts; i++ {
+		spec.accounts = append(spec.accounts, randomBytes32(rng))
+	}
+	numProofs := rng.Intn(5)
+	for i := 0; i < numProofs; i++ {
+		spec.proofs = append(spec.proofs, randomBytes32(rng))
+	}
+	return spec
+}
+
+// report returns the execute report with addresses and extra args encoded for the given destination chain family.
+func (s executeReportSpec) report(t *testing.T, family string) cciptypes.ExecutePluginReport {
+	encodeAddress := func(addr common.Address) cciptypes.UnknownAddress {
+		if family == chainsel.FamilySolana {
+			return common.LeftPadBytes(addr.Bytes(), solanago.PublicKeyLength)
+		}
+		return addr.Bytes()
+	}
+
+	var extraArgs []byte
+	var err error
+	if family == chainsel.FamilySolana {
+		extraArgs, err = ccipevm.SerializeClientSVMExtraArgsV1(message_hasher.ClientSVMExtraArgsV1{
+			ComputeUnits:             s.computeUnits,
+			AccountIsWritableBitmap:  s.writableBitmap,
+			AllowOutOfOrderExecution: true,
+			TokenReceiver:            [32]byte(encodeAddress(s.receiver)),
+			Accounts:                 s.accounts,
+		})
+	} else {
+		extraArgs, err = ccipevm.SerializeClientGenericExtraArgsV2(message_hasher.ClientGenericExtraArgsV2{
+			GasLimit:                 s.gasLimit,
+			AllowOutOfOrderExecution: true,
+		})
+	}
+	require.NoError(t, err)
+
+	tokenAmounts := make([]cciptypes.RampTokenAmount, 0, len(s.tokens))
+	offchainTokenData := make([][]byte, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokenAmounts = append(tokenAmounts, cciptypes.RampTokenAmount{
+			SourcePoolAddress: token.sourcePool.Bytes(),
+			DestTokenAddress:  encodeAddress(token.destToken),
+			ExtraData:         token.extraData,
+			Amount:            cciptypes.NewBigInt(token.amount),
+			DestExecData:      abiEncodeUint32(t, token.destGasAmount),
+		})
+		offchainTokenData = append(offchainTokenData, token.offchainTokenData)
+	}
+
+	return cciptypes.ExecutePluginReport{
+		ChainReports: []cciptypes.ExecutePluginReportSingleChain{{
+			SourceChainSelector: s.header.SourceChainSelector,
+			Messages: []cciptypes.Message{{
+				Header:       s.header,
+				Sender:       s.sender,
+				Data:         s.data,
+				Receiver:     encodeAddress(s.receiver),
+				ExtraArgs:    extraArgs,
+				TokenAmounts: tokenAmounts,
+			}},
+			OffchainTokenData: [][][]byte{offchainTokenData},
+			Proofs:            s.proofs,
+			ProofFlagBits:     cciptypes.NewBigInt(s.proofFlagBits),
+		}},
+	}
+}
+
+// executeReportView is the chain agnostic content of an execute report. The message hash, onRamp, fee and extra
+// args are not part of the report sent onchain.
+type executeReportView struct {
+	SourceChainSelector cciptypes.ChainSelector
+	Messages            []string
+	TokenAmounts        []string
+	OffchainTokenData   []string
+	Proofs              []string
+}
+
+func viewExecuteReport(
+	t *testing.T, report cciptypes.ExecutePluginReport, decodeDestGasAmount func([]byte) uint32,
+) executeReportView {
+	require.Len(t, report.ChainReports, 1)
+	chainReport := report.ChainReports[0]
+	v := executeReportView{SourceChainSelector: chainReport.SourceChainSelector}
+	for _, msg := range chainReport.Messages {
+		v.Messages = append(v.Messages, fmt.Sprintf("%x:%d:%d:%d:%d:%x:%x:%x",
+			msg.Header.MessageID, msg.Header.SourceChainSelector, msg.Header.DestChainSelector,
+			msg.Header.SequenceNumber, msg.Header.Nonce, msg.Sender, common.LeftPadBytes(msg.Receiver, 32), msg.Data))
+		for _, token := range msg.TokenAmounts {
+			v.TokenAmounts = append(v.TokenAmounts, fmt.Sprintf("%x:%x:%x:%s:%d",
+				token.SourcePoolAddress, common.LeftPadBytes(token.DestTokenAddress, 32), token.ExtraData,
+				token.Amount.Int, decodeDestGasAmount(token.DestExecData)))
+		}
+	}
+	for _, msgTokenData := range chainReport.OffchainTokenData {
+		for _, tokenData := range msgTokenData {
+			v.OffchainTokenData = append(v.OffchainTokenData, fmt.Sprintf("%x", tokenData))
+		}
+	}
+	for _, proof := range chainReport.Proofs {
+		v.Proofs = append(v.Proofs, proof.String())
+	}
+	return v
+}
+
+// svmMessageSpec is a message sent by an EVM chain to Solana. Token amounts are limited to 64 bits, the most an SPL
+// token can hold.
+type svmMessageSpec struct {
+	header    cciptypes.RampMessageHeader
+	sender    []byte
+	receiver  solanago.PublicKey
+	data      []byte
+	extraArgs message_hasher.ClientSVMExtraArgsV1
+	tokens    []svmTokenTransferSpec
+}
+
+type svmTokenTransferSpec struct {
+	sourcePool    []byte
+	destToken     solanago.PublicKey
+	extraData     []byte
+	amount        uint64
+	destGasAmount uint32
+}
+
+func randomSVMMessageSpec(rng *rand.Rand) svmMessageSpec {
+	spec := svmMessageSpec{
+		header: cciptypes.RampMessageHeader{
+			MessageID:           randomBytes32(rng),
+			SourceChainSelector: ethMainnetSelector,
+			DestChainSelector:   cciptypes.ChainSelector(chainsel.SOLANA_MAINNET.Selector),
+			SequenceNumber:      cciptypes.SeqNum(rng.Uint64()),
+			Nonce:               rng.Uint64(),
+			OnRamp:              common.LeftPadBytes(randomBytes(rng, common.AddressLength), 32),
+		},
+		sender:    common.LeftPadBytes(randomBytes(rng, common.AddressLength), 32),
+		data:      randomBytes(rng, rng.Intn(512)),
+		extraArgs: randomSVMExtraArgs(rng),
+	}
+	// a zero receiver is a token only transfer
+	if rng.Intn(4) > 0 {
+		spec.receiver = solanago.PublicKeyFromBytes(randomBytes(rng, solanago.PublicKeyLength))
+	}
+
+	numTokens := rng.Intn(4)
+	for i := 0; i < numTokens; i++ {
+		spec.tokens = append(spec.tokens, svmTokenTransferSpec{
+			sourcePool:    common.LeftPadBytes(randomBytes(rng, common.AddressLength), 32),
+			destToken:     solanago.PublicKeyFromBytes(randomBytes(rng, solanago.PublicKeyLength)),
+			extraData:     randomBytes(rng, rng.Intn(64)),
+			amount:        rng.Uint64(),
+			destGasAmount: rng.Uint32(),
+		})
+	}
+	return spec
+}
+
+// message returns the message as observed on the EVM source chain.
+func (s svmMessageSpec) message(t *testing.T) cciptypes.Message {
+	extraArgs := abiEncodeSVMExtraArgs(t, s.extraArgs)
+	tokenAmounts := make([]cciptypes.RampTokenAmount, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokenAmounts = append(tokenAmounts, cciptypes.RampTokenAmount{
+			SourcePoolAddress: token.sourcePool,
+			DestTokenAddress:  token.destToken.Bytes(),
+			ExtraData:         token.extraData,
+			Amount:            cciptypes.NewBigInt(new(big.Int).SetUint64(token.amount)),
+			DestExecData:      abiEncodeUint32(t, token.destGasAmount),
+		})
+	}
+	return cciptypes.Message{
+		Header:       s.header,
+		Sender:       s.sender,
+		Receiver:     s.receiver.Bytes(),
+		Data:         s.data,
+		ExtraArgs:    extraArgs,
+		TokenAmounts: tokenAmounts,
+	}
+}
+
+// any2SVMMessage returns the message as executed by the Solana offRamp.
+func (s svmMessageSpec) any2SVMMessage() ccip_offramp.Any2SVMRampMessage {
+	tokenAmounts := make([]ccip_offramp.Any2SVMTokenTransfer, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokenAmounts = append(tokenAmounts, ccip_offramp.Any2SVMTokenTransfer{
+			SourcePoolAddress: token.sourcePool,
+			DestTokenAddress:  token.destToken,
+			ExtraData:         token.extraData,
+			Amount:            ccip_offramp.CrossChainAmount{LeBytes: tokens.ToLittleEndianU256(token.amount)},
+			DestGasAmount:     token.destGasAmount,
+		})
+	}
+	return ccip_offramp.Any2SVMRampMessage{
+		Header: ccip_offramp.RampMessageHeader{
+			MessageId:           s.header.MessageID,
+			SourceChainSelector: uint64(s.header.SourceChainSelector),
+			DestChainSelector:   uint64(s.header.DestChainSelector),
+			SequenceNumber:      uint64(s.header.SequenceNumber),
+			Nonce:               s.header.Nonce,
+		},
+		Sender:        s.sender,
+		Data:          s.data,
+		TokenReceiver: solanago.PublicKeyFromBytes(s.extraArgs.TokenReceiver[:]),
+		TokenAmounts:  tokenAmounts,
+		ExtraArgs: ccip_offramp.Any2SVMRampExtraArgs{
+			ComputeUnits:     s.extraArgs.ComputeUnits,
+			IsWritableBitmap: s.extraArgs.AccountIsWritableBitmap,
+		},
+	}
+}
+
+// accountsWithReceiver returns the accounts of the message, led by the receiver unless the message has none.
+func (s svmMessageSpec) accountsWithReceiver() []solanago.PublicKey {
+	var accounts []solanago.PublicKey
+	if !s.receiver.IsZero() {
+		accounts = append(accounts, s.receiver)
+	}
+	for _, account := range s.extraArgs.Accounts {
+		accounts = append(accounts, solanago.PublicKeyFromBytes(account[:]))
+	}
+	return accounts
+}
+
+func randomSVMExtraArgs(rng *rand.Rand) message_hasher.ClientSVMExtraArgsV1 {
+	args := message_hasher.ClientSVMExtraArgsV1{
+		ComputeUnits:             rng.Uint32(),
+		AccountIsWritableBitmap:  rng.Uint64(),
+		AllowOutOfOrderExecution: true,
+		TokenReceiver:            randomBytes32(rng),
+		Accounts:                 [][32]byte{},
+	}
+	numAccounts := rng.Intn(4)
+	for i := 0; i < numAccounts; i++ {
+		args.Accounts = append(args.Accounts, randomBytes32(rng))
+	}
+	return args
+}
+
+func abiEncodeSVMExtraArgs(t *testing.T, args message_hasher.ClientSVMExtraArgsV1) []byte {
+	encoded, err := ccipevm.SerializeClientSVMExtraArgsV1(args)
+	require.NoError(t, err)
+	return encoded
+}
+
+func borshEncodeSVMExtraArgs(t *testing.T, args message_hasher.ClientSVMExtraArgsV1) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, fee_quoter.SVMExtraArgsV1{
+		ComputeUnits:             args.ComputeUnits,
+		AccountIsWritableBitmap:  args.AccountIsWritableBitmap,
+		AllowOutOfOrderExecution: args.AllowOutOfOrderExecution,
+		TokenReceiver:            args.TokenReceiver,
+		Accounts:                 args.Accounts,
+	}.MarshalWithEncoder(agbinary.NewBorshEncoder(&buf)))
+	return append(hexutil.MustDecode("0x1f3b3aba"), buf.Bytes()...)
+}
+
+// lowercaseKeys returns the decoded extra args keyed the same way for every source chain family.
+func lowercaseKeys(decoded map[string]any) map[string]any {
+	out := make(map[string]any, len(decoded))
+	for k, v := range decoded {
+		out[strings.ToLower(k)] = v
+	}
+	return out
+}
+
+func uint128ToBigInt(v agbinary.Uint128) *big.Int {
+	return new(big.Int).Or(new(big.Int).Lsh(new(big.Int).SetUint64(v.Hi), 64), new(big.Int).SetUint64(v.Lo))
+}
+
+// randomizeMessage returns a copy of the message with random header, data, gas limit and token amounts.
+func randomizeMessage(t *testing.T, rng *rand.Rand, msg cciptypes.Message) cciptypes.Message {
+	msg.Header.MessageID = randomBytes32(rng)
+	msg.Header.DestChainSelector = cciptypes.ChainSelector(rng.Uint64())
+	msg.Header.SequenceNumber = cciptypes.SeqNum(rng.Uint64())
+	msg.Header.Nonce = rng.Uint64()
+	msg.Data = randomBytes(rng, rng.Intn(512))
+
+	extraArgs, err := ccipevm.SerializeClientGenericExtraArgsV2(message_hasher.ClientGenericExtraArgsV2{
+		GasLimit:                 randomBigInt(rng, 256),
+		AllowOutOfOrderExecution: rng.Intn(2) == 0,
+	})
+	require.NoError(t, err)
+	msg.ExtraArgs = extraArgs
+
+	tokenAmounts := make([]cciptypes.RampTokenAmount, len(msg.TokenAmounts))
+	for i, token := range msg.TokenAmounts {
+		token.Amount = cciptypes.NewBigInt(randomBigInt(rng, 256))
+		token.ExtraData = randomBytes(rng, rng.Intn(64))
+		token.DestExecData = abiEncodeUint32(t, rng.Uint32())
+		tokenAmounts[i] = token
+	}
+	msg.TokenAmounts = tokenAmounts
+	return msg
+}
+
+func toMessageHasherMessage(msg offramp.InternalAny2EVMRampMessage) message_hasher.InternalAny2EVMRampMessage {
+	tokenAmounts := make([]message_hasher.InternalAny2EVMTokenTransfer, 0, len(msg.TokenAmounts))
+	for _, token := range msg.TokenAmounts {
+		tokenAmounts = append(tokenAmounts, message_hasher.InternalAny2EVMTokenTransfer{
+			SourcePoolAddress: token.SourcePoolAddress,
+			DestTokenAddress:  token.DestTokenAddress,
+			ExtraData:         token.ExtraData,
+			Amount:            token.Amount,
+			DestGasAmount:     token.DestGasAmount,
+		})
+	}
+	return message_hasher.InternalAny2EVMRampMessage{
+		Header: message_hasher.InternalRampMessageHeader{
+			MessageId:           msg.Header.MessageId,
+			SourceChainSelector: msg.Header.SourceChainSelector,
+			DestChainSelector:   msg.Header.DestChainSelector,
+			SequenceNumber:      msg.Header.SequenceNumber,
+			Nonce:               msg.Header.Nonce,
+		},
+		Sender:       msg.Sender,
+		Data:         msg.Data,
+		Receiver:     msg.Receiver,
+		GasLimit:     msg.GasLimit,
+		TokenAmounts: tokenAmounts,
+	}
+}
+
+func deployMessageHasher(tb testing.TB) *message_hasher.MessageHasher {
+	key, err := crypto.GenerateKey()
+	require.NoError(tb, err)
+	transactor, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(tb, err)
+	backend := backends.NewSimulatedBackend(types.GenesisAlloc{
+		transactor.From: {Balance: assets.Ether(1000).ToInt()},
+	}, 30e6)
+	tb.Cleanup(func() { require.NoError(tb, backend.Close()) })
+
+	_, _, contract, err := message_hasher.DeployMessageHasher(transactor, backend)
+	require.NoError(tb, err)
+	backend.Commit()
+	return contract
+}
+
+func abiEncodeUint32(t *testing.T, v uint32) []byte {
+	encoded, err := utils.ABIEncode(`[{ "type": "uint32" }]`, v)
+	require.NoError(t, err)
+	return encoded
+}
+
+func abiDecodeUint32(data []byte) uint32 {
+	return uint32(new(big.Int).SetBytes(data).Uint64())
+}
+
+func randomBytes(rng *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	_, _ = rng.Read(b)
+	return b
+}
+
+func randomBytes32(rng *rand.Rand) [32]byte {
+	return [32]byte(randomBytes(rng, 32))
+}
+
+func randomBigInt(rng *rand.Rand, bits uint) *big.Int {
+	return new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), bits))
+}
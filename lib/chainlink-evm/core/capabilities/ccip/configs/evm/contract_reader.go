@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec"
@@ -23,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/rmn_remote"
 	kcr "github.com/smartcontractkit/chainlink-evm/gethwrappers/keystone/generated/capabilities_registry_1_1_0"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
@@ -52,15 +54,20 @@ var (
 	routerABI               = evmtypes.MustGetABI(router.RouterABI)
 )
 
+// MergeReaderConfigs merges the contracts of the configs, the Multicall3 address is taken from the first config setting it.
 func MergeReaderConfigs(configs ...evmrelaytypes.ChainReaderConfig) evmrelaytypes.ChainReaderConfig {
 	allContracts := make(map[string]evmrelaytypes.ChainContractReader)
+	var multicall3Address *common.Address
 	for _, c := range configs {
 		for contractName, contractReader := range c.Contracts {
 			allContracts[contractName] = contractReader
 		}
+		if multicall3Address == nil {
+			multicall3Address = c.Multicall3Address
+		}
 	}
 
-	return evmrelaytypes.ChainReaderConfig{Contracts: allContracts}
+	return evmrelaytypes.ChainReaderConfig{Contracts: allContracts, Multicall3Address: multicall3Address}
 }
 
 // DestReaderConfig returns a ChainReaderConfig that can be used to read from the offramp.
@@ -72,6 +79,9 @@ var DestReaderConfig = evmrelaytypes.ChainReaderConfig{
 				GenericEventNames: []string{
 					mustGetEventName(consts.EventNameExecutionStateChanged, offrampABI),
 					mustGetEventName(consts.EventNameCommitReportAccepted, offrampABI),
+					// invalidate the source chain configs cached by the CCIPReader
+					mustGetEventName("SourceChainConfigSet", offrampABI),
+					mustGetEventName("SourceChainSelectorAdded", offrampABI),
 				},
 				PollingFilter: evmrelaytypes.PollingFilter{
 					Retention: models.Interval(DefaultCCIPLogsRetention),
@@ -114,6 +124,14 @@ var DestReaderConfig = evmrelaytypes.ChainReaderConfig{
 					ChainSpecificName: mustGetEventName(consts.EventNameCommitReportAccepted, offrampABI),
 					ReadType:          evmrelaytypes.Event,
 				},
+				"SourceChainConfigSet": {
+					ChainSpecificName: mustGetEventName("SourceChainConfigSet", offrampABI),
+					ReadType:          evmrelaytypes.Event,
+				},
+				"SourceChainAdded": {
+					ChainSpecificName: mustGetEventName("SourceChainSelectorAdded", offrampABI),
+					ReadType:          evmrelaytypes.Event,
+				},
 				consts.EventNameExecutionStateChanged: {
 					ChainSpecificName: mustGetEventName(consts.EventNameExecutionStateChanged, offrampABI),
 					ReadType:          evmrelaytypes.Event,
@@ -202,6 +220,10 @@ var DestReaderConfig = evmrelaytypes.ChainReaderConfig{
 		consts.ContractNameRMNRemote: {
 			ContractABI: rmn_remote.RMNRemoteABI,
 			ContractPollingFilter: evmrelaytypes.ContractPollingFilter{
+				GenericEventNames: []string{
+					// invalidates the RMNRemote config cached by the CCIPReader
+					mustGetEventName("ConfigSet", rmnRemoteABI),
+				},
 				PollingFilter: evmrelaytypes.PollingFilter{
 					Retention: models.Interval(DefaultCCIPLogsRetention),
 				},
@@ -219,6 +241,10 @@ var DestReaderConfig = evmrelaytypes.ChainReaderConfig{
 					ChainSpecificName: mustGetMethodName("getCursedSubjects", rmnRemoteABI),
 					ReadType:          evmrelaytypes.Method,
 				},
+				"ConfigSet": {
+					ChainSpecificName: mustGetEventName("ConfigSet", rmnRemoteABI),
+					ReadType:          evmrelaytypes.Event,
+				},
 			},
 		},
 		consts.ContractNameRMNProxy: {
@@ -250,6 +276,7 @@ var DestReaderConfig = evmrelaytypes.ChainReaderConfig{
 			},
 		},
 	},
+	Multicall3Address: ptr(read.CanonicalMulticall3Address),
 }
 
 // SourceReaderConfig returns a ChainReaderConfig that can be used to read from the onramp.
@@ -372,6 +399,7 @@ var SourceReaderConfig = evmrelaytypes.ChainReaderConfig{
 			},
 		},
 	},
+	Multicall3Address: ptr(read.CanonicalMulticall3Address),
 }
 
 // FeedReaderConfig provides a ChainReaderConfig that can be used to read from a price feed
@@ -103,6 +103,21 @@ func DestContractReaderConfig() (config.ContractReader, error) {
 							&codec.ElementExtractorModifierConfig{Extractions: map[string]*codec.ElementExtractorLocation{"UnblessedMerkleRoots": &locationFirst}},
 						},
 					},
+					// the source chain config events invalidate the configs cached by the CCIPReader
+					"SourceChainConfigSet": {
+						ChainSpecificName: "SourceChainConfigUpdated",
+						ReadType:          config.Event,
+						EventDefinitions: &config.EventDefinitions{
+							PollingFilter: &config.PollingFilter{},
+						},
+					},
+					"SourceChainAdded": {
+						ChainSpecificName: "SourceChainAdded",
+						ReadType:          config.Event,
+						EventDefinitions: &config.EventDefinitions{
+							PollingFilter: &config.PollingFilter{},
+						},
+					},
 					consts.MethodNameOffRampLatestConfigDetails: {
 						ChainSpecificName: "Config",
 						ReadType:          config.Account,
@@ -404,6 +419,14 @@ func DestContractReaderConfig() (config.ContractReader, error) {
 							Prefix: []byte("config"),
 						},
 					},
+					// invalidates the config cached by the CCIPReader
+					"ConfigSet": {
+						ChainSpecificName: "ConfigSet",
+						ReadType:          config.Event,
+						EventDefinitions: &config.EventDefinitions{
+							PollingFilter: &config.PollingFilter{},
+						},
+					},
 					consts.MethodNameGetCursedSubjects: {
 						ChainSpecificName: "Curses",
 						ReadType:          config.Account,
@@ -149,6 +149,16 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing Ethereum Transaction Attempts",
 			Subcommands: initAttemptsSubCmds(s),
 		},
+		{
+			Name:        "automation",
+			Usage:       "Commands for managing Automation jobs",
+			Subcommands: initAutomationSubCmds(s),
+		},
+		{
+			Name:        "blockhashstore",
+			Usage:       "Commands for inspecting and backfilling blockhash store jobs",
+			Subcommands: initBlockhashStoreSubCmds(s),
+		},
 		{
 			Name:        "blocks",
 			Aliases:     []string{},
@@ -268,6 +278,20 @@ func NewApp(s *Shell) *cli.App {
 					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
 					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
 					SentryEnabled:  s.Config.Sentry().DSN() != "",
+					NamedLevels:    s.Config.NamedLogLevels(),
+				}
+				if otlp := s.Config.Log().OTLP(); otlp.Enabled() {
+					lggrCfg.OTLP = &logger.OTLPConfig{
+						Endpoint:           otlp.Endpoint(),
+						InsecureConnection: otlp.InsecureConnection(),
+						CACertFile:         otlp.CACertFile(),
+						Level:              otlp.Level(),
+						QueueSize:          int(otlp.QueueSize()),
+						BatchSize:          int(otlp.BatchSize()),
+						ExportInterval:     otlp.ExportInterval(),
+						ExportTimeout:      otlp.ExportTimeout(),
+						ResourceAttributes: s.Config.Telemetry().ResourceAttributes(),
+					}
 				}
 				l, closeFn := lggrCfg.New()
 
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAutomationSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "backfill",
+			Usage: "Replay the logs of a log trigger upkeep in a block range, skipping the logs that were already performed",
+			Description: "Backfills are kept in the memory of the node only. Replayed logs are only performed once enough " +
+				"nodes of the DON agree to recover them, so the identical backfill must be enqueued on every node of the DON. " +
+				"Backfills are lost when the node restarts and must be enqueued again.",
+			Action: s.CreateAutomationBackfill,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "job-id",
+					Usage:    "ID of the automation job",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "upkeep-id",
+					Usage:    "ID of the log trigger upkeep",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "from-block",
+					Usage:    "First block of the range",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "to-block",
+					Usage:    "Last block of the range",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "backfills",
+			Usage:  "List the active and recently finished log backfills of an automation job",
+			Action: s.ListAutomationBackfills,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "job-id",
+					Usage:    "ID of the automation job",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "diagnose",
+			Usage:  "Explain why an upkeep is or isn't eligible to be performed by an automation job",
+			Action: s.DiagnoseAutomationUpkeep,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "job-id",
+					Usage:    "ID of the automation job",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "upkeep-id",
+					Usage:    "ID of the upkeep",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:  "block",
+					Usage: "Block to check a conditional upkeep at, defaults to the latest block",
+				},
+				cli.StringFlag{
+					Name:  "tx-hash",
+					Usage: "Hash of the tx of the triggering log, required for log trigger upkeeps",
+				},
+				cli.Int64Flag{
+					Name:  "log-index",
+					Usage: "Index of the triggering log in its block",
+				},
+			},
+		},
+	}
+}
+
+// AutomationBackfillPresenter implements TableRenderer for an AutomationBackfillResource.
+type AutomationBackfillPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.AutomationBackfillResource
+}
+
+var automationBackfillHeaders = []string{
+	"ID", "Upkeep ID", "From Block", "To Block", "Scanned Block", "Done",
+	"Replayed", "Performed", "Ineligible", "Already Performed", "Already Ineligible", "Error", "Created At",
+}
+
+// ToRow presents the AutomationBackfillResource as a slice of strings.
+func (p *AutomationBackfillPresenter) ToRow() []string {
+	statuses := map[string]int{}
+	for _, l := range p.Logs {
+		statuses[l.Status]++
+	}
+
+	return []string{
+		p.GetID(),
+		p.UpkeepID,
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.ScannedBlock, 10),
+		strconv.FormatBool(p.Done),
+		strconv.Itoa(statuses[string(logprovider.BackfillLogReplayed)]),
+		strconv.Itoa(statuses[string(logprovider.BackfillLogPerformed)]),
+		strconv.Itoa(statuses[string(logprovider.BackfillLogIneligible)]),
+		strconv.Itoa(statuses[string(logprovider.BackfillLogAlreadyPerformed)]),
+		strconv.Itoa(statuses[string(logprovider.BackfillLogAlreadyIneligible)]),
+		p.Error,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AutomationBackfillPresenter) RenderTable(rt RendererTable) error {
+	renderList(automationBackfillHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// AutomationBackfillPresenters implements TableRenderer for a slice of AutomationBackfillPresenter.
+type AutomationBackfillPresenters []AutomationBackfillPresenter
+
+// RenderTable implements TableRenderer
+func (ps AutomationBackfillPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(automationBackfillHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// CreateAutomationBackfill enqueues a backfill of the logs of a log trigger upkeep.
+func (s *Shell) CreateAutomationBackfill(c *cli.Context) (err error) {
+	upkeepID, ok := new(big.Int).SetString(c.String("upkeep-id"), 10)
+	if !ok {
+		return s.errorOut(errors.New("invalid upkeep-id"))
+	}
+
+	request, err := json.Marshal(web.CreateAutomationBackfillRequest{
+		UpkeepID:  ubig.New(upkeepID),
+		FromBlock: c.Int64("from-block"),
+		ToBlock:   c.Int64("to-block"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/jobs/%d/automation/backfills", c.Int64("job-id")), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AutomationBackfillPresenter{}, "Backfill enqueued")
+}
+
+// ListAutomationBackfills lists the log backfills of an automation job.
+func (s *Shell) ListAutomationBackfills(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%d/automation/backfills", c.Int64("job-id")))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AutomationBackfillPresenters{}, "Backfills")
+}
+
+// AutomationUpkeepDiagnosisPresenter implements TableRenderer for an AutomationUpkeepDiagnosisResource.
+type AutomationUpkeepDiagnosisPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.AutomationUpkeepDiagnosisResource
+}
+
+var automationUpkeepDiagnosisHeaders = []string{"Upkeep ID", "Trigger Type", "Check Block", "Work ID", "Eligible", "Reason"}
+
+// ToRow presents the AutomationUpkeepDiagnosisResource as a slice of strings.
+func (p *AutomationUpkeepDiagnosisPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.TriggerType,
+		strconv.FormatInt(p.CheckBlock, 10),
+		p.WorkID,
+		strconv.FormatBool(p.Eligible),
+		p.Reason,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AutomationUpkeepDiagnosisPresenter) RenderTable(rt RendererTable) error {
+	renderList(automationUpkeepDiagnosisHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	table := rt.newTable([]string{"Step", "Status", "Message"})
+	for _, step := range p.Steps {
+		table.Append([]string{step.Name, step.Status, step.Message})
+	}
+	render("Diagnosis Steps", table)
+
+	return nil
+}
+
+// DiagnoseAutomationUpkeep diagnoses an upkeep of an automation job.
+func (s *Shell) DiagnoseAutomationUpkeep(c *cli.Context) (err error) {
+	upkeepID, ok := new(big.Int).SetString(c.String("upkeep-id"), 10)
+	if !ok {
+		return s.errorOut(errors.New("invalid upkeep-id"))
+	}
+
+	query := url.Values{}
+	if c.IsSet("block") {
+		query.Set("block", strconv.FormatInt(c.Int64("block"), 10))
+	}
+	if c.IsSet("tx-hash") {
+		query.Set("txHash", c.String("tx-hash"))
+		query.Set("logIndex", strconv.FormatInt(c.Int64("log-index"), 10))
+	}
+
+	path := fmt.Sprintf("/v2/jobs/%d/automation/upkeeps/%s/diagnosis", c.Int64("job-id"), upkeepID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AutomationUpkeepDiagnosisPresenter{}, "Upkeep diagnosis")
+}
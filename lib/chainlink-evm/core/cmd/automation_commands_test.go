@@ -0,0 +1,89 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAutomationBackfillPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id        = "7"
+		upkeepID  = "1843548457736589226156809205796175506139185429616502850435279853710366065936"
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.AutomationBackfillPresenter{
+		AutomationBackfillResource: presenters.AutomationBackfillResource{
+			JAID:         presenters.NewJAID(id),
+			UpkeepID:     upkeepID,
+			FromBlock:    100,
+			ToBlock:      200,
+			ScannedBlock: 150,
+			CreatedAt:    createdAt,
+			Logs: []presenters.AutomationBackfillLog{
+				{WorkID: "1", Status: "performed"},
+				{WorkID: "2", Status: "already_performed"},
+			},
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, upkeepID)
+	assert.Contains(t, output, "150")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.AutomationBackfillPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, upkeepID)
+}
+
+func TestAutomationUpkeepDiagnosisPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		upkeepID = "1843548457736589226156809205796175506139185429616502850435279853710366065936"
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.AutomationUpkeepDiagnosisPresenter{
+		AutomationUpkeepDiagnosisResource: presenters.AutomationUpkeepDiagnosisResource{
+			JAID:        presenters.NewJAID(upkeepID),
+			TriggerType: "conditional",
+			CheckBlock:  123,
+			WorkID:      "abc",
+			Reason:      "upkeep is paused",
+			Steps: []presenters.AutomationDiagnosisStep{
+				{Name: "registry", Status: "failed", Message: "upkeep is paused"},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, upkeepID)
+	assert.Contains(t, output, "conditional")
+	assert.Contains(t, output, "123")
+	assert.Contains(t, output, "upkeep is paused")
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initBlockhashStoreSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "requests",
+			Usage:  "List the pending VRF requests of a blockhash store job's coordinators and whether their blockhashes are stored",
+			Action: s.ListBlockhashStoreRequests,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "job-id",
+					Usage:    "ID of the blockhash store job",
+					Required: true,
+				},
+				cli.Uint64Flag{
+					Name:  "from-block",
+					Usage: "First block of the range, defaults to the start of the job's lookback window",
+				},
+				cli.Uint64Flag{
+					Name:  "to-block",
+					Usage: "Last block of the range, defaults to the latest block if --from-block is set, else the end of the job's lookback window",
+				},
+			},
+		},
+		{
+			Name:   "backfill",
+			Usage:  "Store the missing blockhashes of the blocks with pending VRF requests in a block range",
+			Action: s.BackfillBlockhashStore,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "job-id",
+					Usage:    "ID of the blockhash store job",
+					Required: true,
+				},
+				cli.Uint64Flag{
+					Name:     "from-block",
+					Usage:    "First block of the range",
+					Required: true,
+				},
+				cli.Uint64Flag{
+					Name:     "to-block",
+					Usage:    "Last block of the range",
+					Required: true,
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "Report the blockhashes which would be stored without sending any transaction",
+				},
+				cli.Uint64Flag{
+					Name:  "gas-budget",
+					Usage: "Maximum total gas limit of the backfill transactions, unlimited if unset",
+				},
+				cli.StringFlag{
+					Name:  "batch-bhs-address",
+					Usage: "Address of the BatchBlockhashStore used to store blockhashes older than 256 blocks in an untrusted blockhash store",
+				},
+			},
+		},
+	}
+}
+
+// BlockhashStoreRequestsPresenter implements TableRenderer for a BlockhashStoreRequestsResource.
+type BlockhashStoreRequestsPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.BlockhashStoreRequestsResource
+}
+
+// RenderTable implements TableRenderer
+func (p *BlockhashStoreRequestsPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Job ID", "From Block", "To Block", "Latest Block"}, [][]string{{
+		p.GetID(),
+		strconv.FormatUint(p.FromBlock, 10),
+		strconv.FormatUint(p.ToBlock, 10),
+		strconv.FormatUint(p.LatestBlock, 10),
+	}}, rt.Writer)
+
+	table := rt.newTable([]string{"Coordinator", "Address", "Request ID", "Block", "Stored", "Error"})
+	for _, c := range p.Coordinators {
+		for _, r := range c.Requests {
+			table.Append([]string{
+				c.Version,
+				c.Address.Hex(),
+				r.ID,
+				strconv.FormatUint(r.Block, 10),
+				strconv.FormatBool(r.Stored),
+				r.Error,
+			})
+		}
+	}
+	render("Pending Requests", table)
+
+	return nil
+}
+
+// ListBlockhashStoreRequests lists the pending VRF requests of a blockhash store job's coordinators.
+func (s *Shell) ListBlockhashStoreRequests(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("from-block") {
+		query.Set("fromBlock", strconv.FormatUint(c.Uint64("from-block"), 10))
+	}
+	if c.IsSet("to-block") {
+		query.Set("toBlock", strconv.FormatUint(c.Uint64("to-block"), 10))
+	}
+
+	path := fmt.Sprintf("/v2/jobs/%d/blockhash_store/requests", c.Int64("job-id"))
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BlockhashStoreRequestsPresenter{}, "Blockhash store requests")
+}
+
+// BlockhashStoreBackfillPresenter implements TableRenderer for a BlockhashStoreBackfillResource.
+type BlockhashStoreBackfillPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.BlockhashStoreBackfillResource
+}
+
+// RenderTable implements TableRenderer
+func (p *BlockhashStoreBackfillPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Job ID", "From Block", "To Block", "Latest Block", "Dry Run", "Gas Budget", "Gas Limit", "Transactions"}, [][]string{{
+		p.GetID(),
+		strconv.FormatUint(p.FromBlock, 10),
+		strconv.FormatUint(p.ToBlock, 10),
+		strconv.FormatUint(p.LatestBlock, 10),
+		strconv.FormatBool(p.DryRun),
+		strconv.FormatUint(p.GasBudget, 10),
+		strconv.FormatUint(p.GasLimit, 10),
+		strconv.Itoa(p.Transactions),
+	}}, rt.Writer)
+
+	table := rt.newTable([]string{"Block", "Status", "Reason", "Request IDs"})
+	for _, b := range p.Blocks {
+		table.Append([]string{
+			strconv.FormatUint(b.Block, 10),
+			b.Status,
+			b.Reason,
+			strings.Join(b.RequestIDs, ", "),
+		})
+	}
+	render("Blocks", table)
+
+	return nil
+}
+
+// BackfillBlockhashStore stores the missing blockhashes of a blockhash store job in a block range.
+func (s *Shell) BackfillBlockhashStore(c *cli.Context) (err error) {
+	req := web.CreateBlockhashStoreBackfillRequest{
+		FromBlock: c.Uint64("from-block"),
+		ToBlock:   c.Uint64("to-block"),
+		DryRun:    c.Bool("dry-run"),
+		GasBudget: c.Uint64("gas-budget"),
+	}
+	if c.IsSet("batch-bhs-address") {
+		if !common.IsHexAddress(c.String("batch-bhs-address")) {
+			return s.errorOut(errors.New("invalid batch-bhs-address"))
+		}
+		address := common.HexToAddress(c.String("batch-bhs-address"))
+		req.BatchBlockhashStoreAddress = &address
+	}
+
+	request, err := json.Marshal(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/jobs/%d/blockhash_store/backfills", c.Int64("job-id")), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BlockhashStoreBackfillPresenter{}, "Blockhash store backfill")
+}
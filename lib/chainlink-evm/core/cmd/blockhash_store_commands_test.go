@@ -0,0 +1,80 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestBlockhashStoreRequestsPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		address = common.HexToAddress("0x469aA2CD13e037DC5236320783dCfd0e641c0559")
+		buffer  = bytes.NewBufferString("")
+		r       = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.BlockhashStoreRequestsPresenter{
+		BlockhashStoreRequestsResource: presenters.BlockhashStoreRequestsResource{
+			JAID:        presenters.NewJAIDInt32(7),
+			FromBlock:   900,
+			ToBlock:     975,
+			LatestBlock: 1000,
+			Coordinators: []presenters.BlockhashStoreCoordinatorRequests{
+				{Version: "v2plus", Address: address, Requests: []presenters.BlockhashStoreRequest{
+					{ID: "4242", Block: 940, Stored: true},
+				}},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "975")
+	assert.Contains(t, output, "v2plus")
+	assert.Contains(t, output, address.Hex())
+	assert.Contains(t, output, "4242")
+	assert.Contains(t, output, "940")
+}
+
+func TestBlockhashStoreBackfillPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.BlockhashStoreBackfillPresenter{
+		BlockhashStoreBackfillResource: presenters.BlockhashStoreBackfillResource{
+			JAID:         presenters.NewJAIDInt32(7),
+			FromBlock:    100,
+			ToBlock:      200,
+			LatestBlock:  1000,
+			DryRun:       true,
+			GasBudget:    1500000,
+			GasLimit:     1000000,
+			Transactions: 2,
+			Blocks: []presenters.BlockhashStoreBackfillBlock{
+				{Block: 150, RequestIDs: []string{"1", "2"}, Status: "would_store"},
+				{Block: 160, RequestIDs: []string{"3"}, Status: "skipped", Reason: "gas budget exceeded"},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "1500000")
+	assert.Contains(t, output, "would_store")
+	assert.Contains(t, output, "1, 2")
+	assert.Contains(t, output, "gas budget exceeded")
+}
@@ -79,6 +79,18 @@ func (rt RendererTable) renderLogPkgConfig(serviceLevelLog webpresenters.Service
 	}
 
 	render("ServiceLogConfig", table)
+
+	if len(serviceLevelLog.NamedLogLevels) > 0 {
+		table = rt.newTable([]string{"Name", "LogLevel", "Expires At"})
+		for _, l := range serviceLevelLog.NamedLogLevels {
+			var expiresAt string
+			if l.ExpiresAt != nil {
+				expiresAt = l.ExpiresAt.String()
+			}
+			table.Append([]string{l.Name, l.Level, expiresAt})
+		}
+		render("NamedLogLevels", table)
+	}
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
@@ -51,6 +52,19 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 					Name:  "level",
 					Usage: "set log level for node (debug||info||warn||error)",
 				},
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "set the log level only for the loggers with this name, and their sub-loggers (e.g. CCIPExec)",
+				},
+				cli.DurationFlag{
+					Name:  "ttl",
+					Usage: "with --name, revert the log level after this duration, or never if 0",
+					Value: time.Hour,
+				},
+				cli.BoolFlag{
+					Name:  "reset",
+					Usage: "with --name, revert to the configured log level of the loggers",
+				},
 			},
 		},
 		{
@@ -345,6 +359,15 @@ func normalizePassword(password string) string {
 func (s *Shell) SetLogLevel(c *cli.Context) (err error) {
 	logLevel := c.String("level")
 	request := web.LogPatchRequest{Level: logLevel}
+	if name := c.String("name"); name != "" {
+		request.Name = name
+		request.Reset = c.Bool("reset")
+		if !request.Reset {
+			request.TTL = c.Duration("ttl").String()
+		}
+	} else if c.IsSet("ttl") || c.Bool("reset") {
+		return s.errorOut(errors.New("--ttl and --reset require --name"))
+	}
 	requestData, err := json.Marshal(request)
 	if err != nil {
 		return s.errorOut(err)
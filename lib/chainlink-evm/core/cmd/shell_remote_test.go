@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/freeport"
 
@@ -736,3 +737,37 @@ func TestShell_SetLogConfig(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, sqlEnabled, app.Config.Database().LogSQL())
 }
+
+func TestShell_SetNamedLogLevel(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+	globalLevel := app.Config.Log().Level()
+
+	set := flag.NewFlagSet("loglevel", 0)
+	flagSetApplyFromAction(client.SetLogLevel, set, "")
+	require.NoError(t, set.Set("level", "debug"))
+	require.NoError(t, set.Set("name", "CCIPExec"))
+	require.NoError(t, set.Set("ttl", "30m"))
+
+	require.NoError(t, client.SetLogLevel(cli.NewContext(nil, set, nil)))
+	assert.Equal(t, globalLevel, app.Config.Log().Level())
+	lvl, ok := app.Config.NamedLogLevels().Level("OCR2.CCIPExec")
+	require.True(t, ok)
+	assert.Equal(t, zapcore.DebugLevel, lvl)
+
+	set = flag.NewFlagSet("loglevel", 0)
+	flagSetApplyFromAction(client.SetLogLevel, set, "")
+	require.NoError(t, set.Set("name", "CCIPExec"))
+	require.NoError(t, set.Set("reset", "true"))
+
+	require.NoError(t, client.SetLogLevel(cli.NewContext(nil, set, nil)))
+	_, ok = app.Config.NamedLogLevels().Level("OCR2.CCIPExec")
+	assert.False(t, ok)
+
+	set = flag.NewFlagSet("loglevel", 0)
+	flagSetApplyFromAction(client.SetLogLevel, set, "")
+	require.NoError(t, set.Set("reset", "true"))
+	require.Error(t, client.SetLogLevel(cli.NewContext(nil, set, nil)))
+}
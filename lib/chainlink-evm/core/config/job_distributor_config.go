@@ -0,0 +1,12 @@
+package config
+
+type JobDistributor interface {
+	AutoApprovalPolicies() []JobDistributorApprovalPolicy
+}
+
+type JobDistributorApprovalPolicy interface {
+	Name() string
+	JobTypes() []string
+	FeedsManagers() []string
+	ChangedFields() []string
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -17,7 +19,22 @@ type Log interface {
 	DefaultLevel() zapcore.Level
 	JSONConsole() bool
 	Level() zapcore.Level
+	// Levels returns the log levels per logger name, which override Level.
+	Levels() map[string]zapcore.Level
 	UnixTimestamps() bool
 
 	File() File
+	OTLP() OTLP
+}
+
+type OTLP interface {
+	Enabled() bool
+	Endpoint() string
+	InsecureConnection() bool
+	CACertFile() string
+	Level() zapcore.Level
+	QueueSize() uint32
+	BatchSize() uint32
+	ExportInterval() time.Duration
+	ExportTimeout() time.Duration
 }
@@ -114,6 +114,53 @@ func (_c *TelemetryIngress_Endpoints_Call) RunAndReturn(run func() []config.Tele
 	return _c
 }
 
+// FileSink provides a mock function with no fields
+func (_m *TelemetryIngress) FileSink() config.TelemetryIngressFileSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileSink")
+	}
+
+	var r0 config.TelemetryIngressFileSink
+	if rf, ok := ret.Get(0).(func() config.TelemetryIngressFileSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TelemetryIngressFileSink)
+		}
+	}
+
+	return r0
+}
+
+// TelemetryIngress_FileSink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileSink'
+type TelemetryIngress_FileSink_Call struct {
+	*mock.Call
+}
+
+// FileSink is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) FileSink() *TelemetryIngress_FileSink_Call {
+	return &TelemetryIngress_FileSink_Call{Call: _e.mock.On("FileSink")}
+}
+
+func (_c *TelemetryIngress_FileSink_Call) Run(run func()) *TelemetryIngress_FileSink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_FileSink_Call) Return(_a0 config.TelemetryIngressFileSink) *TelemetryIngress_FileSink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_FileSink_Call) RunAndReturn(run func() config.TelemetryIngressFileSink) *TelemetryIngress_FileSink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logging provides a mock function with no fields
 func (_m *TelemetryIngress) Logging() bool {
 	ret := _m.Called()
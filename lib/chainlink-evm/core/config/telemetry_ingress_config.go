@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -13,6 +15,7 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	FileSink() TelemetryIngressFileSink
 	Endpoints() []TelemetryIngressEndpoint
 }
 
@@ -22,3 +25,15 @@ type TelemetryIngressEndpoint interface {
 	ServerPubKey() string
 	URL() *url.URL
 }
+
+type TelemetryIngressFileSink interface {
+	Enabled() bool
+	Dir() string
+	Format() string
+	// MaxSize is the size files are rotated at, a whole number of megabytes.
+	MaxSize() utils.FileSize
+	// MaxBackups is the number of rotated files kept for each telemetry type, at least 1.
+	MaxBackups() int64
+	// Types returns the format of each telemetry type written, or nil when all types are written in the default Format.
+	Types() map[string]string
+}
@@ -1,6 +1,8 @@
 package toml
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
@@ -8,6 +10,7 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -23,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -41,6 +45,7 @@ type Core struct {
 	ShutdownGracePeriod *commonconfig.Duration
 
 	Feature          Feature          `toml:",omitempty"`
+	JobDistributor   JobDistributor   `toml:",omitempty"`
 	Database         Database         `toml:",omitempty"`
 	TelemetryIngress TelemetryIngress `toml:",omitempty"`
 	AuditLogger      AuditLogger      `toml:",omitempty"`
@@ -80,6 +85,7 @@ func (c *Core) SetFrom(f *Core) {
 	}
 
 	c.Feature.setFrom(&f.Feature)
+	c.JobDistributor.setFrom(&f.JobDistributor)
 	c.Database.setFrom(&f.Database)
 	c.TelemetryIngress.setFrom(&f.TelemetryIngress)
 	c.AuditLogger.SetFrom(&f.AuditLogger)
@@ -471,6 +477,65 @@ func (f *Feature) setFrom(f2 *Feature) {
 	}
 }
 
+// JobDistributor configures the handling of job proposals from the Job Distributor (feeds managers).
+type JobDistributor struct {
+	AutoApprovalPolicies []JobDistributorApprovalPolicy `toml:",omitempty"`
+}
+
+func (j *JobDistributor) setFrom(f *JobDistributor) {
+	if v := f.AutoApprovalPolicies; v != nil {
+		j.AutoApprovalPolicies = v
+	}
+}
+
+func (j *JobDistributor) ValidateConfig() (err error) {
+	names := configutils.UniqueStrings{}
+	for _, p := range j.AutoApprovalPolicies {
+		if names.IsDupe(p.Name) {
+			err = multierr.Append(err, configutils.NewErrDuplicate("AutoApprovalPolicies.Name", *p.Name))
+		}
+	}
+	return
+}
+
+// JobDistributorApprovalPolicy auto-approves the job proposal specs which match all of its criteria. Empty criteria
+// match any spec, but a policy must restrict either the FeedsManagers or the ChangedFields.
+type JobDistributorApprovalPolicy struct {
+	Name *string
+	// JobTypes are the job types of the specs, e.g. "ccip".
+	JobTypes []string `toml:",omitempty"`
+	// FeedsManagers are the hex encoded public keys of the feeds managers proposing the specs.
+	FeedsManagers []string `toml:",omitempty"`
+	// ChangedFields are the dot-separated paths of the fields which updates of an approved spec may change, including
+	// their nested fields.
+	ChangedFields []string `toml:",omitempty"`
+}
+
+func (p *JobDistributorApprovalPolicy) ValidateConfig() (err error) {
+	if p.Name == nil || *p.Name == "" {
+		err = multierr.Append(err, configutils.ErrEmpty{Name: "Name", Msg: "must be provided and non-empty"})
+	}
+	if len(p.FeedsManagers) == 0 && len(p.ChangedFields) == 0 {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "FeedsManagers", Msg: "must be provided if ChangedFields is empty"})
+	}
+	for _, t := range p.JobTypes {
+		if t == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "JobTypes", Msg: "must not contain empty job types"})
+		}
+	}
+	for _, k := range p.FeedsManagers {
+		if b, herr := hex.DecodeString(k); herr != nil || len(b) != ed25519.PublicKeySize {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "FeedsManagers", Value: k, Msg: "must be a hex encoded ed25519 public key"})
+		}
+	}
+	for _, f := range p.ChangedFields {
+		if f == "" || slices.Contains(strings.Split(f, "."), "") {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "ChangedFields", Value: f, Msg: "must be a dot-separated path without empty segments"})
+		}
+	}
+	return
+}
+
 type Database struct {
 	DefaultIdleInTxSessionTimeout *commonconfig.Duration
 	DefaultLockTimeout            *commonconfig.Duration
@@ -598,6 +663,7 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	FileSink     TelemetryIngressFileSink   `toml:",omitempty"`
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
@@ -630,11 +696,88 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	t.FileSink.setFrom(&f.FileSink)
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
 }
 
+// TelemetryIngressFileSink configures writing telemetry to rotating local files, in addition to the ingress endpoints.
+// Types restricts the telemetry types written, which must be known telemetry types, and overrides their Format. All
+// types are written when it is empty.
+type TelemetryIngressFileSink struct {
+	Enabled    *bool
+	Dir        *string
+	Format     *string
+	MaxSize    *utils.FileSize
+	MaxBackups *int64
+
+	Types map[string]TelemetryIngressFileSinkType `toml:",omitempty"`
+}
+
+type TelemetryIngressFileSinkType struct {
+	Format *string
+}
+
+func (t *TelemetryIngressFileSink) setFrom(f *TelemetryIngressFileSink) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Dir; v != nil {
+		t.Dir = v
+	}
+	if v := f.Format; v != nil {
+		t.Format = v
+	}
+	if v := f.MaxSize; v != nil {
+		t.MaxSize = v
+	}
+	if v := f.MaxBackups; v != nil {
+		t.MaxBackups = v
+	}
+	if f.Types != nil {
+		if t.Types == nil {
+			t.Types = make(map[string]TelemetryIngressFileSinkType)
+		}
+		maps.Copy(t.Types, f.Types)
+	}
+}
+
+func (t *TelemetryIngressFileSink) ValidateConfig() (err error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+	if t.Format != nil && !isTelemetryFileSinkFormat(*t.Format) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Format", Value: *t.Format, Msg: "must be one of 'ndjson' or 'protobuf'"})
+	}
+	// files are rotated by whole megabytes
+	if t.MaxSize != nil && (*t.MaxSize < utils.MB || *t.MaxSize%utils.MB != 0) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxSize", Value: *t.MaxSize, Msg: "must be a whole number of megabytes, at least 1mb"})
+	}
+	// no limit on the rotated files would fill the disk
+	if t.MaxBackups != nil && *t.MaxBackups < 1 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxBackups", Value: *t.MaxBackups, Msg: "must be at least 1"})
+	}
+	for _, name := range slices.Sorted(maps.Keys(t.Types)) {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "Types", Msg: "telemetry type must be non-empty"})
+			continue
+		}
+		if !slices.Contains(synchronization.TelemetryTypes, synchronization.TelemetryType(name)) {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Types", Value: name, Msg: "unknown telemetry type"})
+			continue
+		}
+		if f := t.Types[name].Format; f != nil && !isTelemetryFileSinkFormat(*f) {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Types." + name + ".Format", Value: *f, Msg: "must be one of 'ndjson' or 'protobuf'"})
+		}
+	}
+	return
+}
+
+func isTelemetryFileSinkFormat(format string) bool {
+	return format == "ndjson" || format == "protobuf"
+}
+
 type AuditLogger struct {
 	Enabled        *bool
 	ForwardToUrl   *commonconfig.URL
@@ -694,7 +837,11 @@ type Log struct {
 	JSONConsole *bool
 	UnixTS      *bool
 
-	File LogFile `toml:",omitempty"`
+	// Levels override Level for the named loggers and their sub-loggers. The File and OTLP sinks keep their own levels,
+	// a named level only drops the entries below it there.
+	Levels map[string]LogLevel `toml:",omitempty"`
+	File   LogFile             `toml:",omitempty"`
+	OTLP   LogOTLP             `toml:",omitempty"`
 }
 
 func (l *Log) setFrom(f *Log) {
@@ -707,7 +854,83 @@ func (l *Log) setFrom(f *Log) {
 	if v := f.UnixTS; v != nil {
 		l.UnixTS = v
 	}
+	if len(f.Levels) > 0 {
+		if l.Levels == nil {
+			l.Levels = make(map[string]LogLevel, len(f.Levels))
+		}
+		maps.Copy(l.Levels, f.Levels)
+	}
 	l.File.setFrom(&f.File)
+	l.OTLP.setFrom(&f.OTLP)
+}
+
+func (l *Log) ValidateConfig() (err error) {
+	for _, name := range slices.Sorted(maps.Keys(l.Levels)) {
+		if name == "" || slices.Contains(strings.Split(name, "."), "") {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Levels", Value: name, Msg: "must be a logger name of non-empty, dot-separated segments"})
+		}
+	}
+	return
+}
+
+// LogOTLP configures the export of logs to an OpenTelemetry collector. Unset fields fall back to the defaults of the
+// logger.OTLPConfig.
+type LogOTLP struct {
+	Enabled            *bool
+	Endpoint           *string
+	InsecureConnection *bool
+	CACertFile         *string
+	Level              *LogLevel
+	QueueSize          *uint32
+	BatchSize          *uint32
+	ExportInterval     *commonconfig.Duration
+	ExportTimeout      *commonconfig.Duration
+}
+
+func (l *LogOTLP) setFrom(f *LogOTLP) {
+	if v := f.Enabled; v != nil {
+		l.Enabled = v
+	}
+	if v := f.Endpoint; v != nil {
+		l.Endpoint = v
+	}
+	if v := f.InsecureConnection; v != nil {
+		l.InsecureConnection = v
+	}
+	if v := f.CACertFile; v != nil {
+		l.CACertFile = v
+	}
+	if v := f.Level; v != nil {
+		l.Level = v
+	}
+	if v := f.QueueSize; v != nil {
+		l.QueueSize = v
+	}
+	if v := f.BatchSize; v != nil {
+		l.BatchSize = v
+	}
+	if v := f.ExportInterval; v != nil {
+		l.ExportInterval = v
+	}
+	if v := f.ExportTimeout; v != nil {
+		l.ExportTimeout = v
+	}
+}
+
+func (l *LogOTLP) ValidateConfig() (err error) {
+	if l.Enabled == nil || !*l.Enabled {
+		return
+	}
+	if l.Endpoint == nil || *l.Endpoint == "" {
+		err = multierr.Append(err, configutils.ErrEmpty{Name: "Endpoint", Msg: "must be provided and non-empty when OTLP is enabled"})
+	}
+	if l.InsecureConnection != nil && *l.InsecureConnection && l.CACertFile != nil && *l.CACertFile != "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CACertFile", Value: *l.CACertFile, Msg: "must be empty when InsecureConnection is true"})
+	}
+	if l.QueueSize != nil && l.BatchSize != nil && *l.BatchSize > *l.QueueSize {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "BatchSize", Value: *l.BatchSize, Msg: fmt.Sprintf("must not be greater than QueueSize %d", *l.QueueSize)})
+	}
+	return
 }
 
 type LogFile struct {
@@ -580,6 +580,75 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestTelemetryIngressFileSink_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileSink TelemetryIngressFileSink
+		errMsg   string
+	}{
+		{
+			name:     "disabled",
+			fileSink: TelemetryIngressFileSink{Format: ptr("csv")},
+		},
+		{
+			name: "valid",
+			fileSink: TelemetryIngressFileSink{
+				Enabled:    ptr(true),
+				Format:     ptr("protobuf"),
+				MaxSize:    ptr[utils.FileSize](utils.MB),
+				MaxBackups: ptr[int64](1),
+				Types:      map[string]TelemetryIngressFileSinkType{"ocr": {}, "head-report": {Format: ptr("ndjson")}},
+			},
+		},
+		{
+			name:     "invalid format",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), Format: ptr("csv")},
+			errMsg:   configutils.ErrInvalid{Name: "Format", Value: "csv", Msg: "must be one of 'ndjson' or 'protobuf'"}.Error(),
+		},
+		{
+			name:     "max size too small",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), MaxSize: ptr[utils.FileSize](utils.KB)},
+			errMsg:   configutils.ErrInvalid{Name: "MaxSize", Value: utils.FileSize(utils.KB), Msg: "must be a whole number of megabytes, at least 1mb"}.Error(),
+		},
+		{
+			name:     "max size not whole megabytes",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), MaxSize: ptr[utils.FileSize](utils.MB + utils.KB)},
+			errMsg:   configutils.ErrInvalid{Name: "MaxSize", Value: utils.FileSize(utils.MB + utils.KB), Msg: "must be a whole number of megabytes, at least 1mb"}.Error(),
+		},
+		{
+			name:     "unlimited max backups",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), MaxBackups: ptr[int64](0)},
+			errMsg:   configutils.ErrInvalid{Name: "MaxBackups", Value: int64(0), Msg: "must be at least 1"}.Error(),
+		},
+		{
+			name:     "negative max backups",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), MaxBackups: ptr[int64](-1)},
+			errMsg:   configutils.ErrInvalid{Name: "MaxBackups", Value: int64(-1), Msg: "must be at least 1"}.Error(),
+		},
+		{
+			name:     "invalid type format",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), Types: map[string]TelemetryIngressFileSinkType{"ocr": {Format: ptr("csv")}}},
+			errMsg:   configutils.ErrInvalid{Name: "Types.ocr.Format", Value: "csv", Msg: "must be one of 'ndjson' or 'protobuf'"}.Error(),
+		},
+		{
+			name:     "unknown type",
+			fileSink: TelemetryIngressFileSink{Enabled: ptr(true), Types: map[string]TelemetryIngressFileSinkType{"ocr": {}, "ocr4": {}}},
+			errMsg:   configutils.ErrInvalid{Name: "Types", Value: "ocr4", Msg: "unknown telemetry type"}.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fileSink.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
 func TestEthKeys_TOMLSerialization(t *testing.T) {
 	t.Parallel()
 	t.Run("encode", func(t *testing.T) {
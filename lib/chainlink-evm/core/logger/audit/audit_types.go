@@ -84,10 +84,16 @@ const (
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
+	NamedLogLevelSet         EventID = "NAMED_LOG_LEVEL_SET"
+	NamedLogLevelReset       EventID = "NAMED_LOG_LEVEL_RESET"
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
 
+	AutomationBackfillCreated EventID = "AUTOMATION_BACKFILL_CREATED"
+
+	BlockhashStoreBackfillCreated EventID = "BLOCKHASH_STORE_BACKFILL_CREATED"
+
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
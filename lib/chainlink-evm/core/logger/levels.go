@@ -0,0 +1,256 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// NamedLevel is a log level override for the loggers with Name, and all of their sub-loggers.
+type NamedLevel struct {
+	Name  string
+	Level zapcore.Level
+	// ExpiresAt is the time at which a runtime override reverts, or zero if it doesn't expire.
+	ExpiresAt time.Time
+}
+
+// NamedLevels holds log levels per logger name, which take precedence over the global log level.
+//
+// Names are matched hierarchically, on whole dot-separated segments: a level set for "CCIPExec" applies to the loggers
+// "CCIPExec", "OCR2.CCIPExec" and "OCR2.CCIPExec.TokenDataObserver", but not to "CCIPExecution". When several names
+// match, the most specific one (with the most segments) wins.
+//
+// Static levels are set from the config at startup. Runtime levels override them, optionally for a limited time.
+type NamedLevels struct {
+	mu      sync.Mutex
+	static  map[string]zapcore.Level
+	runtime map[string]*runtimeLevel
+
+	levels atomic.Pointer[[]NamedLevel] // sorted by name, read on every log entry
+}
+
+type runtimeLevel struct {
+	NamedLevel
+	timer *time.Timer
+}
+
+// NewNamedLevels returns a new NamedLevels with the static levels.
+func NewNamedLevels(static map[string]zapcore.Level) (*NamedLevels, error) {
+	n := &NamedLevels{
+		static:  make(map[string]zapcore.Level, len(static)),
+		runtime: make(map[string]*runtimeLevel),
+	}
+	for name, lvl := range static {
+		if err := validateLoggerName(name); err != nil {
+			return nil, err
+		}
+		n.static[name] = lvl
+	}
+	n.mu.Lock()
+	n.update()
+	n.mu.Unlock()
+	return n, nil
+}
+
+func validateLoggerName(name string) error {
+	if name == "" {
+		return errors.New("logger name must not be empty")
+	}
+	if slices.Contains(strings.Split(name, "."), "") {
+		return fmt.Errorf("invalid logger name %q: segments must not be empty", name)
+	}
+	return nil
+}
+
+// Set sets the level of the loggers with name, overriding any static level. If ttl is positive, the level reverts
+// after ttl.
+func (n *NamedLevels) Set(name string, lvl zapcore.Level, ttl time.Duration) error {
+	if err := validateLoggerName(name); err != nil {
+		return err
+	}
+	if ttl < 0 {
+		return fmt.Errorf("invalid ttl %s: must not be negative", ttl)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.stop(name)
+	r := &runtimeLevel{NamedLevel: NamedLevel{Name: name, Level: lvl}}
+	if ttl > 0 {
+		r.ExpiresAt = time.Now().Add(ttl)
+		r.timer = time.AfterFunc(ttl, func() { n.expire(r) })
+	}
+	n.runtime[name] = r
+	n.update()
+	return nil
+}
+
+// Unset removes the runtime level of the loggers with name, reverting to the static level if there is one. It
+// returns false if there was no runtime level for name.
+func (n *NamedLevels) Unset(name string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.stop(name) {
+		return false
+	}
+	n.update()
+	return true
+}
+
+// List returns the effective named levels, sorted by name.
+func (n *NamedLevels) List() []NamedLevel {
+	return slices.Clone(*n.levels.Load())
+}
+
+// Level returns the level for the logger with name, and false if no named level applies to it.
+func (n *NamedLevels) Level(name string) (zapcore.Level, bool) {
+	var (
+		lvl      zapcore.Level
+		segments int
+	)
+	for _, l := range *n.levels.Load() {
+		if !matchesLoggerName(l.Name, name) {
+			continue
+		}
+		if s := strings.Count(l.Name, ".") + 1; s > segments {
+			lvl, segments = l.Level, s
+		}
+	}
+	return lvl, segments > 0
+}
+
+// minLevel returns the lowest named level, and false if there are none.
+func (n *NamedLevels) minLevel() (zapcore.Level, bool) {
+	levels := *n.levels.Load()
+	if len(levels) == 0 {
+		return zapcore.InvalidLevel, false
+	}
+	lvl := levels[0].Level
+	for _, l := range levels[1:] {
+		lvl = min(lvl, l.Level)
+	}
+	return lvl, true
+}
+
+// matchesLoggerName returns true if the segments of pattern are a contiguous run of the segments of name.
+func matchesLoggerName(pattern, name string) bool {
+	return name == pattern ||
+		strings.HasPrefix(name, pattern+".") ||
+		strings.HasSuffix(name, "."+pattern) ||
+		strings.Contains(name, "."+pattern+".")
+}
+
+func (n *NamedLevels) expire(r *runtimeLevel) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.runtime[r.Name] != r {
+		return // replaced or unset since
+	}
+	delete(n.runtime, r.Name)
+	n.update()
+}
+
+// stop removes the runtime level for name. n.mu must be held.
+func (n *NamedLevels) stop(name string) bool {
+	r, ok := n.runtime[name]
+	if !ok {
+		return false
+	}
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	delete(n.runtime, name)
+	return true
+}
+
+// update stores a new snapshot of the effective levels. n.mu must be held.
+func (n *NamedLevels) update() {
+	levels := make([]NamedLevel, 0, len(n.static)+len(n.runtime))
+	for name, lvl := range n.static {
+		if _, ok := n.runtime[name]; !ok {
+			levels = append(levels, NamedLevel{Name: name, Level: lvl})
+		}
+	}
+	for _, r := range n.runtime {
+		levels = append(levels, r.NamedLevel)
+	}
+	slices.SortFunc(levels, func(a, b NamedLevel) int { return strings.Compare(a.Name, b.Name) })
+	n.levels.Store(&levels)
+}
+
+var _ zapcore.Core = &namedLevelsCore{}
+
+// namedLevelsCore filters the entries of a zapcore.Core by the NamedLevels for the entry's logger name, falling back
+// to the global level.
+type namedLevelsCore struct {
+	zapcore.Core
+	global zap.AtomicLevel
+	levels *NamedLevels
+}
+
+// newNamedLevelsCore returns a zapcore.Core which writes to a new core with encoder and sink, filtered by levels and
+// the global level.
+func newNamedLevelsCore(encoder zapcore.Encoder, sink zapcore.WriteSyncer, global zap.AtomicLevel, levels *NamedLevels) zapcore.Core {
+	c := &namedLevelsCore{global: global, levels: levels}
+	c.Core = zapcore.NewCore(encoder, sink, zap.LevelEnablerFunc(c.Enabled))
+	return c
+}
+
+func (c *namedLevelsCore) Enabled(lvl zapcore.Level) bool {
+	if c.global.Enabled(lvl) {
+		return true
+	}
+	minLvl, ok := c.levels.minLevel()
+	return ok && lvl >= minLvl
+}
+
+func (c *namedLevelsCore) With(fields []zapcore.Field) zapcore.Core {
+	return &namedLevelsCore{Core: c.Core.With(fields), global: c.global, levels: c.levels}
+}
+
+func (c *namedLevelsCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if lvl, ok := c.levels.Level(ent.LoggerName); ok {
+		if ent.Level < lvl {
+			return ce
+		}
+	} else if !c.global.Enabled(ent.Level) {
+		return ce
+	}
+	return ce.AddCore(ent, c)
+}
+
+var _ zapcore.Core = &namedLevelsFilter{}
+
+// namedLevelsFilter drops the entries of a zapcore.Core below the NamedLevels for the entry's logger name. Unlike
+// namedLevelsCore it never writes entries below the core's own level, so named levels only quiet loggers in the sinks
+// with their own levels, like the disk and OTLP sinks.
+type namedLevelsFilter struct {
+	zapcore.Core
+	levels *NamedLevels
+}
+
+// filterNamedLevels returns core filtered by levels, or core if levels is nil.
+func filterNamedLevels(core zapcore.Core, levels *NamedLevels) zapcore.Core {
+	if levels == nil {
+		return core
+	}
+	return &namedLevelsFilter{Core: core, levels: levels}
+}
+
+func (c *namedLevelsFilter) With(fields []zapcore.Field) zapcore.Core {
+	return &namedLevelsFilter{Core: c.Core.With(fields), levels: c.levels}
+}
+
+func (c *namedLevelsFilter) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if lvl, ok := c.levels.Level(ent.LoggerName); ok && ent.Level < lvl {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
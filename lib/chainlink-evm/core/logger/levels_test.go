@@ -0,0 +1,153 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNamedLevels_Level(t *testing.T) {
+	levels, err := NewNamedLevels(map[string]zapcore.Level{
+		"CCIPExec":                   zapcore.DebugLevel,
+		"CCIPExec.TokenDataObserver": zapcore.WarnLevel,
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name  string
+		level zapcore.Level
+		found bool
+	}{
+		{"CCIPExec", zapcore.DebugLevel, true},
+		{"OCR2.CCIPExec", zapcore.DebugLevel, true},
+		{"OCR2.CCIPExec.ExecutionObservation", zapcore.DebugLevel, true},
+		{"OCR2.CCIPExec.TokenDataObserver", zapcore.WarnLevel, true},
+		{"OCR2.CCIPExec.TokenDataObserver.USDC", zapcore.WarnLevel, true},
+		{"CCIPExecution", 0, false},
+		{"OCR2.CCIPCommit", 0, false},
+		{"", 0, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lvl, ok := levels.Level(tc.name)
+			assert.Equal(t, tc.found, ok)
+			assert.Equal(t, tc.level, lvl)
+		})
+	}
+
+	_, err = NewNamedLevels(map[string]zapcore.Level{"CCIPExec.": zapcore.DebugLevel})
+	require.Error(t, err)
+}
+
+func TestNamedLevels_SetUnset(t *testing.T) {
+	levels, err := NewNamedLevels(map[string]zapcore.Level{"CCIPExec": zapcore.InfoLevel})
+	require.NoError(t, err)
+
+	require.Error(t, levels.Set("", zapcore.DebugLevel, 0))
+	require.Error(t, levels.Set("CCIPExec", zapcore.DebugLevel, -time.Second))
+
+	require.NoError(t, levels.Set("CCIPExec", zapcore.DebugLevel, 0))
+	require.NoError(t, levels.Set("TokenDataObserver", zapcore.DebugLevel, time.Hour))
+	list := levels.List()
+	require.Len(t, list, 2)
+	assert.Equal(t, NamedLevel{Name: "CCIPExec", Level: zapcore.DebugLevel}, list[0])
+	assert.Equal(t, "TokenDataObserver", list[1].Name)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), list[1].ExpiresAt, time.Minute)
+
+	// reverts to the static level
+	assert.True(t, levels.Unset("CCIPExec"))
+	assert.False(t, levels.Unset("CCIPExec"))
+	lvl, ok := levels.Level("CCIPExec")
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.InfoLevel, lvl)
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		require.NoError(t, levels.Set("CCIPExec", zapcore.DebugLevel, 10*time.Millisecond))
+		require.Eventually(t, func() bool {
+			lvl, _ := levels.Level("CCIPExec")
+			return lvl == zapcore.InfoLevel
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("replaced levels don't expire", func(t *testing.T) {
+		require.NoError(t, levels.Set("CCIPCommit", zapcore.DebugLevel, 10*time.Millisecond))
+		require.NoError(t, levels.Set("CCIPCommit", zapcore.WarnLevel, 0))
+		time.Sleep(50 * time.Millisecond)
+		lvl, ok := levels.Level("CCIPCommit")
+		assert.True(t, ok)
+		assert.Equal(t, zapcore.WarnLevel, lvl)
+	})
+}
+
+func TestNamedLevelsCore(t *testing.T) {
+	levels, err := NewNamedLevels(nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	global := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	core := newNamedLevelsCore(zapcore.NewConsoleEncoder(makeEncoderConfig(false)), zapcore.AddSync(&buf), global, levels)
+	root := zap.New(core).Sugar().With("key", "value")
+
+	root.Named("CCIPExec").Debug("exec debug 1")
+	root.Info("root info 1")
+	assert.NotContains(t, buf.String(), "exec debug 1")
+	assert.Contains(t, buf.String(), "root info 1")
+
+	require.NoError(t, levels.Set("CCIPExec", zapcore.DebugLevel, 0))
+	require.NoError(t, levels.Set("Noisy", zapcore.ErrorLevel, 0))
+	root.Named("OCR2").Named("CCIPExec").Debug("exec debug 2")
+	root.Debug("root debug")
+	root.Named("Noisy").Warn("noisy warn")
+	root.Named("Noisy").Error("noisy error")
+	assert.Contains(t, buf.String(), "exec debug 2")
+	assert.NotContains(t, buf.String(), "root debug")
+	assert.NotContains(t, buf.String(), "noisy warn")
+	assert.Contains(t, buf.String(), "noisy error")
+
+	global.SetLevel(zapcore.DebugLevel)
+	root.Debug("root debug 2")
+	assert.Contains(t, buf.String(), "root debug 2")
+}
+
+func TestNamedLevelsFilter(t *testing.T) {
+	levels, err := NewNamedLevels(map[string]zapcore.Level{
+		"Noisy":    zapcore.ErrorLevel,
+		"CCIPExec": zapcore.DebugLevel,
+	})
+	require.NoError(t, err)
+
+	t.Run("disk", func(t *testing.T) {
+		var buf bytes.Buffer
+		diskLevel := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+		core := zapcore.NewCore(zapcore.NewConsoleEncoder(makeEncoderConfig(false)), zapcore.AddSync(&buf), diskLevel)
+		root := zap.New(filterNamedLevels(core, levels)).Sugar().With("key", "value")
+
+		root.Named("Noisy").Warn("noisy warn")
+		root.Named("Noisy").Error("noisy error")
+		root.Named("CCIPExec").Debug("exec debug")
+		root.Info("root info")
+		assert.NotContains(t, buf.String(), "noisy warn")
+		assert.Contains(t, buf.String(), "noisy error")
+		// named levels don't lower the level of the sink
+		assert.NotContains(t, buf.String(), "exec debug")
+		assert.Contains(t, buf.String(), "root info")
+	})
+
+	t.Run("OTLP", func(t *testing.T) {
+		exporter := &testOTLPExporter{}
+		core, closeFn := newOTLPCoreWithExporter(OTLPConfig{Level: zapcore.InfoLevel, ExportInterval: time.Hour}, exporter)
+		root := zap.New(filterNamedLevels(core, levels)).Sugar()
+
+		root.Named("Noisy").Warn("noisy warn")
+		root.Named("CCIPExec").Debug("exec debug")
+		root.Named("OCR2").Named("Noisy").Error("noisy error")
+		require.NoError(t, closeFn())
+
+		records := exporter.records()
+		require.Len(t, records, 1)
+		assert.Equal(t, "noisy error", records[0].Body().AsString())
+	})
+}
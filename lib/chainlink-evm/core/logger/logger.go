@@ -163,6 +163,11 @@ type Config struct {
 	FileMaxAgeDays int
 	FileMaxBackups int // files
 	SentryEnabled  bool
+	// NamedLevels optionally overrides LogLevel for some named loggers. The disk and OTLP sinks keep their own levels,
+	// named levels only drop the entries below them there.
+	NamedLevels *NamedLevels
+	// OTLP optionally exports the entries to an OpenTelemetry collector.
+	OTLP *OTLPConfig
 
 	diskSpaceAvailableFn diskSpaceAvailableFn
 	diskPollConfig       zapDiskPollConfig
@@ -186,15 +191,32 @@ func (c *Config) New() (Logger, func() error) {
 		l           Logger
 		closeLogger func() error
 		err         error
+		cores       []zapcore.Core
+		closeOTLP   func() error
 	)
+	if c.OTLP != nil {
+		var otlpCore zapcore.Core
+		otlpCore, closeOTLP, err = newOTLPCore(*c.OTLP)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cores = append(cores, filterNamedLevels(otlpCore, c.NamedLevels))
+	}
 	if !c.DebugLogsToDisk() {
-		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS)
+		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS, c.NamedLevels, cores...)
 	} else {
-		l, closeLogger, err = newRotatingFileLogger(cfg, *c)
+		l, closeLogger, err = newRotatingFileLogger(cfg, *c, cores...)
 	}
 	if err != nil {
 		log.Fatal(err)
 	}
+	if closeOTLP != nil {
+		closeCores := closeLogger
+		closeLogger = func() error {
+			// flush the exported entries before closing the other sinks
+			return errors.Join(closeOTLP(), closeCores())
+		}
+	}
 
 	if c.SentryEnabled {
 		l = newSentryLogger(l)
@@ -240,11 +262,14 @@ func newZapConfigBase() zap.Config {
 	return cfg
 }
 
-func newDefaultLogger(zcfg zap.Config, unixTS bool) (Logger, func() error, error) {
-	core, coreCloseFn, err := newDefaultLoggingCore(zcfg, unixTS)
+func newDefaultLogger(zcfg zap.Config, unixTS bool, levels *NamedLevels, cores ...zapcore.Core) (Logger, func() error, error) {
+	core, coreCloseFn, err := newDefaultLoggingCore(zcfg, unixTS, levels)
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(cores) > 0 {
+		core = zapcore.NewTee(append(cores, core)...)
+	}
 
 	l, loggerCloseFn, err := newLoggerForCore(zcfg, core)
 	if err != nil {
@@ -271,7 +296,7 @@ func newLoggerForCore(zcfg zap.Config, core zapcore.Core) (*zapLogger, func(), e
 	}, closeFn, nil
 }
 
-func newDefaultLoggingCore(zcfg zap.Config, unixTS bool) (zapcore.Core, func(), error) {
+func newDefaultLoggingCore(zcfg zap.Config, unixTS bool, levels *NamedLevels) (zapcore.Core, func(), error) {
 	encoder := zapcore.NewJSONEncoder(makeEncoderConfig(unixTS))
 
 	sink, closeOut, err := zap.Open(zcfg.OutputPaths...)
@@ -283,6 +308,10 @@ func newDefaultLoggingCore(zcfg zap.Config, unixTS bool) (zapcore.Core, func(),
 		return nil, nil, errors.New("missing Level")
 	}
 
+	if levels != nil {
+		return newNamedLevelsCore(encoder, sink, zcfg.Level, levels), closeOut, nil
+	}
+
 	filteredLogLevels := zap.LevelEnablerFunc(zcfg.Level.Enabled)
 
 	core := zapcore.NewCore(encoder, sink, filteredLogLevels)
@@ -0,0 +1,445 @@
+package logger
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+)
+
+const (
+	// TraceIDKey and SpanIDKey are the fields correlating log entries with a trace, see WithSpanContext.
+	TraceIDKey = "trace_id"
+	SpanIDKey  = "span_id"
+
+	otlpDropReasonQueueFull    = "queue_full"
+	otlpDropReasonExportFailed = "export_failed"
+	otlpDropReasonShutdown     = "shutdown"
+)
+
+var (
+	otlpExportedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "log_otlp_exported_count",
+		Help: "Number of log records exported over OTLP",
+	})
+	otlpDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_otlp_dropped_count",
+		Help: "Number of log records dropped instead of being exported over OTLP, by reason",
+	}, []string{"reason"})
+)
+
+// WithSpanContext returns l with the trace and span IDs of the span in ctx, if there is one. Entries logged with them
+// are correlated with the trace when exported over OTLP.
+func WithSpanContext(ctx context.Context, l Logger) Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return l
+	}
+	return l.With(TraceIDKey, sc.TraceID().String(), SpanIDKey, sc.SpanID().String())
+}
+
+// OTLPConfig configures the export of log entries to an OpenTelemetry collector over OTLP/gRPC.
+type OTLPConfig struct {
+	Endpoint           string
+	InsecureConnection bool
+	CACertFile         string
+	// Level is the minimum level of the exported entries, independently of the console and disk levels.
+	Level zapcore.Level
+	// QueueSize bounds the entries waiting to be exported. Entries are dropped when the queue is full.
+	QueueSize      int
+	BatchSize      int
+	ExportInterval time.Duration
+	ExportTimeout  time.Duration
+	// ResourceAttributes are added to the resource of the exported records, next to the service name and version.
+	ResourceAttributes map[string]string
+}
+
+func (c OTLPConfig) withDefaults() OTLPConfig {
+	if c.QueueSize <= 0 {
+		c.QueueSize = 2048
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 512
+	}
+	c.BatchSize = min(c.BatchSize, c.QueueSize)
+	if c.ExportInterval <= 0 {
+		c.ExportInterval = time.Second
+	}
+	if c.ExportTimeout <= 0 {
+		c.ExportTimeout = 30 * time.Second
+	}
+	return c
+}
+
+func newOTLPCore(cfg OTLPConfig) (zapcore.Core, func() error, error) {
+	opts := []otlploggrpc.Option{otlploggrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.InsecureConnection {
+		opts = append(opts, otlploggrpc.WithInsecure())
+	} else if cfg.CACertFile != "" {
+		creds, err := credentials.NewClientTLSFromFile(cfg.CACertFile, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load OTLP CA cert: %w", err)
+		}
+		opts = append(opts, otlploggrpc.WithTLSCredentials(creds))
+	} else {
+		opts = append(opts, otlploggrpc.WithTLSCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})))
+	}
+	exporter, err := otlploggrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OTLP log exporter: %w", err)
+	}
+	core, closeFn := newOTLPCoreWithExporter(cfg, exporter)
+	return core, closeFn, nil
+}
+
+func newOTLPCoreWithExporter(cfg OTLPConfig, exporter sdklog.Exporter) (*otlpCore, func() error) {
+	cfg = cfg.withDefaults()
+	attrs := []attribute.KeyValue{
+		attribute.String("service.name", "chainlink"),
+		attribute.String("service.version", static.Version),
+	}
+	for _, k := range slices.Sorted(maps.Keys(cfg.ResourceAttributes)) {
+		attrs = append(attrs, attribute.String(k, cfg.ResourceAttributes[k]))
+	}
+
+	batcher := newOTLPBatcher(exporter, cfg)
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(sdkresource.NewSchemaless(attrs...)),
+		sdklog.WithProcessor(batcher),
+	)
+	core := &otlpCore{
+		LevelEnabler: zap.NewAtomicLevelAt(cfg.Level),
+		logger:       provider.Logger("github.com/smartcontractkit/chainlink/v2/core/logger"),
+		provider:     provider,
+		timeout:      cfg.ExportTimeout,
+	}
+	return core, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ExportTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}
+}
+
+var _ zapcore.Core = &otlpCore{}
+
+// otlpCore is a zapcore.Core emitting entries as OpenTelemetry log records.
+type otlpCore struct {
+	zapcore.LevelEnabler
+	logger   otellog.Logger
+	provider *sdklog.LoggerProvider
+	timeout  time.Duration
+	fields   []zapcore.Field
+}
+
+func (c *otlpCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(slices.Clip(c.fields), fields...)
+	return &clone
+}
+
+func (c *otlpCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *otlpCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+
+	ctx := context.Background()
+	if sc := spanContextFromFields(enc.Fields); sc.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, sc)
+		delete(enc.Fields, TraceIDKey)
+		delete(enc.Fields, SpanIDKey)
+	}
+
+	var r otellog.Record
+	r.SetTimestamp(ent.Time)
+	r.SetObservedTimestamp(time.Now())
+	r.SetSeverity(otlpSeverity(ent.Level))
+	r.SetSeverityText(ent.Level.CapitalString())
+	r.SetBody(otellog.StringValue(ent.Message))
+
+	attrs := make([]otellog.KeyValue, 0, len(enc.Fields)+3)
+	if ent.LoggerName != "" {
+		attrs = append(attrs, otellog.String("logger", ent.LoggerName))
+	}
+	if ent.Caller.Defined {
+		attrs = append(attrs, otellog.String("caller", ent.Caller.TrimmedPath()))
+	}
+	if ent.Stack != "" {
+		attrs = append(attrs, otellog.String("stacktrace", ent.Stack))
+	}
+	for _, k := range slices.Sorted(maps.Keys(enc.Fields)) {
+		attrs = append(attrs, otellog.KeyValue{Key: k, Value: otlpValue(enc.Fields[k])})
+	}
+	r.AddAttributes(attrs...)
+
+	c.logger.Emit(ctx, r)
+	return nil
+}
+
+func (c *otlpCore) Sync() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.provider.ForceFlush(ctx)
+}
+
+func spanContextFromFields(fields map[string]interface{}) trace.SpanContext {
+	traceID, _ := fields[TraceIDKey].(string)
+	spanID, _ := fields[SpanIDKey].(string)
+	if traceID == "" || spanID == "" {
+		return trace.SpanContext{}
+	}
+	tid, err := trace.TraceIDFromHex(traceID)
+	if err != nil {
+		return trace.SpanContext{}
+	}
+	sid, err := trace.SpanIDFromHex(spanID)
+	if err != nil {
+		return trace.SpanContext{}
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{TraceID: tid, SpanID: sid, TraceFlags: trace.FlagsSampled})
+}
+
+func otlpSeverity(lvl zapcore.Level) otellog.Severity {
+	switch lvl {
+	case zapcore.DebugLevel:
+		return otellog.SeverityDebug
+	case zapcore.InfoLevel:
+		return otellog.SeverityInfo
+	case zapcore.WarnLevel:
+		return otellog.SeverityWarn
+	case zapcore.ErrorLevel:
+		return otellog.SeverityError
+	case zapcore.DPanicLevel:
+		return otellog.SeverityFatal1
+	case zapcore.PanicLevel:
+		return otellog.SeverityFatal2
+	case zapcore.FatalLevel:
+		return otellog.SeverityFatal3
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+// otlpValue converts a value of a zapcore.MapObjectEncoder to a log attribute value.
+func otlpValue(v interface{}) otellog.Value {
+	switch v := v.(type) {
+	case string:
+		return otellog.StringValue(v)
+	case bool:
+		return otellog.BoolValue(v)
+	case int:
+		return otellog.IntValue(v)
+	case int8:
+		return otellog.Int64Value(int64(v))
+	case int16:
+		return otellog.Int64Value(int64(v))
+	case int32:
+		return otellog.Int64Value(int64(v))
+	case int64:
+		return otellog.Int64Value(v)
+	case uint8:
+		return otellog.Int64Value(int64(v))
+	case uint16:
+		return otellog.Int64Value(int64(v))
+	case uint32:
+		return otellog.Int64Value(int64(v))
+	case uint64:
+		if v > math.MaxInt64 {
+			return otellog.StringValue(fmt.Sprint(v))
+		}
+		return otellog.Int64Value(int64(v))
+	case float32:
+		return otellog.Float64Value(float64(v))
+	case float64:
+		return otellog.Float64Value(v)
+	case []byte:
+		return otellog.BytesValue(v)
+	case time.Time:
+		return otellog.StringValue(v.Format(time.RFC3339Nano))
+	case time.Duration:
+		return otellog.StringValue(v.String())
+	case []interface{}:
+		vals := make([]otellog.Value, len(v))
+		for i, e := range v {
+			vals[i] = otlpValue(e)
+		}
+		return otellog.SliceValue(vals...)
+	case map[string]interface{}:
+		kvs := make([]otellog.KeyValue, 0, len(v))
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			kvs = append(kvs, otellog.KeyValue{Key: k, Value: otlpValue(v[k])})
+		}
+		return otellog.MapValue(kvs...)
+	default:
+		return otellog.StringValue(fmt.Sprint(v))
+	}
+}
+
+var _ sdklog.Processor = &otlpBatcher{}
+
+// otlpBatcher is a sdklog.Processor exporting records in batches, from a bounded queue. Unlike the sdklog batch
+// processor, it counts the records it drops.
+type otlpBatcher struct {
+	exporter  sdklog.Exporter
+	batchSize int
+	interval  time.Duration
+	timeout   time.Duration
+
+	queue     chan sdklog.Record
+	flush     chan chan struct{}
+	stop      chan struct{}
+	stopOnce  sync.Once
+	done      chan struct{}
+	startOnce sync.Once
+}
+
+func newOTLPBatcher(exporter sdklog.Exporter, cfg OTLPConfig) *otlpBatcher {
+	return &otlpBatcher{
+		exporter:  exporter,
+		batchSize: cfg.BatchSize,
+		interval:  cfg.ExportInterval,
+		timeout:   cfg.ExportTimeout,
+		queue:     make(chan sdklog.Record, cfg.QueueSize),
+		flush:     make(chan chan struct{}),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// start starts exporting on the first record, so that unused loggers don't leak a goroutine.
+func (b *otlpBatcher) start() {
+	b.startOnce.Do(func() {
+		go b.run()
+	})
+}
+
+func (b *otlpBatcher) OnEmit(_ context.Context, r *sdklog.Record) error {
+	select {
+	case <-b.stop:
+		otlpDroppedCount.WithLabelValues(otlpDropReasonShutdown).Inc()
+		return nil
+	default:
+	}
+	b.start()
+	select {
+	case b.queue <- r.Clone():
+	default:
+		otlpDroppedCount.WithLabelValues(otlpDropReasonQueueFull).Inc()
+	}
+	return nil
+}
+
+func (b *otlpBatcher) ForceFlush(ctx context.Context) error {
+	b.start()
+	flushed := make(chan struct{})
+	select {
+	case b.flush <- flushed:
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return b.exporter.ForceFlush(ctx)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *otlpBatcher) Shutdown(ctx context.Context) error {
+	b.start()
+	b.stopOnce.Do(func() { close(b.stop) })
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return errors.Join(ctx.Err(), b.exporter.Shutdown(ctx))
+	}
+	return b.exporter.Shutdown(ctx)
+}
+
+func (b *otlpBatcher) run() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	batch := make([]sdklog.Record, 0, b.batchSize)
+	for {
+		select {
+		case r := <-b.queue:
+			batch = append(batch, r)
+			if len(batch) >= b.batchSize {
+				b.export(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			b.export(batch)
+			batch = batch[:0]
+		case flushed := <-b.flush:
+			b.export(b.drain(batch))
+			batch = batch[:0]
+			close(flushed)
+		case <-b.stop:
+			b.export(b.drain(batch))
+			return
+		}
+	}
+}
+
+// drain appends the queued records to batch.
+func (b *otlpBatcher) drain(batch []sdklog.Record) []sdklog.Record {
+	for {
+		select {
+		case r := <-b.queue:
+			batch = append(batch, r)
+		default:
+			return batch
+		}
+	}
+}
+
+// export exports records in batches of at most batchSize. Records failing to export are dropped.
+func (b *otlpBatcher) export(records []sdklog.Record) {
+	for len(records) > 0 {
+		n := min(len(records), b.batchSize)
+		ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+		err := b.exporter.Export(ctx, records[:n])
+		cancel()
+		if err != nil {
+			// not logged, as the error would be exported too
+			otlpDroppedCount.WithLabelValues(otlpDropReasonExportFailed).Add(float64(n))
+		} else {
+			otlpExportedCount.Add(float64(n))
+		}
+		records = records[n:]
+	}
+}
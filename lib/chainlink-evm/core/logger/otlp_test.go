@@ -0,0 +1,157 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type testOTLPExporter struct {
+	mu        sync.Mutex
+	batches   [][]sdklog.Record
+	err       error
+	exporting chan struct{}
+	block     chan struct{}
+}
+
+func (e *testOTLPExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	if e.block != nil {
+		select {
+		case e.exporting <- struct{}{}:
+		default:
+		}
+		<-e.block
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err != nil {
+		return e.err
+	}
+	batch := make([]sdklog.Record, len(records))
+	for i, r := range records {
+		batch[i] = r.Clone()
+	}
+	e.batches = append(e.batches, batch)
+	return nil
+}
+
+func (e *testOTLPExporter) Shutdown(ctx context.Context) error   { return nil }
+func (e *testOTLPExporter) ForceFlush(ctx context.Context) error { return nil }
+
+func (e *testOTLPExporter) records() (records []sdklog.Record) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, b := range e.batches {
+		records = append(records, b...)
+	}
+	return
+}
+
+func recordAttributes(r sdklog.Record) map[string]otellog.Value {
+	attrs := make(map[string]otellog.Value)
+	r.WalkAttributes(func(kv otellog.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	return attrs
+}
+
+func TestOTLPCore(t *testing.T) {
+	exporter := &testOTLPExporter{}
+	core, closeFn := newOTLPCoreWithExporter(OTLPConfig{
+		Level:              zapcore.InfoLevel,
+		BatchSize:          2,
+		ExportInterval:     time.Hour,
+		ResourceAttributes: map[string]string{"node": "test"},
+	}, exporter)
+	lggr := zap.New(core).Sugar().Named("CCIPExec").With("jobID", 42)
+
+	traceID, spanID := trace.TraceID{1}, trace.SpanID{2}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+
+	lggr.Debugw("not exported")
+	lggr.Infow("exported", "ok", true, "ratio", 0.5)
+	WithSpanContext(ctx, &zapLogger{SugaredLogger: lggr}).Errorw("exported with span", "err", errors.New("boom"))
+
+	// the batch of two is exported without waiting for the interval
+	require.Eventually(t, func() bool { return len(exporter.records()) == 2 }, time.Second, 10*time.Millisecond)
+	records := exporter.records()
+
+	assert.Equal(t, "exported", records[0].Body().AsString())
+	assert.Equal(t, otellog.SeverityInfo, records[0].Severity())
+	assert.Equal(t, "INFO", records[0].SeverityText())
+	assert.False(t, records[0].TraceID().IsValid())
+	attrs := recordAttributes(records[0])
+	assert.Equal(t, "CCIPExec", attrs["logger"].AsString())
+	assert.Equal(t, int64(42), attrs["jobID"].AsInt64())
+	assert.True(t, attrs["ok"].AsBool())
+	assert.InDelta(t, 0.5, attrs["ratio"].AsFloat64(), 0)
+	resource := records[0].Resource()
+	require.NotNil(t, resource)
+	v, ok := resource.Set().Value("node")
+	require.True(t, ok)
+	assert.Equal(t, "test", v.AsString())
+
+	assert.Equal(t, otellog.SeverityError, records[1].Severity())
+	assert.Equal(t, traceID, records[1].TraceID())
+	assert.Equal(t, spanID, records[1].SpanID())
+	attrs = recordAttributes(records[1])
+	assert.Equal(t, "boom", attrs["err"].AsString())
+	assert.NotContains(t, attrs, TraceIDKey)
+	assert.NotContains(t, attrs, SpanIDKey)
+
+	// the remaining entries are exported on close
+	lggr.Warn("exported on close")
+	require.NoError(t, closeFn())
+	require.Len(t, exporter.records(), 3)
+}
+
+func TestOTLPCore_Drops(t *testing.T) {
+	t.Run("queue full", func(t *testing.T) {
+		before := testutil.ToFloat64(otlpDroppedCount.WithLabelValues(otlpDropReasonQueueFull))
+		exporter := &testOTLPExporter{exporting: make(chan struct{}, 1), block: make(chan struct{})}
+		core, closeFn := newOTLPCoreWithExporter(OTLPConfig{QueueSize: 1, BatchSize: 1, ExportInterval: time.Hour}, exporter)
+		lggr := zap.New(core).Sugar()
+
+		// the first entry blocks the exporter, the second one fills the queue
+		lggr.Info("exporting")
+		select {
+		case <-exporter.exporting:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for export")
+		}
+		lggr.Info("queued")
+		for range 3 {
+			lggr.Info("dropped")
+		}
+		assert.Equal(t, before+3, testutil.ToFloat64(otlpDroppedCount.WithLabelValues(otlpDropReasonQueueFull)))
+
+		close(exporter.block)
+		require.NoError(t, closeFn())
+		assert.Len(t, exporter.records(), 2)
+	})
+
+	t.Run("export failed", func(t *testing.T) {
+		before := testutil.ToFloat64(otlpDroppedCount.WithLabelValues(otlpDropReasonExportFailed))
+		exporter := &testOTLPExporter{err: errors.New("unavailable")}
+		core, closeFn := newOTLPCoreWithExporter(OTLPConfig{ExportInterval: time.Hour}, exporter)
+		lggr := zap.New(core).Sugar()
+
+		lggr.Info("a")
+		lggr.Info("b")
+		require.NoError(t, core.Sync())
+		assert.Equal(t, before+2, testutil.ToFloat64(otlpDroppedCount.WithLabelValues(otlpDropReasonExportFailed)))
+		require.NoError(t, closeFn())
+	})
+}
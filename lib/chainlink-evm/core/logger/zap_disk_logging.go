@@ -87,7 +87,7 @@ func (l *zapDiskLogger) pollDiskSpace() {
 }
 
 func newRotatingFileLogger(zcfg zap.Config, c Config, cores ...zapcore.Core) (*zapDiskLogger, func() error, error) {
-	defaultCore, defaultCloseFn, err := newDefaultLoggingCore(zcfg, c.UnixTS)
+	defaultCore, defaultCloseFn, err := newDefaultLoggingCore(zcfg, c.UnixTS, c.NamedLevels)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -99,7 +99,7 @@ func newRotatingFileLogger(zcfg zap.Config, c Config, cores ...zapcore.Core) (*z
 		defaultCloseFn()
 		return nil, nil, diskErr
 	}
-	cores = append(cores, diskCore)
+	cores = append(cores, filterNamedLevels(diskCore, c.NamedLevels))
 
 	core := zapcore.NewTee(cores...)
 	l, diskCloseFn, err := newLoggerForCore(zcfg, core)
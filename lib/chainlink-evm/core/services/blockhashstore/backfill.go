@@ -0,0 +1,531 @@
+package blockhashstore
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+
+	evmkeystore "github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// blockhashWindow is the number of most recent blocks whose blockhash is available to the EVM.
+	blockhashWindow = 256
+	// backfillGetBlockhashesBatchSize is the number of blockhashes read per batch BHS call.
+	backfillGetBlockhashesBatchSize = 100
+	// backfillStoreHeadersBatchSize is the number of blockhashes stored per batch BHS transaction.
+	backfillStoreHeadersBatchSize = 10
+)
+
+var (
+	// ErrBackfillInvalidRange is returned for a backfill of an empty block range, or one after the latest block.
+	ErrBackfillInvalidRange = errors.New("invalid backfill block range")
+	// ErrBackfillInProgress is returned when a backfill is requested while another one of the same job is running.
+	ErrBackfillInProgress = errors.New("backfill already in progress")
+
+	errGasBudgetExceeded = errors.New("gas budget exceeded")
+)
+
+// BatchBHS defines an interface for interacting with a BatchBlockhashStore contract.
+type BatchBHS interface {
+	// GetBlockhashes returns blockhashes for given blockNumbers
+	GetBlockhashes(ctx context.Context, blockNumbers []*big.Int) ([][32]byte, error)
+
+	// StoreVerifyHeader stores blockhashes on-chain by using block headers
+	StoreVerifyHeader(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) error
+
+	// BHS returns the address of the BlockhashStore the blockhashes are stored in
+	BHS(ctx context.Context) (common.Address, error)
+}
+
+// BlockHeaderProvider provides the RLP-encoded headers of the child blocks of a block range.
+type BlockHeaderProvider interface {
+	RlpHeadersBatch(ctx context.Context, blockRange []*big.Int) ([][]byte, error)
+}
+
+// FeederCoordinator is a VRF coordinator fed by a BHS feeder job.
+type FeederCoordinator struct {
+	Coordinator
+	// Version of the coordinator, one of v1, v2 or v2plus.
+	Version string
+	Address common.Address
+}
+
+// PendingRequest is an unfulfilled VRF request.
+type PendingRequest struct {
+	ID    string
+	Block uint64
+	// Stored is true if the blockhash of the request block is stored.
+	Stored bool
+	// Error is set if the blockhash store could not be read.
+	Error string
+}
+
+// CoordinatorPendingRequests are the pending requests of a coordinator, sorted by block.
+type CoordinatorPendingRequests struct {
+	Version  string
+	Address  common.Address
+	Requests []PendingRequest
+}
+
+// PendingRequestsReport lists the pending requests of the coordinators of a BHS feeder job in a block range.
+type PendingRequestsReport struct {
+	FromBlock    uint64
+	ToBlock      uint64
+	LatestBlock  uint64
+	Coordinators []CoordinatorPendingRequests
+}
+
+// BackfillOptions configure a backfill of the blockhashes of the blocks with pending VRF requests.
+type BackfillOptions struct {
+	FromBlock uint64
+	ToBlock   uint64
+	// DryRun reports the blockhashes which would be stored without sending any transaction.
+	DryRun bool
+	// GasBudget is the maximum total gas limit of the backfill transactions, or zero for no limit. Blocks which
+	// don't fit in the budget are skipped.
+	GasBudget uint64
+	// BatchBlockhashStoreAddress is the BatchBlockhashStore used to store the blockhashes older than 256 blocks
+	// in an untrusted BHS, by verifying the block headers back from the closest later stored blockhash. It must
+	// store to the job's BHS.
+	BatchBlockhashStoreAddress *common.Address
+}
+
+// BackfillBlockStatus is the outcome of a backfill for a block.
+type BackfillBlockStatus string
+
+const (
+	BackfillBlockAlreadyStored BackfillBlockStatus = "already_stored"
+	BackfillBlockStored        BackfillBlockStatus = "stored"
+	BackfillBlockWouldStore    BackfillBlockStatus = "would_store"
+	BackfillBlockSkipped       BackfillBlockStatus = "skipped"
+	BackfillBlockFailed        BackfillBlockStatus = "failed"
+)
+
+// BackfillBlock is a block with pending VRF requests found by a backfill.
+type BackfillBlock struct {
+	Block      uint64
+	RequestIDs []string
+	Status     BackfillBlockStatus
+	Reason     string
+}
+
+// BackfillReport reports the outcome of a backfill.
+type BackfillReport struct {
+	FromBlock   uint64
+	ToBlock     uint64
+	LatestBlock uint64
+	DryRun      bool
+	GasBudget   uint64
+	// GasLimit is the total gas limit of the transactions sent, or which would be sent for a dry run.
+	GasLimit uint64
+	// Transactions is the number of transactions sent, or which would be sent for a dry run.
+	Transactions int
+	Blocks       []BackfillBlock
+}
+
+// FeederInspector is implemented by the services of BHS feeder jobs to inspect and backfill their blockhashes.
+type FeederInspector interface {
+	// PendingRequests lists the pending requests of each coordinator in the block range. When toBlock is zero it
+	// defaults to the latest block, or the range defaults to the job's search window if fromBlock is zero too.
+	PendingRequests(ctx context.Context, fromBlock, toBlock uint64) (PendingRequestsReport, error)
+	// Backfill stores the missing blockhashes of the blocks with pending requests in a block range.
+	Backfill(ctx context.Context, opts BackfillOptions) (BackfillReport, error)
+}
+
+// Backfiller inspects and backfills the blockhashes of a BHS feeder job's coordinators.
+type Backfiller struct {
+	lggr                logger.Logger
+	coordinators        []FeederCoordinator
+	bhs                 BHS
+	bhsAddress          common.Address
+	lp                  logpoller.LogPoller
+	newBatchBHS         func(address common.Address) (BatchBHS, error)
+	headers             BlockHeaderProvider
+	gethks              evmkeystore.RoundRobin
+	fromAddresses       []types.EIP55Address
+	gasLimit            uint64
+	trustedBHSBatchSize int32
+	waitBlocks          int
+	lookbackBlocks      int
+	latestBlock         func(ctx context.Context) (uint64, error)
+
+	mu sync.Mutex
+}
+
+// NewBackfiller creates a new Backfiller. bhsAddress is the address of the job's BlockhashStore, which the batch BHS
+// of a backfill must store to. gasLimit is the gas limit of each transaction storing blockhashes.
+func NewBackfiller(
+	lggr logger.Logger,
+	coordinators []FeederCoordinator,
+	bhs BHS,
+	bhsAddress common.Address,
+	lp logpoller.LogPoller,
+	newBatchBHS func(address common.Address) (BatchBHS, error),
+	headers BlockHeaderProvider,
+	gethks evmkeystore.RoundRobin,
+	fromAddresses []types.EIP55Address,
+	gasLimit uint64,
+	trustedBHSBatchSize int32,
+	waitBlocks int,
+	lookbackBlocks int,
+	latestBlock func(ctx context.Context) (uint64, error),
+) *Backfiller {
+	return &Backfiller{
+		lggr:                lggr.Named("Backfiller"),
+		coordinators:        coordinators,
+		bhs:                 bhs,
+		bhsAddress:          bhsAddress,
+		lp:                  lp,
+		newBatchBHS:         newBatchBHS,
+		headers:             headers,
+		gethks:              gethks,
+		fromAddresses:       fromAddresses,
+		gasLimit:            gasLimit,
+		trustedBHSBatchSize: trustedBHSBatchSize,
+		waitBlocks:          waitBlocks,
+		lookbackBlocks:      lookbackBlocks,
+		latestBlock:         latestBlock,
+	}
+}
+
+// PendingRequests satisfies the FeederInspector interface.
+func (b *Backfiller) PendingRequests(ctx context.Context, fromBlock, toBlock uint64) (PendingRequestsReport, error) {
+	latestBlock, err := b.latestBlock(ctx)
+	if err != nil {
+		return PendingRequestsReport{}, errors.Wrap(err, "fetching block number")
+	}
+	switch {
+	case toBlock != 0:
+	case fromBlock != 0:
+		toBlock = latestBlock
+	default:
+		fromBlock, toBlock = GetSearchWindow(int(latestBlock), b.waitBlocks, b.lookbackBlocks)
+	}
+	if fromBlock > toBlock || toBlock > latestBlock {
+		return PendingRequestsReport{}, errors.Wrapf(ErrBackfillInvalidRange, "blocks %d to %d, latest block %d", fromBlock, toBlock, latestBlock)
+	}
+
+	report := PendingRequestsReport{FromBlock: fromBlock, ToBlock: toBlock, LatestBlock: latestBlock}
+	stored := make(map[uint64]PendingRequest)
+	for _, c := range b.coordinators {
+		blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, b.lggr, c, fromBlock, toBlock)
+		if err != nil {
+			return PendingRequestsReport{}, errors.Wrapf(err, "coordinator %s %s", c.Version, c.Address)
+		}
+
+		cr := CoordinatorPendingRequests{Version: c.Version, Address: c.Address}
+		for block, reqs := range blockToRequests {
+			if len(reqs) == 0 {
+				continue
+			}
+			status, ok := stored[block]
+			if !ok {
+				isStored, err := b.bhs.IsStored(ctx, block)
+				status = PendingRequest{Stored: isStored}
+				if err != nil {
+					status.Error = err.Error()
+				}
+				stored[block] = status
+			}
+			for id := range reqs {
+				cr.Requests = append(cr.Requests, PendingRequest{ID: id, Block: block, Stored: status.Stored, Error: status.Error})
+			}
+		}
+		slices.SortFunc(cr.Requests, func(a, b PendingRequest) int {
+			if c := cmp.Compare(a.Block, b.Block); c != 0 {
+				return c
+			}
+			return strings.Compare(a.ID, b.ID)
+		})
+		report.Coordinators = append(report.Coordinators, cr)
+	}
+	return report, nil
+}
+
+// Backfill satisfies the FeederInspector interface. The blockhashes are stored through the trusted BHS in batches if
+// the job has one. Otherwise the blockhashes of the last 256 blocks are stored directly, and the older ones through
+// the batch BHS if configured.
+func (b *Backfiller) Backfill(ctx context.Context, opts BackfillOptions) (BackfillReport, error) {
+	if !b.mu.TryLock() {
+		return BackfillReport{}, ErrBackfillInProgress
+	}
+	defer b.mu.Unlock()
+
+	latestBlock, err := b.latestBlock(ctx)
+	if err != nil {
+		return BackfillReport{}, errors.Wrap(err, "fetching block number")
+	}
+	if opts.FromBlock > opts.ToBlock || opts.ToBlock > latestBlock {
+		return BackfillReport{}, errors.Wrapf(ErrBackfillInvalidRange, "blocks %d to %d, latest block %d", opts.FromBlock, opts.ToBlock, latestBlock)
+	}
+
+	lggr := b.lggr.With("fromBlock", opts.FromBlock, "toBlock", opts.ToBlock, "latestBlock", latestBlock, "dryRun", opts.DryRun)
+	blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, lggr, NewMultiCoordinator(feederCoordinators(b.coordinators)...), opts.FromBlock, opts.ToBlock)
+	if err != nil {
+		return BackfillReport{}, err
+	}
+
+	bf := &backfill{
+		Backfiller: b,
+		opts:       opts,
+		lggr:       lggr,
+		report: BackfillReport{
+			FromBlock:   opts.FromBlock,
+			ToBlock:     opts.ToBlock,
+			LatestBlock: latestBlock,
+			DryRun:      opts.DryRun,
+			GasBudget:   opts.GasBudget,
+		},
+		blocks: make(map[uint64]*BackfillBlock),
+	}
+
+	var missing []uint64
+	sortedBlocks := maps.Keys(blockToRequests)
+	slices.Sort(sortedBlocks)
+	for _, block := range sortedBlocks {
+		reqs := blockToRequests[block]
+		if len(reqs) == 0 {
+			continue
+		}
+		bb := &BackfillBlock{Block: block, RequestIDs: LimitReqIDs(reqs, 50)}
+		slices.Sort(bb.RequestIDs)
+		bf.blocks[block] = bb
+
+		stored, err := b.bhs.IsStored(ctx, block)
+		switch {
+		case err != nil:
+			bb.Status, bb.Reason = BackfillBlockFailed, errors.Wrap(err, "checking if stored").Error()
+		case stored:
+			bb.Status = BackfillBlockAlreadyStored
+		default:
+			missing = append(missing, block)
+		}
+	}
+
+	if b.bhs.IsTrusted() {
+		bf.storeTrusted(ctx, missing)
+	} else {
+		var old []uint64
+		for _, block := range missing {
+			if latestBlock-block < blockhashWindow {
+				bf.store(ctx, block)
+			} else {
+				old = append(old, block)
+			}
+		}
+		bf.storeHeaders(ctx, old)
+	}
+
+	for _, block := range sortedBlocks {
+		if bb, ok := bf.blocks[block]; ok {
+			bf.report.Blocks = append(bf.report.Blocks, *bb)
+		}
+	}
+	lggr.Infow("Backfilled blockhashes", "transactions", bf.report.Transactions, "gasLimit", bf.report.GasLimit)
+	return bf.report, nil
+}
+
+// backfill is the state of a single Backfill run.
+type backfill struct {
+	*Backfiller
+	opts   BackfillOptions
+	lggr   logger.Logger
+	report BackfillReport
+	blocks map[uint64]*BackfillBlock
+}
+
+// spend reserves the gas limit of a transaction, returning false if it exceeds the gas budget.
+func (bf *backfill) spend() bool {
+	if bf.opts.GasBudget > 0 && bf.report.GasLimit+bf.gasLimit > bf.opts.GasBudget {
+		return false
+	}
+	bf.report.GasLimit += bf.gasLimit
+	bf.report.Transactions++
+	return true
+}
+
+// setStatus sets the status of the blocks with pending requests among blocks.
+func (bf *backfill) setStatus(blocks []uint64, status BackfillBlockStatus, reason string) {
+	for _, block := range blocks {
+		if bb, ok := bf.blocks[block]; ok {
+			bb.Status, bb.Reason = status, reason
+		}
+	}
+}
+
+// stored returns the status of a block whose blockhash was sent to be stored, which depends on the dry run.
+func (bf *backfill) stored() BackfillBlockStatus {
+	if bf.opts.DryRun {
+		return BackfillBlockWouldStore
+	}
+	return BackfillBlockStored
+}
+
+func (bf *backfill) store(ctx context.Context, block uint64) {
+	if !bf.spend() {
+		bf.setStatus([]uint64{block}, BackfillBlockSkipped, errGasBudgetExceeded.Error())
+		return
+	}
+	if !bf.opts.DryRun {
+		if err := bf.bhs.Store(ctx, block); err != nil {
+			bf.lggr.Errorw("Failed to store block", "err", err, "block", block)
+			bf.setStatus([]uint64{block}, BackfillBlockFailed, errors.Wrap(err, "storing block").Error())
+			return
+		}
+	}
+	bf.setStatus([]uint64{block}, bf.stored(), "")
+}
+
+func (bf *backfill) storeTrusted(ctx context.Context, blocks []uint64) {
+	batchSize := int(bf.trustedBHSBatchSize)
+	if batchSize <= 0 {
+		batchSize = len(blocks)
+	}
+	for batch := range slices.Chunk(blocks, max(batchSize, 1)) {
+		if !bf.spend() {
+			bf.setStatus(batch, BackfillBlockSkipped, errGasBudgetExceeded.Error())
+			continue
+		}
+		if bf.opts.DryRun {
+			bf.setStatus(batch, BackfillBlockWouldStore, "")
+			continue
+		}
+
+		lpBlocks, err := bf.lp.GetBlocksRange(ctx, append(slices.Clone(batch), bf.report.LatestBlock))
+		if err != nil {
+			bf.setStatus(batch, BackfillBlockFailed, errors.Wrap(err, "log poller get blocks range").Error())
+			continue
+		}
+		// GetBlocksRange returns the blocks in the requested order, with the latest block last.
+		blockhashes := make([]common.Hash, len(batch))
+		for i := range batch {
+			blockhashes[i] = lpBlocks[i].BlockHash
+		}
+		latestBlockhash := lpBlocks[len(batch)].BlockHash
+
+		if err := bf.bhs.StoreTrusted(ctx, batch, blockhashes, bf.report.LatestBlock, latestBlockhash); err != nil {
+			bf.lggr.Errorw("Failed to store trusted", "err", err, "blocks", batch)
+			bf.setStatus(batch, BackfillBlockFailed, errors.Wrap(err, "storing trusted").Error())
+			continue
+		}
+		bf.setStatus(batch, BackfillBlockStored, "")
+	}
+}
+
+// storeHeaders stores the blockhashes older than 256 blocks through the batch BHS, verifying the block headers down
+// from the closest later block whose blockhash is stored.
+func (bf *backfill) storeHeaders(ctx context.Context, blocks []uint64) {
+	if len(blocks) == 0 {
+		return
+	}
+	if bf.opts.BatchBlockhashStoreAddress == nil {
+		bf.setStatus(blocks, BackfillBlockSkipped, "block is older than 256 blocks, a batch blockhash store is required to store it")
+		return
+	}
+	batchBHS, err := bf.newBatchBHS(*bf.opts.BatchBlockhashStoreAddress)
+	if err != nil {
+		bf.setStatus(blocks, BackfillBlockFailed, errors.Wrap(err, "building batch BHS").Error())
+		return
+	}
+	bhsAddress, err := batchBHS.BHS(ctx)
+	if err != nil {
+		bf.setStatus(blocks, BackfillBlockFailed, errors.Wrap(err, "getting BHS of batch BHS").Error())
+		return
+	}
+	if bhsAddress != bf.bhsAddress {
+		bf.setStatus(blocks, BackfillBlockFailed, fmt.Sprintf("batch BHS %s stores to BHS %s, not the job's BHS %s", *bf.opts.BatchBlockhashStoreAddress, bhsAddress, bf.bhsAddress))
+		return
+	}
+	// use 1 sending key for all batches because ordering matters for StoreVerifyHeader
+	fromAddress, err := bf.gethks.GetNextAddress(ctx, SendingKeys(bf.fromAddresses)...)
+	if err != nil {
+		bf.setStatus(blocks, BackfillBlockFailed, errors.Wrap(err, "getting next from address").Error())
+		return
+	}
+
+	for len(blocks) > 0 {
+		lowest := blocks[0]
+		earliestStored, err := findEarliestStored(ctx, batchBHS, lowest+1, bf.report.LatestBlock)
+		if err != nil {
+			bf.setStatus(blocks, BackfillBlockFailed, errors.Wrap(err, "finding earliest stored blockhash").Error())
+			return
+		}
+		if earliestStored == 0 {
+			bf.setStatus(blocks, BackfillBlockSkipped, "no later blockhash is stored to verify the block headers from")
+			return
+		}
+
+		// Blocks from earliestStored on can only be stored from a later stored blockhash.
+		i, _ := slices.BinarySearch(blocks, earliestStored)
+		chain, rest := blocks[:i], blocks[i:]
+		if err := bf.storeHeaderRange(ctx, batchBHS, fromAddress, earliestStored-1, lowest); err != nil {
+			bf.lggr.Errorw("Failed to store block headers", "err", err, "fromBlock", lowest, "toBlock", earliestStored-1)
+			if errors.Is(err, errGasBudgetExceeded) {
+				bf.setStatus(blocks, BackfillBlockSkipped, err.Error())
+			} else {
+				bf.setStatus(blocks, BackfillBlockFailed, err.Error())
+			}
+			return
+		}
+		bf.setStatus(chain, bf.stored(), "")
+		blocks = rest
+	}
+}
+
+// storeHeaderRange stores the blockhashes from start down to end. The blockhash of start+1 must be stored.
+func (bf *backfill) storeHeaderRange(ctx context.Context, batchBHS BatchBHS, fromAddress common.Address, start, end uint64) error {
+	blockRange, err := DecreasingBlockRange(new(big.Int).SetUint64(start), new(big.Int).SetUint64(end))
+	if err != nil {
+		return err
+	}
+	for batch := range slices.Chunk(blockRange, backfillStoreHeadersBatchSize) {
+		if !bf.spend() {
+			return errGasBudgetExceeded
+		}
+		if bf.opts.DryRun {
+			continue
+		}
+		// the remaining headers can't be verified without the blockhashes of a failed batch
+		headers, err := bf.headers.RlpHeadersBatch(ctx, batch)
+		if err != nil {
+			return errors.Wrap(err, "fetching block headers")
+		}
+		if err := batchBHS.StoreVerifyHeader(ctx, batch, headers, fromAddress); err != nil {
+			return errors.Wrap(err, "storing block headers")
+		}
+	}
+	return nil
+}
+
+// findEarliestStored returns the earliest block in [fromBlock, toBlock] whose blockhash is stored in the batch BHS, or
+// zero if there is none.
+func findEarliestStored(ctx context.Context, batchBHS BatchBHS, fromBlock, toBlock uint64) (uint64, error) {
+	for i := fromBlock; i <= toBlock; i += backfillGetBlockhashesBatchSize {
+		var blocks []*big.Int
+		for j := i; j <= toBlock && j < i+backfillGetBlockhashesBatchSize; j++ {
+			blocks = append(blocks, new(big.Int).SetUint64(j))
+		}
+		blockhashes, err := batchBHS.GetBlockhashes(ctx, blocks)
+		if err != nil {
+			return 0, errors.Wrap(err, "fetching blockhashes")
+		}
+		for idx, bh := range blockhashes {
+			if bh != ([32]byte{}) {
+				return i + uint64(idx), nil
+			}
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,246 @@
+package blockhashstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	mocklp "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	bhsmocks "github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore/mocks"
+)
+
+const backfillGasLimit = 500_000
+
+func newTestBackfiller(t *testing.T, coordinators []FeederCoordinator, bhs BHS, lp logpoller.LogPoller, batchBHS BatchBHS, latest uint64) *Backfiller {
+	fromAddress := "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+	return NewBackfiller(
+		logger.TestLogger(t),
+		coordinators,
+		bhs,
+		common.Address{},
+		lp,
+		func(common.Address) (BatchBHS, error) { return batchBHS, nil },
+		&TestBlockHeaderProvider{},
+		keystest.Addresses{common.HexToAddress(fromAddress)},
+		[]types.EIP55Address{types.EIP55Address(fromAddress)},
+		backfillGasLimit,
+		10,
+		25,
+		100,
+		func(ctx context.Context) (uint64, error) { return latest, nil },
+	)
+}
+
+func TestBackfiller_PendingRequests(t *testing.T) {
+	v2 := FeederCoordinator{
+		Coordinator: &TestCoordinator{
+			RequestEvents:     []Event{{Block: 950, ID: "3"}, {Block: 940, ID: "2"}, {Block: 940, ID: "1"}, {Block: 945, ID: "4"}},
+			FulfillmentEvents: []Event{{Block: 960, ID: "4"}},
+		},
+		Version: "v2",
+		Address: common.HexToAddress("0x1"),
+	}
+	v2plus := FeederCoordinator{
+		Coordinator: &TestCoordinator{RequestEvents: []Event{{Block: 800, ID: "5"}, {Block: 950, ID: "6"}}},
+		Version:     "v2plus",
+		Address:     common.HexToAddress("0x2"),
+	}
+	bhs := &TestBHS{Stored: []uint64{950}}
+	b := newTestBackfiller(t, []FeederCoordinator{v2, v2plus}, bhs, &mocklp.LogPoller{}, &TestBatchBHS{}, 1000)
+
+	report, err := b.PendingRequests(testutils.Context(t), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, PendingRequestsReport{
+		FromBlock:   900,
+		ToBlock:     975,
+		LatestBlock: 1000,
+		Coordinators: []CoordinatorPendingRequests{
+			{Version: "v2", Address: v2.Address, Requests: []PendingRequest{
+				{ID: "1", Block: 940},
+				{ID: "2", Block: 940},
+				{ID: "3", Block: 950, Stored: true},
+			}},
+			{Version: "v2plus", Address: v2plus.Address, Requests: []PendingRequest{
+				{ID: "6", Block: 950, Stored: true},
+			}},
+		},
+	}, report)
+
+	report, err = b.PendingRequests(testutils.Context(t), 700, 850)
+	require.NoError(t, err)
+	require.Empty(t, report.Coordinators[0].Requests)
+	require.Equal(t, []PendingRequest{{ID: "5", Block: 800}}, report.Coordinators[1].Requests)
+
+	report, err = b.PendingRequests(testutils.Context(t), 800, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(800), report.FromBlock)
+	require.Equal(t, uint64(1000), report.ToBlock)
+	require.Equal(t, []PendingRequest{{ID: "5", Block: 800}, {ID: "6", Block: 950, Stored: true}}, report.Coordinators[1].Requests)
+
+	_, err = b.PendingRequests(testutils.Context(t), 900, 1001)
+	require.ErrorIs(t, err, ErrBackfillInvalidRange)
+}
+
+func TestBackfiller_Backfill(t *testing.T) {
+	coordinator := FeederCoordinator{
+		Coordinator: &TestCoordinator{
+			RequestEvents:     []Event{{Block: 100, ID: "1"}, {Block: 900, ID: "2"}, {Block: 950, ID: "3"}, {Block: 960, ID: "4"}},
+			FulfillmentEvents: []Event{{Block: 970, ID: "4"}},
+		},
+		Version: "v2",
+	}
+	batchBHSAddress := common.HexToAddress("0x3")
+
+	tests := []struct {
+		name             string
+		opts             BackfillOptions
+		expectedStatuses map[uint64]BackfillBlockStatus
+		expectedTxs      int
+		expectedStored   []uint64
+		expectedHeaders  []uint64
+	}{
+		{
+			name: "stores recent and old blockhashes",
+			opts: BackfillOptions{FromBlock: 0, ToBlock: 1000, BatchBlockhashStoreAddress: &batchBHSAddress},
+			expectedStatuses: map[uint64]BackfillBlockStatus{
+				100: BackfillBlockStored,
+				900: BackfillBlockStored,
+				950: BackfillBlockAlreadyStored,
+			},
+			expectedTxs:     2,
+			expectedStored:  []uint64{950, 900},
+			expectedHeaders: []uint64{105, 104, 103, 102, 101, 100},
+		},
+		{
+			name: "dry run",
+			opts: BackfillOptions{FromBlock: 0, ToBlock: 1000, DryRun: true, BatchBlockhashStoreAddress: &batchBHSAddress},
+			expectedStatuses: map[uint64]BackfillBlockStatus{
+				100: BackfillBlockWouldStore,
+				900: BackfillBlockWouldStore,
+				950: BackfillBlockAlreadyStored,
+			},
+			expectedTxs:     2,
+			expectedStored:  []uint64{950},
+			expectedHeaders: []uint64{105},
+		},
+		{
+			name: "gas budget",
+			opts: BackfillOptions{FromBlock: 0, ToBlock: 1000, GasBudget: backfillGasLimit, BatchBlockhashStoreAddress: &batchBHSAddress},
+			expectedStatuses: map[uint64]BackfillBlockStatus{
+				100: BackfillBlockSkipped,
+				900: BackfillBlockStored,
+				950: BackfillBlockAlreadyStored,
+			},
+			expectedTxs:     1,
+			expectedStored:  []uint64{950, 900},
+			expectedHeaders: []uint64{105},
+		},
+		{
+			name: "no batch BHS for old blockhashes",
+			opts: BackfillOptions{FromBlock: 50, ToBlock: 920},
+			expectedStatuses: map[uint64]BackfillBlockStatus{
+				100: BackfillBlockSkipped,
+				900: BackfillBlockStored,
+			},
+			expectedTxs:     1,
+			expectedStored:  []uint64{950, 900},
+			expectedHeaders: []uint64{105},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bhs := &TestBHS{Stored: []uint64{950}}
+			batchBHS := &TestBatchBHS{Stored: []uint64{105}}
+			b := newTestBackfiller(t, []FeederCoordinator{coordinator}, bhs, &mocklp.LogPoller{}, batchBHS, 1000)
+
+			report, err := b.Backfill(testutils.Context(t), test.opts)
+			require.NoError(t, err)
+
+			statuses := make(map[uint64]BackfillBlockStatus)
+			for _, bb := range report.Blocks {
+				statuses[bb.Block] = bb.Status
+			}
+			require.Equal(t, test.expectedStatuses, statuses)
+			require.Equal(t, test.expectedTxs, report.Transactions)
+			require.Equal(t, uint64(test.expectedTxs*backfillGasLimit), report.GasLimit)
+			require.Equal(t, test.expectedStored, bhs.Stored)
+			require.Equal(t, test.expectedHeaders, batchBHS.Stored)
+		})
+	}
+
+	t.Run("invalid range", func(t *testing.T) {
+		b := newTestBackfiller(t, []FeederCoordinator{coordinator}, &TestBHS{}, &mocklp.LogPoller{}, &TestBatchBHS{}, 1000)
+
+		_, err := b.Backfill(testutils.Context(t), BackfillOptions{FromBlock: 200, ToBlock: 100})
+		require.ErrorIs(t, err, ErrBackfillInvalidRange)
+		_, err = b.Backfill(testutils.Context(t), BackfillOptions{FromBlock: 200, ToBlock: 1001})
+		require.ErrorIs(t, err, ErrBackfillInvalidRange)
+	})
+
+	t.Run("batch BHS of another BHS", func(t *testing.T) {
+		bhs := &TestBHS{Stored: []uint64{950}}
+		batchBHS := &TestBatchBHS{BHSAddress: common.HexToAddress("0x4"), Stored: []uint64{105}}
+		b := newTestBackfiller(t, []FeederCoordinator{coordinator}, bhs, &mocklp.LogPoller{}, batchBHS, 1000)
+
+		report, err := b.Backfill(testutils.Context(t), BackfillOptions{FromBlock: 0, ToBlock: 1000, BatchBlockhashStoreAddress: &batchBHSAddress})
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), report.Blocks[0].Block)
+		require.Equal(t, BackfillBlockFailed, report.Blocks[0].Status)
+		require.Contains(t, report.Blocks[0].Reason, "not the job's BHS")
+		require.Equal(t, []uint64{105}, batchBHS.Stored)
+	})
+
+	t.Run("trusted BHS", func(t *testing.T) {
+		bhs := bhsmocks.NewBHS(t)
+		bhs.On("IsTrusted").Return(true)
+		bhs.On("IsStored", mock.Anything, uint64(100)).Return(false, nil)
+		bhs.On("IsStored", mock.Anything, uint64(900)).Return(false, nil)
+		bhs.On("IsStored", mock.Anything, uint64(950)).Return(true, nil)
+		lp := mocklp.NewLogPoller(t)
+		lp.On("GetBlocksRange", mock.Anything, []uint64{100, 900, 1000}).Return([]logpoller.Block{
+			{BlockNumber: 100, BlockHash: common.HexToHash("0x100")},
+			{BlockNumber: 900, BlockHash: common.HexToHash("0x900")},
+			{BlockNumber: 1000, BlockHash: common.HexToHash("0x1000")},
+		}, nil)
+		bhs.On("StoreTrusted", mock.Anything,
+			[]uint64{100, 900},
+			[]common.Hash{common.HexToHash("0x100"), common.HexToHash("0x900")},
+			uint64(1000),
+			common.HexToHash("0x1000"),
+		).Return(nil).Once()
+		b := newTestBackfiller(t, []FeederCoordinator{coordinator}, bhs, lp, &TestBatchBHS{}, 1000)
+
+		report, err := b.Backfill(testutils.Context(t), BackfillOptions{FromBlock: 0, ToBlock: 1000})
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Transactions)
+		require.Equal(t, []BackfillBlock{
+			{Block: 100, RequestIDs: []string{"1"}, Status: BackfillBlockStored},
+			{Block: 900, RequestIDs: []string{"2"}, Status: BackfillBlockStored},
+			{Block: 950, RequestIDs: []string{"3"}, Status: BackfillBlockAlreadyStored},
+		}, report.Blocks)
+	})
+}
+
+func Test_findEarliestStored(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	earliest, err := findEarliestStored(ctx, &TestBatchBHS{Stored: []uint64{250}}, 101, 300)
+	require.NoError(t, err)
+	require.Equal(t, uint64(250), earliest)
+
+	earliest, err = findEarliestStored(ctx, &TestBatchBHS{Stored: []uint64{250}}, 101, 200)
+	require.NoError(t, err)
+	require.Zero(t, earliest)
+
+	_, err = findEarliestStored(ctx, &TestBatchBHS{GetBlockhashesError: errors.New("rpc error")}, 101, 200)
+	require.Error(t, err)
+}
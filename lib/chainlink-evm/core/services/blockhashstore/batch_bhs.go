@@ -50,6 +50,14 @@ func (b *BatchBlockhashStore) GetBlockhashes(ctx context.Context, blockNumbers [
 	return blockhashes, nil
 }
 
+func (b *BatchBlockhashStore) BHS(ctx context.Context) (common.Address, error) {
+	bhs, err := b.batchbhs.BHS(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "getting BHS address")
+	}
+	return bhs, nil
+}
+
 func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) error {
 	payload, err := b.abi.Pack("storeVerifyHeader", blockNumbers, blockHeaders)
 	if err != nil {
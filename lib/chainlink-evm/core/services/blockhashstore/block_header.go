@@ -1,4 +1,4 @@
-package blockheaderfeeder
+package blockhashstore
 
 import (
 	"bytes"
@@ -7,9 +7,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/batch_blockhash_store"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/blockhash_store"
 	v1 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/trusted_blockhash_store"
@@ -24,7 +26,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 )
 
-var _ job.ServiceCtx = &service{}
+var (
+	_ job.ServiceCtx  = &service{}
+	_ FeederInspector = &service{}
+)
 
 type Config interface {
 	Feature() config.Feature
@@ -114,7 +119,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	}
 
 	lp := chain.LogPoller()
-	var coordinators []Coordinator
+	var coordinators []FeederCoordinator
 	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
 		var c *v1.VRFCoordinator
 		if c, err = v1.NewVRFCoordinator(
@@ -127,7 +132,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		if err != nil {
 			return nil, errors.Wrap(err, "building V1 coordinator")
 		}
-		coordinators = append(coordinators, coord)
+		coordinators = append(coordinators, FeederCoordinator{
+			Coordinator: coord,
+			Version:     "v1",
+			Address:     jb.BlockhashStoreSpec.CoordinatorV1Address.Address(),
+		})
 	}
 	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
 		var c *v2.VRFCoordinatorV2
@@ -141,7 +150,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		if err != nil {
 			return nil, errors.Wrap(err, "building V2 coordinator")
 		}
-		coordinators = append(coordinators, coord)
+		coordinators = append(coordinators, FeederCoordinator{
+			Coordinator: coord,
+			Version:     "v2",
+			Address:     jb.BlockhashStoreSpec.CoordinatorV2Address.Address(),
+		})
 	}
 	if jb.BlockhashStoreSpec.CoordinatorV2PlusAddress != nil {
 		var c v2plus.IVRFCoordinatorV2PlusInternalInterface
@@ -155,7 +168,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		if err != nil {
 			return nil, errors.Wrap(err, "building V2Plus coordinator")
 		}
-		coordinators = append(coordinators, coord)
+		coordinators = append(coordinators, FeederCoordinator{
+			Coordinator: coord,
+			Version:     "v2plus",
+			Address:     jb.BlockhashStoreSpec.CoordinatorV2PlusAddress.Address(),
+		})
 	}
 
 	bpBHS, err := NewBulletproofBHS(
@@ -171,26 +188,51 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		return nil, errors.Wrap(err, "building bulletproof bhs")
 	}
 
+	latestBlock := func(ctx context.Context) (uint64, error) {
+		head, err := lp.LatestBlock(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "getting chain head")
+		}
+		return uint64(head.BlockNumber), nil
+	}
+
 	log := d.logger.Named("BHSFeeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
 	feeder := NewFeeder(
 		log,
-		NewMultiCoordinator(coordinators...),
+		NewMultiCoordinator(feederCoordinators(coordinators)...),
 		bpBHS,
 		lp,
 		jb.BlockhashStoreSpec.TrustedBlockhashStoreBatchSize,
 		int(jb.BlockhashStoreSpec.WaitBlocks),
 		int(jb.BlockhashStoreSpec.LookbackBlocks),
 		jb.BlockhashStoreSpec.HeartbeatPeriod,
-		func(ctx context.Context) (uint64, error) {
-			head, err := lp.LatestBlock(ctx)
+		latestBlock)
+
+	backfiller := NewBackfiller(
+		log,
+		coordinators,
+		bpBHS,
+		jb.BlockhashStoreSpec.BlockhashStoreAddress.Address(),
+		lp,
+		func(address common.Address) (BatchBHS, error) {
+			batchBHS, err := batch_blockhash_store.NewBatchBlockhashStore(address, chain.Client())
 			if err != nil {
-				return 0, errors.Wrap(err, "getting chain head")
+				return nil, errors.Wrap(err, "building batch BHS wrapper")
 			}
-			return uint64(head.BlockNumber), nil
-		})
+			return NewBatchBHS(chain.Config().EVM().GasEstimator(), chain.TxManager(), batchBHS)
+		},
+		NewGethBlockHeaderProvider(chain.Client()),
+		ks,
+		fromAddresses,
+		chain.Config().EVM().GasEstimator().LimitDefault(),
+		jb.BlockhashStoreSpec.TrustedBlockhashStoreBatchSize,
+		int(jb.BlockhashStoreSpec.WaitBlocks),
+		int(jb.BlockhashStoreSpec.LookbackBlocks),
+		latestBlock)
 
 	return []job.ServiceCtx{&service{
 		feeder:     feeder,
+		backfiller: backfiller,
 		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
 		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
 		logger:     log,
@@ -209,10 +251,19 @@ func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 // OnDeleteJob satisfies the job.Delegate interface.
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
+func feederCoordinators(coordinators []FeederCoordinator) []Coordinator {
+	cs := make([]Coordinator, len(coordinators))
+	for i, c := range coordinators {
+		cs[i] = c.Coordinator
+	}
+	return cs
+}
+
 // service is a job.Service that runs the BHS feeder every pollPeriod.
 type service struct {
 	services.StateMachine
 	feeder     *Feeder
+	backfiller *Backfiller
 	wg         sync.WaitGroup
 	pollPeriod time.Duration
 	runTimeout time.Duration
@@ -273,3 +324,13 @@ func (s *service) runFeeder(ctx context.Context) {
 			"err", err)
 	}
 }
+
+// PendingRequests satisfies the FeederInspector interface.
+func (s *service) PendingRequests(ctx context.Context, fromBlock, toBlock uint64) (PendingRequestsReport, error) {
+	return s.backfiller.PendingRequests(ctx, fromBlock, toBlock)
+}
+
+// Backfill satisfies the FeederInspector interface.
+func (s *service) Backfill(ctx context.Context, opts BackfillOptions) (BackfillReport, error) {
+	return s.backfiller.Backfill(ctx, opts)
+}
@@ -92,6 +92,7 @@ func (t *TestBHS) StoreEarliest(ctx context.Context) error {
 }
 
 type TestBatchBHS struct {
+	BHSAddress                   common.Address
 	Stored                       []uint64
 	GetBlockhashesCallCounter    uint16
 	StoreVerifyHeaderCallCounter uint16
@@ -120,6 +121,10 @@ func (t *TestBatchBHS) GetBlockhashes(_ context.Context, blockNumbers []*big.Int
 	return blockhashes, nil
 }
 
+func (t *TestBatchBHS) BHS(_ context.Context) (common.Address, error) {
+	return t.BHSAddress, nil
+}
+
 func (t *TestBatchBHS) StoreVerifyHeader(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) error {
 	t.StoreVerifyHeaderCallCounter++
 	if t.StoreVerifyHeadersError != nil {
@@ -183,7 +183,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		"batchBHSAddress", batchBlockhashStore.Address(),
 	)
 
-	blockHeaderProvider := NewGethBlockHeaderProvider(chain.Client())
+	blockHeaderProvider := blockhashstore.NewGethBlockHeaderProvider(chain.Client())
 
 	feeder := NewBlockHeaderFeeder(
 		log,
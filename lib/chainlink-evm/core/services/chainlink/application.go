@@ -687,6 +687,10 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	var feedsService feeds.Service
 	if cfg.Feature().FeedsManager() {
+		approvalPolicies, err := feeds.NewApprovalPolicies(cfg.JobDistributor().AutoApprovalPolicies())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create job distributor approval policies: %w", err)
+		}
 		feedsORM := feeds.NewORM(opts.DS)
 		feedsService = feeds.NewService(
 			feedsORM,
@@ -704,6 +708,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 			globalLogger,
 			opts.Version,
 			loopRegistrarConfig,
+			feeds.WithApprovalPolicies(approvalPolicies...),
 		)
 	} else {
 		feedsService = &feeds.NullService{}
@@ -1009,10 +1014,11 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 		attribute.String("commit", static.Sha),
 	))
 	defer span.End()
+	lggr := logger.WithSpanContext(ctx, app.logger)
 
 	if app.FeedsService != nil {
 		if err := app.FeedsService.Start(ctx); err != nil {
-			app.logger.Errorf("[Feeds Service] Failed to start %v", err)
+			lggr.Errorf("[Feeds Service] Failed to start %v", err)
 			app.FeedsService = &feeds.NullService{} // so we don't try to Close() later
 		}
 	}
@@ -1024,7 +1030,7 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 			return multierr.Combine(err, ms.Close())
 		}
 
-		app.logger.Infow("Starting service...", "name", service.Name())
+		lggr.Infow("Starting service...", "name", service.Name())
 
 		if err := ms.Start(ctx, service); err != nil {
 			return err
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -44,6 +45,9 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
+	namedLogLevelsOnce sync.Once
+	namedLogLevels     *logger.NamedLevels
+
 	passwordMu sync.RWMutex // passwords are set after initialization
 }
 
@@ -287,6 +291,10 @@ func (g *generalConfig) FeatureFeedsManager() bool {
 	return *g.c.Feature.FeedsManager
 }
 
+func (g *generalConfig) JobDistributor() coreconfig.JobDistributor {
+	return &jobDistributorConfig{c: g.c.JobDistributor}
+}
+
 func (g *generalConfig) OCR() config.OCR {
 	return &ocrConfig{c: g.c.OCR}
 }
@@ -495,7 +503,8 @@ func (g *generalConfig) RootDir() string {
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir,
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -45,6 +46,18 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logMu.Unlock()
 }
 
+func (g *generalConfig) NamedLogLevels() *logger.NamedLevels {
+	g.namedLogLevelsOnce.Do(func() {
+		levels, err := logger.NewNamedLevels(g.Log().Levels())
+		if err != nil {
+			// unreachable after Validate, which rejects invalid logger names
+			levels, _ = logger.NewNamedLevels(nil)
+		}
+		g.namedLogLevels = levels
+	})
+	return g.namedLogLevels
+}
+
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
 	g.passwordMu.Lock()
 	defer g.passwordMu.Unlock()
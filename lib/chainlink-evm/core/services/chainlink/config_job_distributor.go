@@ -0,0 +1,42 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.JobDistributor = (*jobDistributorConfig)(nil)
+
+type jobDistributorConfig struct {
+	c toml.JobDistributor
+}
+
+type jobDistributorApprovalPolicyConfig struct {
+	c toml.JobDistributorApprovalPolicy
+}
+
+func (j *jobDistributorConfig) AutoApprovalPolicies() []config.JobDistributorApprovalPolicy {
+	var policies []config.JobDistributorApprovalPolicy
+	for _, p := range j.c.AutoApprovalPolicies {
+		policies = append(policies, &jobDistributorApprovalPolicyConfig{
+			c: p,
+		})
+	}
+	return policies
+}
+
+func (p *jobDistributorApprovalPolicyConfig) Name() string {
+	return *p.c.Name
+}
+
+func (p *jobDistributorApprovalPolicyConfig) JobTypes() []string {
+	return p.c.JobTypes
+}
+
+func (p *jobDistributorApprovalPolicyConfig) FeedsManagers() []string {
+	return p.c.FeedsManagers
+}
+
+func (p *jobDistributorApprovalPolicyConfig) ChangedFields() []string {
+	return p.c.ChangedFields
+}
@@ -0,0 +1,25 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDistributorConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	policies := cfg.JobDistributor().AutoApprovalPolicies()
+	require.Len(t, policies, 2)
+	assert.Equal(t, "ccip-trusted", policies[0].Name())
+	assert.Equal(t, []string{"ccip"}, policies[0].JobTypes())
+	assert.Equal(t, []string{"3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808"}, policies[0].FeedsManagers())
+	assert.Empty(t, policies[0].ChangedFields())
+	assert.Equal(t, "ccip-prices", policies[1].Name())
+	assert.Equal(t, []string{"pluginConfig.tokenPricesUSDPipeline", "pluginConfig.priceGetterConfig"}, policies[1].ChangedFields())
+}
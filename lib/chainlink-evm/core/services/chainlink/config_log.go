@@ -1,6 +1,8 @@
 package chainlink
 
 import (
+	"time"
+
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -42,10 +44,79 @@ func (f *fileConfig) MaxBackups() int64 {
 	return *f.c.MaxBackups
 }
 
+type otlpConfig struct {
+	c toml.LogOTLP
+}
+
+func (o *otlpConfig) Enabled() bool {
+	return o.c.Enabled != nil && *o.c.Enabled
+}
+
+func (o *otlpConfig) Endpoint() string {
+	if o.c.Endpoint == nil {
+		return ""
+	}
+	return *o.c.Endpoint
+}
+
+func (o *otlpConfig) InsecureConnection() bool {
+	return o.c.InsecureConnection != nil && *o.c.InsecureConnection
+}
+
+func (o *otlpConfig) CACertFile() string {
+	if o.c.CACertFile == nil {
+		return ""
+	}
+	return *o.c.CACertFile
+}
+
+func (o *otlpConfig) Level() zapcore.Level {
+	if o.c.Level == nil {
+		return zapcore.InfoLevel
+	}
+	return zapcore.Level(*o.c.Level)
+}
+
+// QueueSize returns the configured queue size, or zero for the logger default.
+func (o *otlpConfig) QueueSize() uint32 {
+	if o.c.QueueSize == nil {
+		return 0
+	}
+	return *o.c.QueueSize
+}
+
+// BatchSize returns the configured batch size, or zero for the logger default.
+func (o *otlpConfig) BatchSize() uint32 {
+	if o.c.BatchSize == nil {
+		return 0
+	}
+	return *o.c.BatchSize
+}
+
+// ExportInterval returns the configured export interval, or zero for the logger default.
+func (o *otlpConfig) ExportInterval() time.Duration {
+	if o.c.ExportInterval == nil {
+		return 0
+	}
+	return o.c.ExportInterval.Duration()
+}
+
+// ExportTimeout returns the configured export timeout, or zero for the logger default.
+func (o *otlpConfig) ExportTimeout() time.Duration {
+	if o.c.ExportTimeout == nil {
+		return 0
+	}
+	return o.c.ExportTimeout.Duration()
+}
+
 func (l *logConfig) File() config.File {
 	return &fileConfig{c: l.c.File, rootDir: l.rootDir}
 }
 
+func (l *logConfig) OTLP() config.OTLP {
+	return &otlpConfig{c: l.c.OTLP}
+}
+
 func (l *logConfig) UnixTimestamps() bool {
 	return *l.c.UnixTS
 }
@@ -61,3 +132,11 @@ func (l *logConfig) DefaultLevel() zapcore.Level {
 func (l *logConfig) Level() zapcore.Level {
 	return l.level()
 }
+
+func (l *logConfig) Levels() map[string]zapcore.Level {
+	levels := make(map[string]zapcore.Level, len(l.c.Levels))
+	for name, lvl := range l.c.Levels {
+		levels[name] = zapcore.Level(lvl)
+	}
+	return levels
+}
@@ -2,6 +2,7 @@ package chainlink
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,4 +29,16 @@ func TestLogConfig(t *testing.T) {
 	assert.True(t, log.JSONConsole())
 	assert.Equal(t, zapcore.Level(3), log.DefaultLevel())
 	assert.Equal(t, zapcore.Level(3), log.Level())
+	assert.Equal(t, map[string]zapcore.Level{"CCIPExec": zapcore.DebugLevel}, log.Levels())
+
+	otlp := log.OTLP()
+	assert.True(t, otlp.Enabled())
+	assert.Equal(t, "otel.test:4317", otlp.Endpoint())
+	assert.False(t, otlp.InsecureConnection())
+	assert.Equal(t, "otel/ca.pem", otlp.CACertFile())
+	assert.Equal(t, zapcore.WarnLevel, otlp.Level())
+	assert.Equal(t, uint32(4096), otlp.QueueSize())
+	assert.Equal(t, uint32(256), otlp.BatchSize())
+	assert.Equal(t, time.Second, otlp.ExportInterval())
+	assert.Equal(t, time.Minute, otlp.ExportTimeout())
 }
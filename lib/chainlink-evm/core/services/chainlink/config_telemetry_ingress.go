@@ -2,16 +2,19 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir func() string
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -46,6 +49,10 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+func (t *telemetryIngressConfig) FileSink() config.TelemetryIngressFileSink {
+	return &telemetryIngressFileSinkConfig{c: t.c.FileSink, rootDir: t.rootDir}
+}
+
 func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	var endpoints []config.TelemetryIngressEndpoint
 	for _, e := range t.c.Endpoints {
@@ -74,3 +81,55 @@ func (t *telemetryIngressEndpointConfig) URL() *url.URL {
 func (t *telemetryIngressEndpointConfig) ServerPubKey() string {
 	return *t.c.ServerPubKey
 }
+
+type telemetryIngressFileSinkConfig struct {
+	c       toml.TelemetryIngressFileSink
+	rootDir func() string
+}
+
+func (f *telemetryIngressFileSinkConfig) Enabled() bool {
+	return f.c.Enabled != nil && *f.c.Enabled
+}
+
+// Dir returns the configured directory, or the telemetry directory of the RootDir by default.
+func (f *telemetryIngressFileSinkConfig) Dir() string {
+	if f.c.Dir == nil || *f.c.Dir == "" {
+		return filepath.Join(f.rootDir(), "telemetry")
+	}
+	return *f.c.Dir
+}
+
+func (f *telemetryIngressFileSinkConfig) Format() string {
+	if f.c.Format == nil {
+		return "ndjson"
+	}
+	return *f.c.Format
+}
+
+func (f *telemetryIngressFileSinkConfig) MaxSize() utils.FileSize {
+	if f.c.MaxSize == nil {
+		return 100 * utils.MB
+	}
+	return *f.c.MaxSize
+}
+
+func (f *telemetryIngressFileSinkConfig) MaxBackups() int64 {
+	if f.c.MaxBackups == nil {
+		return 5
+	}
+	return *f.c.MaxBackups
+}
+
+func (f *telemetryIngressFileSinkConfig) Types() map[string]string {
+	if len(f.c.Types) == 0 {
+		return nil
+	}
+	types := make(map[string]string, len(f.c.Types))
+	for name, t := range f.c.Types {
+		types[name] = f.Format()
+		if t.Format != nil {
+			types[name] = *t.Format
+		}
+	}
+	return types
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestTelemetryIngressConfig(t *testing.T) {
@@ -24,6 +26,14 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, 5*time.Second, ticfg.SendTimeout())
 	assert.True(t, ticfg.UseBatchSend())
 
+	fs := ticfg.FileSink()
+	assert.True(t, fs.Enabled())
+	assert.Equal(t, "telemetry/file/dir", fs.Dir())
+	assert.Equal(t, "ndjson", fs.Format())
+	assert.Equal(t, utils.FileSize(10*utils.MB), fs.MaxSize())
+	assert.Equal(t, int64(3), fs.MaxBackups())
+	assert.Equal(t, map[string]string{"head-report": "ndjson", "ocr": "protobuf"}, fs.Types())
+
 	tec := cfg.TelemetryIngress().Endpoints()
 
 	assert.Len(t, tec, 1)
@@ -32,3 +42,19 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, "prom.test", tec[0].URL().String())
 	assert.Equal(t, "test-pub-key", tec[0].ServerPubKey())
 }
+
+func TestTelemetryIngressFileSinkConfig_defaults(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`RootDir = '/root/dir'`},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	fs := cfg.TelemetryIngress().FileSink()
+	assert.False(t, fs.Enabled())
+	assert.Equal(t, "/root/dir/telemetry", fs.Dir())
+	assert.Equal(t, "ndjson", fs.Format())
+	assert.Equal(t, utils.FileSize(100*utils.MB), fs.MaxSize())
+	assert.Equal(t, int64(5), fs.MaxBackups())
+	assert.Nil(t, fs.Types())
+}
@@ -285,6 +285,20 @@ func TestConfig_Marshal(t *testing.T) {
 		CCIP:               ptr(true),
 		MultiFeedsManagers: ptr(true),
 	}
+	full.JobDistributor = toml.JobDistributor{
+		AutoApprovalPolicies: []toml.JobDistributorApprovalPolicy{
+			{
+				Name:          ptr("ccip-trusted"),
+				JobTypes:      []string{"ccip"},
+				FeedsManagers: []string{"3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808"},
+			},
+			{
+				Name:          ptr("ccip-prices"),
+				JobTypes:      []string{"ccip"},
+				ChangedFields: []string{"pluginConfig.tokenPricesUSDPipeline", "pluginConfig.priceGetterConfig"},
+			},
+		},
+	}
 	full.Database = toml.Database{
 		DefaultIdleInTxSessionTimeout: commoncfg.MustNewDuration(time.Minute),
 		DefaultLockTimeout:            commoncfg.MustNewDuration(time.Hour),
@@ -318,6 +332,17 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		FileSink: toml.TelemetryIngressFileSink{
+			Enabled:    ptr(true),
+			Dir:        ptr("telemetry/file/dir"),
+			Format:     ptr("ndjson"),
+			MaxSize:    ptr[utils.FileSize](10 * utils.MB),
+			MaxBackups: ptr[int64](3),
+			Types: map[string]toml.TelemetryIngressFileSinkType{
+				"head-report": {Format: ptr("ndjson")},
+				"ocr":         {Format: ptr("protobuf")},
+			},
+		},
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -330,12 +355,26 @@ func TestConfig_Marshal(t *testing.T) {
 		Level:       ptr(toml.LogLevel(zapcore.DPanicLevel)),
 		JSONConsole: ptr(true),
 		UnixTS:      ptr(true),
+		Levels: map[string]toml.LogLevel{
+			"CCIPExec": toml.LogLevel(zapcore.DebugLevel),
+		},
 		File: toml.LogFile{
 			Dir:        ptr("log/file/dir"),
 			MaxSize:    ptr[utils.FileSize](100 * utils.GB),
 			MaxAgeDays: ptr[int64](17),
 			MaxBackups: ptr[int64](9),
 		},
+		OTLP: toml.LogOTLP{
+			Enabled:            ptr(true),
+			Endpoint:           ptr("otel.test:4317"),
+			InsecureConnection: ptr(false),
+			CACertFile:         ptr("otel/ca.pem"),
+			Level:              ptr(toml.LogLevel(zapcore.WarnLevel)),
+			QueueSize:          ptr[uint32](4096),
+			BatchSize:          ptr[uint32](256),
+			ExportInterval:     &second,
+			ExportTimeout:      &minute,
+		},
 	}
 	full.WebServer = toml.WebServer{
 		AuthenticationMethod:    ptr("local"),
@@ -867,6 +906,17 @@ LogPoller = true
 UICSAKeys = true
 CCIP = true
 MultiFeedsManagers = true
+`},
+		{"JobDistributor", Config{Core: toml.Core{JobDistributor: full.JobDistributor}}, `[JobDistributor]
+[[JobDistributor.AutoApprovalPolicies]]
+Name = 'ccip-trusted'
+JobTypes = ['ccip']
+FeedsManagers = ['3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808']
+
+[[JobDistributor.AutoApprovalPolicies]]
+Name = 'ccip-prices'
+JobTypes = ['ccip']
+ChangedFields = ['pluginConfig.tokenPricesUSDPipeline', 'pluginConfig.priceGetterConfig']
 `},
 		{"Database", Config{Core: toml.Core{Database: full.Database}}, `[Database]
 DefaultIdleInTxSessionTimeout = '1m0s'
@@ -902,6 +952,20 @@ SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
 
+[TelemetryIngress.FileSink]
+Enabled = true
+Dir = 'telemetry/file/dir'
+Format = 'ndjson'
+MaxSize = '10.00mb'
+MaxBackups = 3
+
+[TelemetryIngress.FileSink.Types]
+[TelemetryIngress.FileSink.Types.head-report]
+Format = 'ndjson'
+
+[TelemetryIngress.FileSink.Types.ocr]
+Format = 'protobuf'
+
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
 ChainID = '1'
@@ -914,11 +978,25 @@ Level = 'crit'
 JSONConsole = true
 UnixTS = true
 
+[Log.Levels]
+CCIPExec = 'debug'
+
 [Log.File]
 Dir = 'log/file/dir'
 MaxSize = '100.00gb'
 MaxAgeDays = 17
 MaxBackups = 9
+
+[Log.OTLP]
+Enabled = true
+Endpoint = 'otel.test:4317'
+InsecureConnection = false
+CACertFile = 'otel/ca.pem'
+Level = 'warn'
+QueueSize = 4096
+BatchSize = 256
+ExportInterval = '1s'
+ExportTimeout = '1m0s'
 `},
 		{"WebServer", Config{Core: toml.Core{WebServer: full.WebServer}}, `[WebServer]
 AuthenticationMethod = 'local'
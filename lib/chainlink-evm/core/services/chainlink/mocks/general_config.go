@@ -6,6 +6,8 @@ import (
 	config "github.com/smartcontractkit/chainlink/v2/core/config"
 	chainlink "github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 
+	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
+
 	mock "github.com/stretchr/testify/mock"
 
 	solanaconfig "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
@@ -970,6 +972,53 @@ func (_c *GeneralConfig_InsecurePPROFHeap_Call) RunAndReturn(run func() bool) *G
 	return _c
 }
 
+// JobDistributor provides a mock function with no fields
+func (_m *GeneralConfig) JobDistributor() config.JobDistributor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobDistributor")
+	}
+
+	var r0 config.JobDistributor
+	if rf, ok := ret.Get(0).(func() config.JobDistributor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.JobDistributor)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_JobDistributor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobDistributor'
+type GeneralConfig_JobDistributor_Call struct {
+	*mock.Call
+}
+
+// JobDistributor is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) JobDistributor() *GeneralConfig_JobDistributor_Call {
+	return &GeneralConfig_JobDistributor_Call{Call: _e.mock.On("JobDistributor")}
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Run(run func()) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Return(_a0 config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) RunAndReturn(run func() config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobPipeline provides a mock function with no fields
 func (_m *GeneralConfig) JobPipeline() config.JobPipeline {
 	ret := _m.Called()
@@ -1192,6 +1241,53 @@ func (_c *GeneralConfig_Mercury_Call) RunAndReturn(run func() config.Mercury) *G
 	return _c
 }
 
+// NamedLogLevels provides a mock function with no fields
+func (_m *GeneralConfig) NamedLogLevels() *logger.NamedLevels {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NamedLogLevels")
+	}
+
+	var r0 *logger.NamedLevels
+	if rf, ok := ret.Get(0).(func() *logger.NamedLevels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logger.NamedLevels)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_NamedLogLevels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NamedLogLevels'
+type GeneralConfig_NamedLogLevels_Call struct {
+	*mock.Call
+}
+
+// NamedLogLevels is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) NamedLogLevels() *GeneralConfig_NamedLogLevels_Call {
+	return &GeneralConfig_NamedLogLevels_Call{Call: _e.mock.On("NamedLogLevels")}
+}
+
+func (_c *GeneralConfig_NamedLogLevels_Call) Run(run func()) *GeneralConfig_NamedLogLevels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_NamedLogLevels_Call) Return(_a0 *logger.NamedLevels) *GeneralConfig_NamedLogLevels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_NamedLogLevels_Call) RunAndReturn(run func() *logger.NamedLevels) *GeneralConfig_NamedLogLevels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OCR provides a mock function with no fields
 func (_m *GeneralConfig) OCR() config.OCR {
 	ret := _m.Called()
@@ -6,6 +6,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 type GeneralConfig interface {
@@ -18,6 +19,8 @@ type GeneralConfig interface {
 	TronConfigs() RawConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// NamedLogLevels returns the per-logger-name log levels, initialized from Log.Levels and changed at runtime.
+	NamedLogLevels() *logger.NamedLevels
 	ImportedSecretConfig
 }
 
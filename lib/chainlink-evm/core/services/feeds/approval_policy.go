@@ -0,0 +1,78 @@
+package feeds
+
+import (
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
+)
+
+// ApprovalPolicy auto-approves the job proposal specs which match all of its criteria. Empty criteria match any spec.
+type ApprovalPolicy struct {
+	Name string
+	// JobTypes are the job types of the specs.
+	JobTypes []job.Type
+	// FeedsManagers are the public keys of the feeds managers proposing the specs.
+	FeedsManagers []crypto.PublicKey
+	// ChangedFields are the spec fields, and their nested fields, which may change from the approved spec. If set, the
+	// policy only matches updates of a job proposal with an approved spec.
+	ChangedFields []string
+}
+
+// NewApprovalPolicies returns the ApprovalPolicies for the configured job distributor auto approval policies.
+func NewApprovalPolicies(cfgs []coreconfig.JobDistributorApprovalPolicy) ([]ApprovalPolicy, error) {
+	policies := make([]ApprovalPolicy, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		p := ApprovalPolicy{
+			Name:          cfg.Name(),
+			ChangedFields: cfg.ChangedFields(),
+		}
+		for _, t := range cfg.JobTypes() {
+			jt := job.Type(t)
+			if !jt.IsValid() {
+				return nil, errors.Errorf("approval policy %s: unknown job type %q", p.Name, t)
+			}
+			p.JobTypes = append(p.JobTypes, jt)
+		}
+		for _, k := range cfg.FeedsManagers() {
+			pubKey, err := hex.DecodeString(k)
+			if err != nil {
+				return nil, errors.Wrapf(err, "approval policy %s: invalid feeds manager public key %q", p.Name, k)
+			}
+			p.FeedsManagers = append(p.FeedsManagers, pubKey)
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// matches returns true if the policy approves a spec of jobType proposed by the feeds manager with the manager
+// public key. changes are the changes from the approved spec if there is one, as indicated by update.
+func (p ApprovalPolicy) matches(jobType job.Type, manager crypto.PublicKey, changes []SpecFieldChange, update bool) bool {
+	if len(p.JobTypes) > 0 && !slices.Contains(p.JobTypes, jobType) {
+		return false
+	}
+	if len(p.FeedsManagers) > 0 && !slices.ContainsFunc(p.FeedsManagers, func(k crypto.PublicKey) bool {
+		return slices.Equal(k, manager)
+	}) {
+		return false
+	}
+	if len(p.ChangedFields) > 0 {
+		if !update {
+			return false
+		}
+		for _, c := range changes {
+			if !slices.ContainsFunc(p.ChangedFields, func(f string) bool {
+				return c.Path == f || strings.HasPrefix(c.Path, f+".")
+			}) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
+)
+
+type testApprovalPolicyConfig struct {
+	name          string
+	jobTypes      []string
+	feedsManagers []string
+	changedFields []string
+}
+
+func (c testApprovalPolicyConfig) Name() string            { return c.name }
+func (c testApprovalPolicyConfig) JobTypes() []string      { return c.jobTypes }
+func (c testApprovalPolicyConfig) FeedsManagers() []string { return c.feedsManagers }
+func (c testApprovalPolicyConfig) ChangedFields() []string { return c.changedFields }
+
+func Test_NewApprovalPolicies(t *testing.T) {
+	t.Parallel()
+
+	key := "3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808"
+	policies, err := NewApprovalPolicies([]coreconfig.JobDistributorApprovalPolicy{
+		testApprovalPolicyConfig{name: "ccip", jobTypes: []string{"ccip"}, feedsManagers: []string{key}},
+	})
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "ccip", policies[0].Name)
+	assert.Equal(t, []job.Type{job.CCIP}, policies[0].JobTypes)
+	assert.Equal(t, key, policies[0].FeedsManagers[0].String())
+
+	_, err = NewApprovalPolicies([]coreconfig.JobDistributorApprovalPolicy{
+		testApprovalPolicyConfig{name: "invalid", jobTypes: []string{"unknown"}, feedsManagers: []string{key}},
+	})
+	require.ErrorContains(t, err, `unknown job type "unknown"`)
+
+	_, err = NewApprovalPolicies([]coreconfig.JobDistributorApprovalPolicy{
+		testApprovalPolicyConfig{name: "invalid", feedsManagers: []string{"zz"}},
+	})
+	require.ErrorContains(t, err, "invalid feeds manager public key")
+}
+
+func Test_ApprovalPolicy_matches(t *testing.T) {
+	t.Parallel()
+
+	var (
+		trusted   = crypto.PublicKey([]byte{1, 2, 3})
+		untrusted = crypto.PublicKey([]byte{4, 5, 6})
+		changes   = []SpecFieldChange{
+			{Path: "pluginConfig.tokenPricesUSDPipeline", Kind: SpecFieldChanged},
+			{Path: "pluginConfig.priceGetterConfig.staticPrices", Kind: SpecFieldAdded},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		policy  ApprovalPolicy
+		jobType job.Type
+		manager crypto.PublicKey
+		changes []SpecFieldChange
+		update  bool
+		want    bool
+	}{
+		{
+			name:    "trusted manager and job type",
+			policy:  ApprovalPolicy{JobTypes: []job.Type{job.CCIP}, FeedsManagers: []crypto.PublicKey{trusted}},
+			jobType: job.CCIP,
+			manager: trusted,
+			changes: changes,
+			want:    true,
+		},
+		{
+			name:    "untrusted manager",
+			policy:  ApprovalPolicy{JobTypes: []job.Type{job.CCIP}, FeedsManagers: []crypto.PublicKey{trusted}},
+			jobType: job.CCIP,
+			manager: untrusted,
+			want:    false,
+		},
+		{
+			name:    "other job type",
+			policy:  ApprovalPolicy{JobTypes: []job.Type{job.CCIP}, FeedsManagers: []crypto.PublicKey{trusted}},
+			jobType: job.OffchainReporting2,
+			manager: trusted,
+			want:    false,
+		},
+		{
+			name:    "only listed fields changed",
+			policy:  ApprovalPolicy{ChangedFields: []string{"pluginConfig.tokenPricesUSDPipeline", "pluginConfig.priceGetterConfig"}},
+			jobType: job.CCIP,
+			manager: untrusted,
+			changes: changes,
+			update:  true,
+			want:    true,
+		},
+		{
+			name:    "unlisted field changed",
+			policy:  ApprovalPolicy{ChangedFields: []string{"pluginConfig.tokenPricesUSDPipeline"}},
+			jobType: job.CCIP,
+			manager: untrusted,
+			changes: changes,
+			update:  true,
+			want:    false,
+		},
+		{
+			name:    "listed fields don't match by prefix",
+			policy:  ApprovalPolicy{ChangedFields: []string{"pluginConfig.tokenPrices"}},
+			jobType: job.CCIP,
+			manager: untrusted,
+			changes: changes[:1],
+			update:  true,
+			want:    false,
+		},
+		{
+			name:    "changed fields require an approved spec",
+			policy:  ApprovalPolicy{ChangedFields: []string{"pluginConfig"}},
+			jobType: job.CCIP,
+			manager: trusted,
+			changes: changes,
+			want:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, tc.policy.matches(tc.jobType, tc.manager, tc.changes, tc.update))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package feeds
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+)
+
+// SpecFieldChangeKind defines the kind of change to a spec field.
+type SpecFieldChangeKind string
+
+const (
+	SpecFieldAdded   SpecFieldChangeKind = "added"
+	SpecFieldRemoved SpecFieldChangeKind = "removed"
+	SpecFieldChanged SpecFieldChangeKind = "changed"
+)
+
+// SpecFieldChange is a change to a single field of a job spec definition.
+type SpecFieldChange struct {
+	// Path is the dot-separated path of the field, e.g. "pluginConfig.juelsPerFeeCoinSource".
+	Path string
+	Kind SpecFieldChangeKind
+	// Old is the formatted value of the field in the current spec, or empty if the field was added.
+	Old string
+	// New is the formatted value of the field in the proposed spec, or empty if the field was removed.
+	New string
+}
+
+// DiffSpecs returns the changes to the fields of the current TOML spec definition in the proposed one, sorted by path.
+// Tables are compared field by field, while arrays are compared as a whole. An empty current definition diffs every
+// proposed field as added.
+func DiffSpecs(current, proposed string) ([]SpecFieldChange, error) {
+	currentFields, err := flattenSpec(current)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse current spec")
+	}
+	proposedFields, err := flattenSpec(proposed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed spec")
+	}
+
+	var changes []SpecFieldChange
+	for path, v := range proposedFields {
+		old, ok := currentFields[path]
+		switch {
+		case !ok:
+			changes = append(changes, SpecFieldChange{Path: path, Kind: SpecFieldAdded, New: formatSpecValue(v)})
+		case !reflect.DeepEqual(old, v):
+			changes = append(changes, SpecFieldChange{Path: path, Kind: SpecFieldChanged, Old: formatSpecValue(old), New: formatSpecValue(v)})
+		}
+	}
+	for path, v := range currentFields {
+		if _, ok := proposedFields[path]; !ok {
+			changes = append(changes, SpecFieldChange{Path: path, Kind: SpecFieldRemoved, Old: formatSpecValue(v)})
+		}
+	}
+	slices.SortFunc(changes, func(a, b SpecFieldChange) int { return strings.Compare(a.Path, b.Path) })
+
+	return changes, nil
+}
+
+// flattenSpec parses the TOML spec definition into a map of the dot-separated paths of its fields to their values.
+func flattenSpec(defn string) (map[string]any, error) {
+	var tree map[string]any
+	if err := toml.Unmarshal([]byte(defn), &tree); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]any)
+	var flatten func(prefix string, table map[string]any)
+	flatten = func(prefix string, table map[string]any) {
+		for k, v := range table {
+			path := prefix + k
+			if t, ok := v.(map[string]any); ok && len(t) > 0 {
+				flatten(path+".", t)
+				continue
+			}
+			fields[path] = v
+		}
+	}
+	flatten("", tree)
+
+	return fields, nil
+}
+
+func formatSpecValue(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case map[string]any:
+		return "{}" // only empty tables aren't flattened
+	case []any:
+		var b strings.Builder
+		enc := toml.NewEncoder(&b).SetTablesInline(true)
+		if err := enc.Encode(map[string]any{"v": t}); err != nil {
+			return fmt.Sprint(t)
+		}
+		return strings.TrimSpace(strings.TrimPrefix(b.String(), "v = "))
+	default:
+		return fmt.Sprint(t)
+	}
+}
@@ -0,0 +1,86 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	current := `
+type = "ccip"
+schemaVersion = 1
+name = "ccip"
+externalJobID = "f1ac5211-ab79-4c31-ba1c-0997b72db466"
+[pluginConfig]
+tokenPricesUSDPipeline = "old"
+feeIDs = ["a"]
+[pluginConfig.deprecated]
+enabled = true
+`
+
+	tests := []struct {
+		name     string
+		current  string
+		proposed string
+		want     []SpecFieldChange
+		wantErr  string
+	}{
+		{
+			name:     "no changes",
+			current:  current,
+			proposed: current,
+		},
+		{
+			name:    "changes",
+			current: current,
+			proposed: `
+type = "ccip"
+schemaVersion = 1
+name = "ccip"
+externalJobID = "f1ac5211-ab79-4c31-ba1c-0997b72db466"
+[pluginConfig]
+tokenPricesUSDPipeline = "new"
+feeIDs = ["a", "b"]
+[[relayConfig.chains]]
+chainID = 1
+`,
+			want: []SpecFieldChange{
+				{Path: "pluginConfig.deprecated.enabled", Kind: SpecFieldRemoved, Old: "true"},
+				{Path: "pluginConfig.feeIDs", Kind: SpecFieldChanged, Old: "['a']", New: "['a', 'b']"},
+				{Path: "pluginConfig.tokenPricesUSDPipeline", Kind: SpecFieldChanged, Old: "old", New: "new"},
+				{Path: "relayConfig.chains", Kind: SpecFieldAdded, New: "[{chainID = 1}]"},
+			},
+		},
+		{
+			name:     "no current spec",
+			proposed: `name = "ccip"`,
+			want: []SpecFieldChange{
+				{Path: "name", Kind: SpecFieldAdded, New: "ccip"},
+			},
+		},
+		{
+			name:     "invalid proposed spec",
+			current:  current,
+			proposed: `name =`,
+			wantErr:  "failed to parse proposed spec",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DiffSpecs(tc.current, tc.proposed)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -74,6 +74,16 @@ var (
 		Help: "Metric to track workflow failed auto approvals",
 	})
 
+	promJobProposalPolicyApprovals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_job_proposal_policy_approvals",
+		Help: "Metric to track job proposal spec successful auto approvals by approval policy",
+	}, []string{"policy"})
+
+	promJobProposalPolicyFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_job_proposal_policy_failures",
+		Help: "Metric to track job proposal spec failed auto approvals by approval policy",
+	}, []string{"policy"})
+
 	promJobProposalCounts = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "feeds_job_proposal_count",
 		Help: "Number of job proposals for the node partitioned by status.",
@@ -155,6 +165,7 @@ type service struct {
 	syncMinDelay        time.Duration
 	syncMaxDelay        time.Duration
 	syncMaxAttempts     uint
+	approvalPolicies    []ApprovalPolicy
 }
 
 // NewService constructs a new feeds service
@@ -775,6 +786,10 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		if len(s.approvalPolicies) > 0 {
+			s.autoApproveSpec(ctx, logger, args, id, specID)
+		}
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
@@ -794,6 +809,66 @@ func isWFSpec(lggr logger.Logger, spec string) bool {
 	return jobType == job.Workflow
 }
 
+// autoApproveSpec approves the proposed spec if an approval policy matches it. The spec is left pending for manual
+// review if none does, or if the approval fails.
+func (s *service) autoApproveSpec(ctx context.Context, lggr logger.Logger, args *ProposeJobArgs, proposalID, specID int64) {
+	policy, update, err := s.matchApprovalPolicy(ctx, args, proposalID)
+	if err != nil {
+		lggr.Errorw("Failed to evaluate approval policies", "id", proposalID, "err", err)
+		return
+	}
+	if policy == nil {
+		lggr.Debugw("No approval policy matches the job proposal spec", "id", proposalID)
+		return
+	}
+
+	// Only force replacing the job of an approved spec of this proposal, so that new proposals conflicting with an
+	// existing job are left for manual review.
+	if err = s.ApproveSpec(ctx, specID, update); err != nil {
+		promJobProposalPolicyFailures.WithLabelValues(policy.Name).Inc()
+		lggr.Errorw("Failed to auto approve job proposal spec", "id", proposalID, "policy", policy.Name, "err", err)
+		return
+	}
+	lggr.Infow("Successful job proposal spec auto approval", "id", proposalID, "policy", policy.Name)
+	promJobProposalPolicyApprovals.WithLabelValues(policy.Name).Inc()
+}
+
+// matchApprovalPolicy returns the first approval policy which matches the proposed spec, or nil if none does, and
+// whether the proposal has an approved spec.
+func (s *service) matchApprovalPolicy(ctx context.Context, args *ProposeJobArgs, proposalID int64) (*ApprovalPolicy, bool, error) {
+	jobType, err := job.ValidateSpec(args.Spec)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to validate spec")
+	}
+
+	mgr, err := s.orm.GetManager(ctx, args.FeedsManagerID)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get feeds manager")
+	}
+
+	var current string
+	approved, err := s.orm.GetApprovedSpec(ctx, proposalID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+	update := err == nil
+	if update {
+		current = approved.Definition
+	}
+
+	changes, err := DiffSpecs(current, args.Spec)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for i, p := range s.approvalPolicies {
+		if p.matches(jobType, mgr.PublicKey, changes, update) {
+			return &s.approvalPolicies[i], update, nil
+		}
+	}
+	return nil, update, nil
+}
+
 // GetJobProposal gets a job proposal by id.
 func (s *service) GetJobProposal(ctx context.Context, id int64) (*JobProposal, error) {
 	return s.orm.GetJobProposal(ctx, id)
@@ -1672,6 +1747,10 @@ func WithSyncMaxAttempts(attempts uint) ServiceOption {
 	return func(s *service) { s.syncMaxAttempts = attempts }
 }
 
+func WithApprovalPolicies(policies ...ApprovalPolicy) ServiceOption {
+	return func(s *service) { s.approvalPolicies = policies }
+}
+
 var _ Service = &NullService{}
 
 // NullService defines an implementation of the Feeds Service that is used
@@ -1338,6 +1338,101 @@ func Test_Service_ProposeJob(t *testing.T) {
 	}
 }
 
+func Test_Service_ProposeJob_ApprovalPolicies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id                   = int64(1)
+		specID               = int64(100)
+		nameAndExternalJobID = uuid.New()
+		spec                 = fmt.Sprintf(FluxMonitorTestSpecTemplate, nameAndExternalJobID, nameAndExternalJobID)
+		args                 = &feeds.ProposeJobArgs{
+			FeedsManagerID: 1,
+			RemoteUUID:     uuid.New(),
+			Spec:           spec,
+			Version:        1,
+		}
+		jp = feeds.JobProposal{
+			FeedsManagerID: 1,
+			Name:           null.StringFrom(nameAndExternalJobID.String()),
+			RemoteUUID:     args.RemoteUUID,
+			Status:         feeds.JobProposalStatusPending,
+		}
+		jpSpec = feeds.JobProposalSpec{
+			Definition:    spec,
+			Status:        feeds.SpecStatusPending,
+			Version:       args.Version,
+			JobProposalID: id,
+		}
+		trusted   = crypto.PublicKey([]byte{1, 2, 3})
+		untrusted = crypto.PublicKey([]byte{4, 5, 6})
+		policy    = feeds.ApprovalPolicy{
+			Name:          "trusted",
+			JobTypes:      []job.Type{job.FluxMonitor},
+			FeedsManagers: []crypto.PublicKey{trusted},
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		before  func(svc *TestService)
+		wantLog string
+	}{
+		{
+			name: "no matching policy",
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", mock.Anything, args.FeedsManagerID).Return(&feeds.FeedsManager{PublicKey: untrusted}, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, id).Return(nil, sql.ErrNoRows)
+			},
+			wantLog: "No approval policy matches the job proposal spec",
+		},
+		{
+			name: "matching policy approves the spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", mock.Anything, args.FeedsManagerID).Return(&feeds.FeedsManager{PublicKey: trusted}, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, id).Return(nil, sql.ErrNoRows)
+				// The approval fails without a connection to the feeds manager, leaving the spec pending
+				svc.orm.EXPECT().GetSpec(mock.Anything, specID).Return(&feeds.JobProposalSpec{ID: specID, Status: feeds.SpecStatusPending, JobProposalID: id}, nil)
+				svc.orm.EXPECT().GetJobProposal(mock.Anything, id).Return(&feeds.JobProposal{ID: id, Status: feeds.JobProposalStatusPending}, nil)
+				svc.connMgr.On("GetClient", args.FeedsManagerID).Return(nil, errors.New("not connected"))
+			},
+			wantLog: "Failed to auto approve job proposal spec",
+		},
+		{
+			name: "failed to get feeds manager",
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", mock.Anything, args.FeedsManagerID).Return(nil, errors.New("orm error"))
+			},
+			wantLog: "Failed to evaluate approval policies",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := setupTestServiceCfg(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.JobPipeline.HTTPRequest.DefaultTimeout = commonconfig.MustNewDuration(time.Second)
+			}, feeds.WithApprovalPolicies(policy))
+			svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, args.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+			svc.orm.On("UpsertJobProposal", mock.Anything, &jp).Return(id, nil)
+			svc.orm.On("CreateSpec", mock.Anything, jpSpec).Return(specID, nil)
+			svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+			transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+			transactCall.Run(func(args mock.Arguments) {
+				fn := args[1].(func(orm feeds.ORM) error)
+				transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+			})
+			tc.before(svc)
+
+			actual, err := svc.ProposeJob(testutils.Context(t), args)
+			require.NoError(t, err)
+			assert.Equal(t, id, actual)
+			assert.Equal(t, 1, svc.logs.FilterMessage(tc.wantLog).Len())
+		})
+	}
+}
+
 func Test_Service_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,125 @@
+package fluxmonitorv2
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// madScale scales the median absolute deviation to a consistent estimator of
+// the standard deviation of normally distributed values.
+var madScale = decimal.RequireFromString("1.4826")
+
+// minRelativeDeviation is the fraction of the median within which source
+// values are never rejected as outliers. Without it a median absolute
+// deviation of 0, when most sources agree exactly, would reject every source
+// differing from them by any amount.
+var minRelativeDeviation = decimal.RequireFromString("0.001")
+
+// SourceStatus is the status of a source of an aggregated answer.
+type SourceStatus string
+
+const (
+	SourceStatusOK      SourceStatus = "ok"
+	SourceStatusError   SourceStatus = "error"
+	SourceStatusOutlier SourceStatus = "outlier"
+)
+
+// SourceResult is the result of a single source sub-pipeline of an aggregated
+// answer.
+type SourceResult struct {
+	Source string           `json:"source"`
+	Status SourceStatus     `json:"status"`
+	Value  *decimal.Decimal `json:"value,omitempty"`
+	Error  string           `json:"error,omitempty"`
+}
+
+// AggregatedAnswer is the answer aggregated from the results of the source
+// sub-pipelines, which is recorded in the meta of the pipeline run.
+type AggregatedAnswer struct {
+	Answer  decimal.Decimal `json:"answer"`
+	Sources []SourceResult  `json:"sources"`
+}
+
+// ErrInsufficientSources is returned when fewer sources than the quorum
+// return a valid, non-outlier value.
+var ErrInsufficientSources = errors.New("insufficient sources for aggregated answer")
+
+// aggregateAnswer computes the median of the values of the source tasks of
+// cfg, after rejecting the values further than OutlierThreshold scaled median
+// absolute deviations, and further than minRelativeDeviation of the median,
+// from the median of all values. The per-source results are returned even if
+// fewer than MinSources sources remain.
+func aggregateAnswer(cfg job.FluxMonitorAggregation, trrs pipeline.TaskRunResults) (AggregatedAnswer, error) {
+	var agg AggregatedAnswer
+	var values []decimal.Decimal
+	for _, source := range cfg.Sources {
+		sr := SourceResult{Source: source, Status: SourceStatusError}
+		i := slices.IndexFunc(trrs, func(trr pipeline.TaskRunResult) bool { return trr.Task.DotID() == source })
+		switch {
+		case i < 0:
+			sr.Error = "source task did not run"
+		case trrs[i].Result.Error != nil:
+			sr.Error = trrs[i].Result.Error.Error()
+		default:
+			value, err := utils.ToDecimal(trrs[i].Result.Value)
+			if err != nil {
+				sr.Error = err.Error()
+				break
+			}
+			sr.Status = SourceStatusOK
+			sr.Value = &value
+			values = append(values, value)
+		}
+		agg.Sources = append(agg.Sources, sr)
+	}
+	if len(values) == 0 {
+		return agg, errors.Wrapf(ErrInsufficientSources, "0 of %d sources succeeded", len(cfg.Sources))
+	}
+
+	median := medianOf(values)
+	deviations := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		deviations[i] = v.Sub(median).Abs()
+	}
+	maxDeviation := decimal.Max(
+		medianOf(deviations).Mul(madScale).Mul(decimal.NewFromFloat(float64(cfg.OutlierThreshold))),
+		median.Abs().Mul(minRelativeDeviation),
+	)
+
+	var inliers []decimal.Decimal
+	for i := range agg.Sources {
+		sr := &agg.Sources[i]
+		if sr.Status != SourceStatusOK {
+			continue
+		}
+		if sr.Value.Sub(median).Abs().GreaterThan(maxDeviation) {
+			sr.Status = SourceStatusOutlier
+			continue
+		}
+		inliers = append(inliers, *sr.Value)
+	}
+	if len(inliers) < int(cfg.MinSources) {
+		return agg, errors.Wrapf(ErrInsufficientSources, "%d of %d sources succeeded without outliers, need %d", len(inliers), len(cfg.Sources), cfg.MinSources)
+	}
+	agg.Answer = medianOf(inliers)
+
+	return agg, nil
+}
+
+// medianOf returns the median of the non-empty values, averaging the middle
+// two for an even number of values.
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b decimal.Decimal) int { return a.Cmp(b) })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).Div(decimal.NewFromInt(2))
+}
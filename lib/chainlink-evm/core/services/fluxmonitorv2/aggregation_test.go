@@ -0,0 +1,111 @@
+package fluxmonitorv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func sourceResult(dotID string, value interface{}, err error) pipeline.TaskRunResult {
+	return pipeline.TaskRunResult{
+		Task:   &pipeline.JSONParseTask{BaseTask: pipeline.NewBaseTask(0, dotID, nil, nil, 0)},
+		Result: pipeline.Result{Value: value, Error: err},
+	}
+}
+
+func Test_aggregateAnswer(t *testing.T) {
+	t.Parallel()
+
+	cfg := job.FluxMonitorAggregation{
+		Sources:          []string{"ds1", "ds2", "ds3", "ds4"},
+		MinSources:       3,
+		OutlierThreshold: 3,
+	}
+
+	t.Run("median of all sources", func(t *testing.T) {
+		agg, err := aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", 101, nil),
+			sourceResult("ds3", 102.5, nil),
+			sourceResult("ds4", decimal.NewFromInt(99), nil),
+			sourceResult("answer", 0, nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "100.5", agg.Answer.String())
+		require.Len(t, agg.Sources, 4)
+		for _, sr := range agg.Sources {
+			assert.Equal(t, SourceStatusOK, sr.Status, sr.Source)
+		}
+	})
+
+	t.Run("rejects outliers and failed sources", func(t *testing.T) {
+		agg, err := aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", "101", nil),
+			sourceResult("ds3", "99", nil),
+			sourceResult("ds4", "1000", nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "100", agg.Answer.String())
+		assert.Equal(t, SourceStatusOutlier, agg.Sources[3].Status)
+		assert.Equal(t, "1000", agg.Sources[3].Value.String())
+
+		agg, err = aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", nil, errors.New("bridge down")),
+			sourceResult("ds3", "99", nil),
+			sourceResult("ds4", "98", nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "99", agg.Answer.String())
+		assert.Equal(t, SourceStatusError, agg.Sources[1].Status)
+		assert.Equal(t, "bridge down", agg.Sources[1].Error)
+		assert.Nil(t, agg.Sources[1].Value)
+	})
+
+	t.Run("zero median absolute deviation", func(t *testing.T) {
+		agg, err := aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", "100", nil),
+			sourceResult("ds3", "100", nil),
+			sourceResult("ds4", "100.05", nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "100", agg.Answer.String())
+		assert.Equal(t, SourceStatusOK, agg.Sources[3].Status)
+
+		agg, err = aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", "100", nil),
+			sourceResult("ds3", "100", nil),
+			sourceResult("ds4", "101", nil),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "100", agg.Answer.String())
+		assert.Equal(t, SourceStatusOutlier, agg.Sources[3].Status)
+	})
+
+	t.Run("quorum not reached", func(t *testing.T) {
+		agg, err := aggregateAnswer(cfg, pipeline.TaskRunResults{
+			sourceResult("ds1", "100", nil),
+			sourceResult("ds2", nil, errors.New("bridge down")),
+			sourceResult("ds3", "not a number", nil),
+		})
+		require.ErrorIs(t, err, ErrInsufficientSources)
+		require.Len(t, agg.Sources, 4)
+		assert.Equal(t, SourceStatusOK, agg.Sources[0].Status)
+		assert.Equal(t, SourceStatusError, agg.Sources[1].Status)
+		assert.Equal(t, SourceStatusError, agg.Sources[2].Status)
+		assert.Equal(t, SourceStatusError, agg.Sources[3].Status)
+		assert.Equal(t, "source task did not run", agg.Sources[3].Error)
+
+		_, err = aggregateAnswer(cfg, nil)
+		require.ErrorIs(t, err, ErrInsufficientSources)
+	})
+}
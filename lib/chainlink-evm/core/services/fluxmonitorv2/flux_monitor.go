@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/flags_wrapper"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/flux_aggregator_wrapper"
@@ -733,15 +734,8 @@ func (fm *FluxMonitor) respondToNewRoundLog(ctx context.Context, log flux_aggreg
 		newRoundLogger.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
 		return
 	}
-	result, err := results.FinalResult().SingularResult()
-	if err != nil || result.Error != nil {
-		newRoundLogger.Errorw("can't fetch answer", "err", err, "result", result)
-		fm.jobORM.TryRecordError(ctx, fm.spec.JobID, "Error polling")
-		return
-	}
-	answer, err := utils.ToDecimal(result.Value)
-	if err != nil {
-		newRoundLogger.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+	answer, ok := fm.answer(ctx, newRoundLogger, run, results)
+	if !ok {
 		return
 	}
 
@@ -770,6 +764,44 @@ func (fm *FluxMonitor) respondToNewRoundLog(ctx context.Context, log flux_aggreg
 	}
 }
 
+// answer returns the answer of the run, which is aggregated from the results of
+// the source sub-pipelines if the job spec configures an aggregation. The
+// per-source results of an aggregated answer are recorded in the run meta, and
+// a run failing the quorum is saved to keep them.
+func (fm *FluxMonitor) answer(ctx context.Context, lggr logger.SugaredLogger, run *pipeline.Run, results pipeline.TaskRunResults) (decimal.Decimal, bool) {
+	if fm.jobSpec.FluxMonitorSpec != nil && fm.jobSpec.FluxMonitorSpec.Aggregation != nil {
+		agg, err := aggregateAnswer(*fm.jobSpec.FluxMonitorSpec.Aggregation, results)
+		jobID := strconv.Itoa(int(fm.spec.JobID))
+		for _, sr := range agg.Sources {
+			promfm.SourceResults.WithLabelValues(jobID, sr.Source, string(sr.Status)).Inc()
+		}
+		run.Meta = jsonserializable.JSONSerializable{Val: agg, Valid: true}
+		if err != nil {
+			lggr.Errorw("can't aggregate answer", "err", err, "sources", agg.Sources)
+			fm.jobORM.TryRecordError(ctx, fm.spec.JobID, "Error polling")
+			// save the run without a submission, to keep the per-source results of the failed quorum
+			if err2 := fm.runner.InsertFinishedRun(ctx, nil, run, true); err2 != nil {
+				lggr.Errorw("can't save run with failed quorum", "err", err2)
+			}
+			return decimal.Decimal{}, false
+		}
+		return agg.Answer, true
+	}
+
+	result, err := results.FinalResult().SingularResult()
+	if err != nil || result.Error != nil {
+		lggr.Errorw("can't fetch answer", "err", err, "result", result)
+		fm.jobORM.TryRecordError(ctx, fm.spec.JobID, "Error polling")
+		return decimal.Decimal{}, false
+	}
+	answer, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		lggr.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+		return decimal.Decimal{}, false
+	}
+	return answer, true
+}
+
 func (fm *FluxMonitor) Transact(ctx context.Context, fn func(sqlutil.DataSource) error) error {
 	return sqlutil.TransactDataSource(ctx, fm.ds, nil, fn)
 }
@@ -939,15 +971,8 @@ func (fm *FluxMonitor) pollIfEligible(ctx context.Context, pollReq PollRequestTy
 		fm.jobORM.TryRecordError(ctx, fm.spec.JobID, "Error polling")
 		return
 	}
-	result, err := results.FinalResult().SingularResult()
-	if err != nil || result.Error != nil {
-		l.Errorw("can't fetch answer", "err", err, "result", result)
-		fm.jobORM.TryRecordError(ctx, fm.spec.JobID, "Error polling")
-		return
-	}
-	answer, err := utils.ToDecimal(result.Value)
-	if err != nil {
-		l.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+	answer, ok := fm.answer(ctx, l, run, results)
+	if !ok {
 		return
 	}
 
@@ -40,6 +40,14 @@ var (
 		},
 		[]string{"job_spec_id"},
 	)
+
+	SourceResults = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_source_results",
+			Help: "Results of the sources of aggregated flux monitor answers, by status (ok, error or outlier)",
+		},
+		[]string{"job_spec_id", "source", "status"},
+	)
 )
 
 // SetDecimal sets a decimal metric
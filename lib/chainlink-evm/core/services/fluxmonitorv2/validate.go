@@ -10,6 +10,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -71,6 +72,12 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if jb.FluxMonitorSpec.Aggregation != nil {
+		if err := validateAggregation(jb.FluxMonitorSpec.Aggregation, &jb.Pipeline); err != nil {
+			return jb, errors.Wrap(err, "while validating aggregation")
+		}
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, JobPipeline.HTTPRequest.DefaultTimeout config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
@@ -88,3 +95,37 @@ func validatePollTimer(disabled bool, minTimeout time.Duration, period time.Dura
 
 	return period >= minTimeout
 }
+
+// validateAggregation validates the aggregation sources are distinct tasks of
+// the pipeline, and defaults the quorum to a majority of the sources and the
+// outlier threshold to 3 scaled median absolute deviations.
+func validateAggregation(agg *job.FluxMonitorAggregation, p *pipeline.Pipeline) error {
+	if len(agg.Sources) == 0 {
+		return errors.New("at least one source is required")
+	}
+	seen := make(map[string]struct{}, len(agg.Sources))
+	for _, source := range agg.Sources {
+		if _, ok := seen[source]; ok {
+			return errors.Errorf("duplicate source %q", source)
+		}
+		seen[source] = struct{}{}
+		if p.ByDotID(source) == nil {
+			return errors.Errorf("source %q is not a task of the observation source", source)
+		}
+	}
+
+	if agg.MinSources == 0 {
+		agg.MinSources = uint32(len(agg.Sources)/2 + 1) //nolint:gosec // number of sources can't overflow
+	}
+	if int(agg.MinSources) > len(agg.Sources) {
+		return errors.Errorf("minSources (%d) must not exceed the number of sources (%d)", agg.MinSources, len(agg.Sources))
+	}
+
+	if agg.OutlierThreshold < 0 {
+		return errors.Errorf("outlierThreshold (%v) must not be negative", agg.OutlierThreshold)
+	}
+	if agg.OutlierThreshold == 0 {
+		agg.OutlierThreshold = 3
+	}
+	return nil
+}
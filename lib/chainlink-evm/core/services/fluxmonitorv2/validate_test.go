@@ -210,6 +210,97 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "aggregation",
+			toml: `
+type = "fluxmonitor"
+schemaVersion = 1
+contractAddress = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold = 0.5
+idleTimerPeriod = "1m0s"
+pollTimerPeriod = "1m0s"
+observationSource = """
+  feed0 [type=bridge name="bridge-coinmarketcap"];
+  jsonparse0 [type=jsonparse path="data,result"];
+  feed1 [type=bridge name="bridge-kaiko"];
+  jsonparse1 [type=jsonparse path="data,result"];
+  feed2 [type=bridge name="bridge-nomics"];
+  jsonparse2 [type=jsonparse path="data,result"];
+  feed0 -> jsonparse0;
+  feed1 -> jsonparse1;
+  feed2 -> jsonparse2;
+"""
+
+[aggregation]
+sources = ["jsonparse0", "jsonparse1", "jsonparse2"]
+outlierThreshold = 2
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.FluxMonitorSpec.Aggregation)
+				agg := s.FluxMonitorSpec.Aggregation
+				assert.Equal(t, []string{"jsonparse0", "jsonparse1", "jsonparse2"}, agg.Sources)
+				assert.Equal(t, uint32(2), agg.MinSources)
+				assert.Equal(t, tomlutils.Float64(2), agg.OutlierThreshold)
+			},
+		},
+		{
+			name: "aggregation with unknown source",
+			toml: `
+type = "fluxmonitor"
+schemaVersion = 1
+contractAddress = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold = 0.5
+idleTimerPeriod = "1m0s"
+pollTimerPeriod = "1m0s"
+observationSource = """
+  feed0 [type=bridge name="bridge-coinmarketcap"];
+  jsonparse0 [type=jsonparse path="data,result"];
+  feed1 [type=bridge name="bridge-kaiko"];
+  jsonparse1 [type=jsonparse path="data,result"];
+  feed2 [type=bridge name="bridge-nomics"];
+  jsonparse2 [type=jsonparse path="data,result"];
+  feed0 -> jsonparse0;
+  feed1 -> jsonparse1;
+  feed2 -> jsonparse2;
+"""
+
+[aggregation]
+sources = ["jsonparse0", "jsonparse3"]
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, `while validating aggregation: source "jsonparse3" is not a task of the observation source`)
+			},
+		},
+		{
+			name: "aggregation quorum exceeds sources",
+			toml: `
+type = "fluxmonitor"
+schemaVersion = 1
+contractAddress = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold = 0.5
+idleTimerPeriod = "1m0s"
+pollTimerPeriod = "1m0s"
+observationSource = """
+  feed0 [type=bridge name="bridge-coinmarketcap"];
+  jsonparse0 [type=jsonparse path="data,result"];
+  feed1 [type=bridge name="bridge-kaiko"];
+  jsonparse1 [type=jsonparse path="data,result"];
+  feed2 [type=bridge name="bridge-nomics"];
+  jsonparse2 [type=jsonparse path="data,result"];
+  feed0 -> jsonparse0;
+  feed1 -> jsonparse1;
+  feed2 -> jsonparse2;
+"""
+
+[aggregation]
+sources = ["jsonparse0", "jsonparse1"]
+minSources = 3
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "while validating aggregation: minSources (3) must not exceed the number of sources (2)")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -24,6 +24,54 @@ func (_m *Spawner) EXPECT() *Spawner_Expecter {
 	return &Spawner_Expecter{mock: &_m.Mock}
 }
 
+// ActiveJobServices provides a mock function with given fields: jobID
+func (_m *Spawner) ActiveJobServices(jobID int32) []job.ServiceCtx {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveJobServices")
+	}
+
+	var r0 []job.ServiceCtx
+	if rf, ok := ret.Get(0).(func(int32) []job.ServiceCtx); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.ServiceCtx)
+		}
+	}
+
+	return r0
+}
+
+// Spawner_ActiveJobServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveJobServices'
+type Spawner_ActiveJobServices_Call struct {
+	*mock.Call
+}
+
+// ActiveJobServices is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Spawner_Expecter) ActiveJobServices(jobID interface{}) *Spawner_ActiveJobServices_Call {
+	return &Spawner_ActiveJobServices_Call{Call: _e.mock.On("ActiveJobServices", jobID)}
+}
+
+func (_c *Spawner_ActiveJobServices_Call) Run(run func(jobID int32)) *Spawner_ActiveJobServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ActiveJobServices_Call) Return(_a0 []job.ServiceCtx) *Spawner_ActiveJobServices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ActiveJobServices_Call) RunAndReturn(run func(int32) []job.ServiceCtx) *Spawner_ActiveJobServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ActiveJobs provides a mock function with no fields
 func (_m *Spawner) ActiveJobs() map[int32]job.Job {
 	ret := _m.Called()
@@ -535,9 +535,42 @@ type FluxMonitorSpec struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *commonassets.Link
-	EVMChainID          *big.Big  `toml:"evmChainID"`
-	CreatedAt           time.Time `toml:"-"`
-	UpdatedAt           time.Time `toml:"-"`
+	EVMChainID          *big.Big                `toml:"evmChainID"`
+	Aggregation         *FluxMonitorAggregation `toml:"aggregation"`
+	CreatedAt           time.Time               `toml:"-"`
+	UpdatedAt           time.Time               `toml:"-"`
+}
+
+// FluxMonitorAggregation configures a flux monitor job to aggregate the answers of several source sub-pipelines,
+// instead of submitting the single result of the pipeline. The answer is the median of the source answers, after
+// rejecting the outliers by median absolute deviation.
+type FluxMonitorAggregation struct {
+	// Sources are the IDs of the final tasks of the source sub-pipelines.
+	Sources []string `toml:"sources" json:"sources"`
+	// MinSources is the number of sources which must answer, without being rejected as outliers, for the answer to be
+	// submitted.
+	MinSources uint32 `toml:"minSources" json:"minSources"`
+	// OutlierThreshold is the number of scaled median absolute deviations from the median beyond which source answers
+	// are rejected as outliers. Answers within 0.1% of the median are never rejected.
+	OutlierThreshold tomlutils.Float64 `toml:"outlierThreshold" json:"outlierThreshold"`
+}
+
+// Value returns this instance serialized for database storage.
+func (a FluxMonitorAggregation) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+// Scan reads the database value and returns an instance.
+func (a *FluxMonitorAggregation) Scan(value interface{}) error {
+	if value == nil {
+		return nil // field is nullable
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, a)
 }
 
 type KeeperSpec struct {
@@ -30,6 +30,8 @@ type (
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// ActiveJobServices returns the services of the job, nil if the job is not active.
+		ActiveJobServices(jobID int32) []ServiceCtx
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -362,6 +364,19 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
+func (js *spawner) ActiveJobServices(jobID int32) []ServiceCtx {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return nil
+	}
+	srvs := make([]ServiceCtx, len(aj.services))
+	copy(srvs, aj.services)
+	return srvs
+}
+
 func (js *spawner) activeJobIDs() []int32 {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -33,6 +33,12 @@ var (
 	}
 )
 
+// IsValid returns true if t is a supported job type.
+func (t Type) IsValid() bool {
+	_, ok := jobTypes[t]
+	return ok
+}
+
 // ValidateSpec is the common spec validation
 func ValidateSpec(ts string) (Type, error) {
 	var jb Job
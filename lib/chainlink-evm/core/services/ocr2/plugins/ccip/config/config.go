@@ -63,9 +63,10 @@ type TokenPriceConfig struct {
 	TokenAddress common.Address `json:"tokenAddress"`
 	// ChainSelector is the chain selector of the chain that the token is deployed on (source or dest).
 	ChainSelector uint64 `json:"chainSelector,string"`
-	// Exactly one of AggregatorConfig or StaticConfig must be set. It defines the source of the price.
-	AggregatorConfig *AggregatorPriceConfig `json:"aggregatorConfig,omitempty"`
-	StaticConfig     *StaticPriceConfig     `json:"staticConfig,omitempty"`
+	// Exactly one of AggregatorConfig, StaticConfig or MultiSourceConfig must be set. It defines the source of the price.
+	AggregatorConfig  *AggregatorPriceConfig  `json:"aggregatorConfig,omitempty"`
+	StaticConfig      *StaticPriceConfig      `json:"staticConfig,omitempty"`
+	MultiSourceConfig *MultiSourcePriceConfig `json:"multiSourceConfig,omitempty"`
 }
 
 // MoveDeprecatedFields moves the deprecated fields to the new TokenPrices field.
@@ -139,8 +140,8 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 			return fmt.Errorf("chain selector is zero: %v", cfg)
 		}
 
-		if cfg.AggregatorConfig != nil && cfg.StaticConfig != nil {
-			return fmt.Errorf("both aggregator and static price configuration is defined: %v", cfg)
+		if numPriceSources(cfg) > 1 {
+			return fmt.Errorf("more than one of aggregator, static or multi source price configuration is defined: %v", cfg)
 		}
 
 		k := tokenKey{ChainSelector: cfg.ChainSelector, TokenAddress: cfg.TokenAddress}
@@ -167,13 +168,33 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 			}
 		}
 
-		if cfg.AggregatorConfig == nil && cfg.StaticConfig == nil {
+		if cfg.MultiSourceConfig != nil {
+			if err := cfg.MultiSourceConfig.Validate(); err != nil {
+				return fmt.Errorf("invalid multi source config: %w: %v", err, cfg)
+			}
+		}
+
+		if numPriceSources(cfg) == 0 {
 			return fmt.Errorf("no price configuration defined: %v", cfg)
 		}
 	}
 	return nil
 }
 
+func numPriceSources(cfg TokenPriceConfig) int {
+	n := 0
+	if cfg.AggregatorConfig != nil {
+		n++
+	}
+	if cfg.StaticConfig != nil {
+		n++
+	}
+	if cfg.MultiSourceConfig != nil {
+		n++
+	}
+	return n
+}
+
 // AggregatorPriceConfig specifies a price retrieved from an aggregator contract.
 type AggregatorPriceConfig struct {
 	ChainID                   uint64         `json:"chainID,string"`
@@ -187,6 +208,57 @@ type StaticPriceConfig struct {
 	Price   *big.Int `json:"price"`
 }
 
+// MultiSourcePriceConfig specifies a price aggregated from several aggregator sources.
+// The price is the median of the healthy sources, a source is unhealthy if its call fails, its answer is stale
+// or it deviates from the median of all fresh sources by more than MaxDeviationPPB.
+type MultiSourcePriceConfig struct {
+	Sources []PriceSourceConfig `json:"sources"`
+	// MaxStalenessSeconds discards answers with an updatedAt older than this, 0 disables the check.
+	MaxStalenessSeconds uint32 `json:"maxStalenessSeconds"`
+	// MaxDeviationPPB discards answers deviating from the median by more than this (1e9 = 100%), 0 disables the check.
+	MaxDeviationPPB uint64 `json:"maxDeviationPPB,string"`
+	// MinSources is the minimum number of healthy sources required, defaults to 1.
+	MinSources int `json:"minSources"`
+	// Fallback is used when fewer than MinSources sources are healthy.
+	Fallback *StaticPriceConfig `json:"fallback,omitempty"`
+}
+
+// PriceSourceConfig is a named aggregator source. Any contract implementing the AggregatorV3 interface can be used,
+// including bridge adapters.
+type PriceSourceConfig struct {
+	Name string `json:"name"`
+	AggregatorPriceConfig
+}
+
+func (c *MultiSourcePriceConfig) Validate() error {
+	if len(c.Sources) == 0 {
+		return errors.New("no sources defined")
+	}
+	if c.MinSources < 0 || c.MinSources > len(c.Sources) {
+		return fmt.Errorf("min sources %d must be between 0 and the number of sources %d", c.MinSources, len(c.Sources))
+	}
+	seen := make(map[string]struct{}, len(c.Sources))
+	for _, src := range c.Sources {
+		if src.Name == "" {
+			return errors.New("source name is empty")
+		}
+		if _, ok := seen[src.Name]; ok {
+			return fmt.Errorf("duplicate source name %s", src.Name)
+		}
+		seen[src.Name] = struct{}{}
+		if src.AggregatorContractAddress == utils.ZeroAddress {
+			return fmt.Errorf("source %s aggregator contract address is zero", src.Name)
+		}
+		if src.ChainID == 0 {
+			return fmt.Errorf("source %s chain id is zero", src.Name)
+		}
+	}
+	if c.Fallback != nil && c.Fallback.Price == nil {
+		return errors.New("fallback price is nil")
+	}
+	return nil
+}
+
 // UnmarshalJSON provides a custom un-marshaller to handle JSON embedded in Toml content.
 func (c *DynamicPriceGetterConfig) UnmarshalJSON(data []byte) error {
 	type Alias DynamicPriceGetterConfig
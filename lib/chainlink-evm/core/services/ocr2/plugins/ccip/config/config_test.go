@@ -464,3 +464,56 @@ func TestDynamicPriceGetterConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiSourcePriceConfig(t *testing.T) {
+	jsonCfg := `
+		{
+		  "tokenPrices": [
+		    {
+		      "tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3",
+		      "chainSelector": "11787463284727550157",
+		      "multiSourceConfig": {
+		        "sources": [
+		          {"name": "eth", "chainID": "1", "contractAddress": "0xb8dabd288955d302d05ca6b011bb46dfa3ea7acf"},
+		          {"name": "arb", "chainID": "42161", "contractAddress": "0xb80244cc8b0bb18db071c150b36e9bcb8310b236"}
+		        ],
+		        "maxStalenessSeconds": 3600,
+		        "maxDeviationPPB": "50000000",
+		        "minSources": 1,
+		        "fallback": {"chainID": "1", "price": 1000000000000000000}
+		      }
+		    }
+		  ]
+		}
+	`
+	var cfg DynamicPriceGetterConfig
+	require.NoError(t, json.Unmarshal([]byte(jsonCfg), &cfg))
+	require.NoError(t, cfg.Validate())
+
+	multi := cfg.TokenPrices[0].MultiSourceConfig
+	require.NotNil(t, multi)
+	require.Len(t, multi.Sources, 2)
+	require.Equal(t, "arb", multi.Sources[1].Name)
+	require.Equal(t, uint64(42161), multi.Sources[1].ChainID)
+	require.Equal(t, common.HexToAddress("0xb80244cc8b0bb18db071c150b36e9bcb8310b236"), multi.Sources[1].AggregatorContractAddress)
+	require.Equal(t, uint64(50_000_000), multi.MaxDeviationPPB)
+	require.Equal(t, 0, big.NewInt(1e18).Cmp(multi.Fallback.Price))
+
+	source := func(name string) PriceSourceConfig {
+		return PriceSourceConfig{Name: name, AggregatorPriceConfig: AggregatorPriceConfig{ChainID: 1, AggregatorContractAddress: utils.RandomAddress()}}
+	}
+	invalid := []MultiSourcePriceConfig{
+		{},
+		{Sources: []PriceSourceConfig{source("a"), source("a")}},
+		{Sources: []PriceSourceConfig{source("")}},
+		{Sources: []PriceSourceConfig{source("a")}, MinSources: 2},
+		{Sources: []PriceSourceConfig{{Name: "a", AggregatorPriceConfig: AggregatorPriceConfig{ChainID: 1}}}},
+		{Sources: []PriceSourceConfig{source("a")}, Fallback: &StaticPriceConfig{}},
+	}
+	for _, c := range invalid {
+		require.Error(t, c.Validate(), "%+v", c)
+	}
+
+	cfg.TokenPrices[0].StaticConfig = &StaticPriceConfig{ChainID: 1, Price: big.NewInt(1)}
+	require.ErrorContains(t, cfg.Validate(), "more than one")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -57,6 +58,7 @@ type DynamicPriceGetter struct {
 	cfg             config.DynamicPriceGetterConfig
 	contractReaders map[uint64]types.ContractReader
 	aggregatorAbi   abi.ABI
+	now             func() time.Time
 }
 
 func NewDynamicPriceGetterConfig(configJson string) (config.DynamicPriceGetterConfig, error) {
@@ -82,7 +84,7 @@ func NewDynamicPriceGetter(cfg config.DynamicPriceGetterConfig, contractReaders
 	if err != nil {
 		return nil, fmt.Errorf("parsing offchainaggregator abi: %w", err)
 	}
-	priceGetter := DynamicPriceGetter{cfg, contractReaders, aggregatorAbi}
+	priceGetter := DynamicPriceGetter{cfg, contractReaders, aggregatorAbi, time.Now}
 	return &priceGetter, nil
 }
 
@@ -139,13 +141,23 @@ func (d *DynamicPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[
 
 // GetTokenPricesUSD returns the prices of the provided tokens in USD.
 func (d *DynamicPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
-	prices, batchCallsPerChain, err := d.preparePricesAndBatchCallsPerChain(tokens)
+	prices, batchCallsPerChain, multiSourceCfgs, err := d.preparePricesAndBatchCallsPerChain(tokens)
 	if err != nil {
 		return nil, err
 	}
-	if err = d.performBatchCalls(ctx, batchCallsPerChain, prices); err != nil {
+	observations := make(map[ccipcommon.TokenID][]sourceObservation, len(multiSourceCfgs))
+	if err = d.performBatchCalls(ctx, batchCallsPerChain, prices, observations); err != nil {
 		return nil, err
 	}
+
+	now := d.now()
+	for tk, cfg := range multiSourceCfgs {
+		price, err := aggregateMultiSource(tk, *cfg, observations[tk], now)
+		if err != nil {
+			return nil, err
+		}
+		prices[tk] = price
+	}
 	return prices, nil
 }
 
@@ -154,9 +166,10 @@ func (d *DynamicPriceGetter) performBatchCalls(
 	ctx context.Context,
 	batchCallsPerChain map[uint64]*batchCallsForChain,
 	prices map[ccipcommon.TokenID]*big.Int,
+	observations map[ccipcommon.TokenID][]sourceObservation,
 ) error {
 	for chainID, batchCalls := range batchCallsPerChain {
-		if err := d.performBatchCall(ctx, chainID, batchCalls, prices); err != nil {
+		if err := d.performBatchCall(ctx, chainID, batchCalls, prices, observations); err != nil {
 			return err
 		}
 	}
@@ -164,18 +177,35 @@ func (d *DynamicPriceGetter) performBatchCalls(
 }
 
 // performBatchCall performs a batch call on a given chain to retrieve token prices.
+// Results of multi source calls are collected in observations, a failed multi source call does not fail the batch.
 func (d *DynamicPriceGetter) performBatchCall(
 	ctx context.Context,
 	chainID uint64,
 	batchCalls *batchCallsForChain,
 	prices map[ccipcommon.TokenID]*big.Int,
+	observations map[ccipcommon.TokenID][]sourceObservation,
 ) (err error) {
-	nbDecimalCalls := len(batchCalls.decimalCalls)
-	nbLatestRoundDataCalls := len(batchCalls.decimalCalls)
 	nbCalls := len(batchCalls.decimalCalls)
 
+	// Chain level failures only fail the batch if a single aggregator token depends on it,
+	// multi source tokens record the failure and rely on their other sources.
+	failBatch := func(chainErr error) error {
+		for _, source := range batchCalls.sourceOrder {
+			if source == "" {
+				return chainErr
+			}
+		}
+		for i, tk := range batchCalls.tokenOrder {
+			observations[tk] = append(observations[tk], sourceObservation{source: batchCalls.sourceOrder[i], err: chainErr})
+		}
+		return nil
+	}
+
 	// Retrieve contract reader for the chain
-	contractReader := d.contractReaders[chainID]
+	contractReader, ok := d.contractReaders[chainID]
+	if !ok {
+		return failBatch(fmt.Errorf("no contract reader for chain %d", chainID))
+	}
 
 	// Bind contract reader to the contract addresses necessary for the batch calls
 	bindings := make([]types.BoundContract, 0)
@@ -188,7 +218,7 @@ func (d *DynamicPriceGetter) performBatchCall(
 
 	err = contractReader.Bind(ctx, bindings)
 	if err != nil {
-		return fmt.Errorf("binding contracts failed: %w", err)
+		return failBatch(fmt.Errorf("binding contracts failed: %w", err))
 	}
 
 	// Construct request, adding a decimals and latestRound req per contract name
@@ -219,14 +249,12 @@ func (d *DynamicPriceGetter) performBatchCall(
 	// Perform call
 	result, err2 := contractReader.BatchGetLatestValues(ctx, batchGetLatestValuesRequest)
 	if err2 != nil {
-		return fmt.Errorf("BatchGetLatestValues failed %w", err2)
+		return failBatch(fmt.Errorf("BatchGetLatestValues failed %w", err2))
 	}
 
 	// Extract results
 	// give result the contract name (key ordering not guaranteed to match that of the request)
 	// and then you get slice of responses
-	decimalsCR := make([]uint8, 0, nbDecimalCalls)
-	latestRoundCR := make([]aggregator_v3_interface.LatestRoundData, 0, nbDecimalCalls)
 	var respErr error
 	for j := range nbCalls {
 		boundContract := types.BoundContract{
@@ -235,10 +263,13 @@ func (d *DynamicPriceGetter) performBatchCall(
 		}
 		offchainAggregatorRespSlice := result[boundContract]
 
+		var decimals *uint8
+		var latestRoundData *aggregator_v3_interface.LatestRoundData
+		var callErr error
 		for _, read := range offchainAggregatorRespSlice {
 			val, readErr := read.GetResult()
 			if readErr != nil {
-				respErr = multierr.Append(respErr, fmt.Errorf("error with contract reader readName %v: %w", read.ReadName, readErr))
+				callErr = multierr.Append(callErr, fmt.Errorf("error with contract reader readName %v: %w", read.ReadName, readErr))
 				continue
 			}
 			if read.ReadName == DecimalsMethodName {
@@ -247,52 +278,94 @@ func (d *DynamicPriceGetter) performBatchCall(
 					return fmt.Errorf("expected type uint8 for method call %v on contract %v: %w", batchCalls.decimalCalls[j].MethodName(), batchCalls.decimalCalls[j].ContractAddress(), readErr)
 				}
 
-				decimalsCR = append(decimalsCR, *decimal)
+				decimals = decimal
 			} else if read.ReadName == LatestRoundDataMethodName {
 				latestRoundDataRes, ok := val.(*aggregator_v3_interface.LatestRoundData)
 				if !ok {
 					return fmt.Errorf("expected type latestRoundDataConfig for method call %v on contract %v: %w", batchCalls.latestRoundDataCalls[j].MethodName(), batchCalls.latestRoundDataCalls[j].ContractAddress(), readErr)
 				}
 
-				latestRoundCR = append(latestRoundCR, *latestRoundDataRes)
+				latestRoundData = latestRoundDataRes
 			}
 		}
-	}
-	if respErr != nil {
-		return respErr
-	}
+		if callErr == nil && (decimals == nil || latestRoundData == nil || latestRoundData.Answer == nil) {
+			callErr = fmt.Errorf("missing result for contract %v", batchCalls.decimalCalls[j].ContractAddress())
+		}
 
-	latestRoundAnswerCR := make([]*big.Int, 0, nbLatestRoundDataCalls)
-	for i := range nbLatestRoundDataCalls {
-		latestRoundAnswerCR = append(latestRoundAnswerCR, latestRoundCR[i].Answer)
-	}
+		tk := batchCalls.tokenOrder[j]
+		source := batchCalls.sourceOrder[j]
+		if source == "" {
+			if callErr != nil {
+				respErr = multierr.Append(respErr, callErr)
+				continue
+			}
+			prices[tk] = normalizePrice(latestRoundData.Answer, *decimals)
+			continue
+		}
 
-	// Normalize and store prices.
-	for i := range batchCalls.tokenOrder {
-		// Normalize to 1e18.
-		if decimalsCR[i] < 18 {
-			latestRoundAnswerCR[i].Mul(latestRoundAnswerCR[i], big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18-int64(decimalsCR[i])), nil))
-		} else if decimalsCR[i] > 18 {
-			latestRoundAnswerCR[i].Div(latestRoundAnswerCR[i], big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimalsCR[i])-18), nil))
+		obs := sourceObservation{source: source, err: callErr}
+		if callErr == nil {
+			obs.price = normalizePrice(latestRoundData.Answer, *decimals)
+			if latestRoundData.UpdatedAt != nil {
+				obs.updatedAt = time.Unix(latestRoundData.UpdatedAt.Int64(), 0)
+			}
 		}
-		prices[batchCalls.tokenOrder[i]] = latestRoundAnswerCR[i]
+		observations[tk] = append(observations[tk], obs)
 	}
-	return nil
+	return respErr
+}
+
+// normalizePrice normalizes an aggregator answer with the given decimals to 1e18.
+func normalizePrice(answer *big.Int, decimals uint8) *big.Int {
+	price := new(big.Int).Set(answer)
+	if decimals < 18 {
+		price.Mul(price, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18-int64(decimals)), nil))
+	} else if decimals > 18 {
+		price.Div(price, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)-18), nil))
+	}
+	return price
 }
 
 // preparePricesAndBatchCallsPerChain uses this price getter to prepare for a list of tokens:
 // - the map of token address to their prices (static prices)
 // - the map of and batch calls per chain for the given tokens (dynamic prices)
+// - the map of multi source tokens to their config, their prices are aggregated after the batch calls
 func (d *DynamicPriceGetter) preparePricesAndBatchCallsPerChain(
 	tokens []ccipcommon.TokenID,
-) (map[ccipcommon.TokenID]*big.Int, map[uint64]*batchCallsForChain, error) {
+) (map[ccipcommon.TokenID]*big.Int, map[uint64]*batchCallsForChain, map[ccipcommon.TokenID]*config.MultiSourcePriceConfig, error) {
 	prices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
 	batchCallsPerChain := make(map[uint64]*batchCallsForChain)
+	multiSourceCfgs := make(map[ccipcommon.TokenID]*config.MultiSourcePriceConfig)
+
+	addAggregatorCalls := func(tk ccipcommon.TokenID, source string, aggCfg config.AggregatorPriceConfig) {
+		// Batch calls for aggregator-based token prices (one per chain).
+		if _, exists := batchCallsPerChain[aggCfg.ChainID]; !exists {
+			batchCallsPerChain[aggCfg.ChainID] = &batchCallsForChain{
+				decimalCalls:         []rpclib.EvmCall{},
+				latestRoundDataCalls: []rpclib.EvmCall{},
+				tokenOrder:           []ccipcommon.TokenID{},
+				sourceOrder:          []string{},
+			}
+		}
+		chainCalls := batchCallsPerChain[aggCfg.ChainID]
+		chainCalls.decimalCalls = append(chainCalls.decimalCalls, rpclib.NewEvmCall(
+			d.aggregatorAbi,
+			DecimalsMethodName,
+			aggCfg.AggregatorContractAddress,
+		))
+		chainCalls.latestRoundDataCalls = append(chainCalls.latestRoundDataCalls, rpclib.NewEvmCall(
+			d.aggregatorAbi,
+			LatestRoundDataMethodName,
+			aggCfg.AggregatorContractAddress,
+		))
+		chainCalls.tokenOrder = append(chainCalls.tokenOrder, tk)
+		chainCalls.sourceOrder = append(chainCalls.sourceOrder, source)
+	}
 
 	for _, tk := range tokens {
 		tkAddr, err := ccipcalc.GenericAddrToEvm(tk.TokenAddress)
 		if err != nil {
-			return nil, nil, fmt.Errorf("converting token address %v to evm address: %w", tk, err)
+			return nil, nil, nil, fmt.Errorf("converting token address %v to evm address: %w", tk, err)
 		}
 
 		var priceCfg config.TokenPriceConfig
@@ -305,35 +378,20 @@ func (d *DynamicPriceGetter) preparePricesAndBatchCallsPerChain(
 
 		switch {
 		case priceCfg.AggregatorConfig != nil:
-			aggCfg := priceCfg.AggregatorConfig
-			// Batch calls for aggregator-based token prices (one per chain).
-			if _, exists := batchCallsPerChain[aggCfg.ChainID]; !exists {
-				batchCallsPerChain[aggCfg.ChainID] = &batchCallsForChain{
-					decimalCalls:         []rpclib.EvmCall{},
-					latestRoundDataCalls: []rpclib.EvmCall{},
-					tokenOrder:           []ccipcommon.TokenID{},
-				}
-			}
-			chainCalls := batchCallsPerChain[aggCfg.ChainID]
-			chainCalls.decimalCalls = append(chainCalls.decimalCalls, rpclib.NewEvmCall(
-				d.aggregatorAbi,
-				DecimalsMethodName,
-				aggCfg.AggregatorContractAddress,
-			))
-			chainCalls.latestRoundDataCalls = append(chainCalls.latestRoundDataCalls, rpclib.NewEvmCall(
-				d.aggregatorAbi,
-				LatestRoundDataMethodName,
-				aggCfg.AggregatorContractAddress,
-			))
-			chainCalls.tokenOrder = append(chainCalls.tokenOrder, tk)
+			addAggregatorCalls(tk, "", *priceCfg.AggregatorConfig)
 		case priceCfg.StaticConfig != nil:
 			staticCfg := priceCfg.StaticConfig
 			prices[tk] = staticCfg.Price
+		case priceCfg.MultiSourceConfig != nil:
+			for _, src := range priceCfg.MultiSourceConfig.Sources {
+				addAggregatorCalls(tk, src.Name, src.AggregatorPriceConfig)
+			}
+			multiSourceCfgs[tk] = priceCfg.MultiSourceConfig
 		default:
-			return nil, nil, fmt.Errorf("no price resolution rule for token %v", tk)
+			return nil, nil, nil, fmt.Errorf("no price resolution rule for token %v", tk)
 		}
 	}
-	return prices, batchCallsPerChain, nil
+	return prices, batchCallsPerChain, multiSourceCfgs, nil
 }
 
 // batchCallsForChain Defines the batch calls to perform on a given chain.
@@ -341,6 +399,7 @@ type batchCallsForChain struct {
 	decimalCalls         []rpclib.EvmCall
 	latestRoundDataCalls []rpclib.EvmCall
 	tokenOrder           []ccipcommon.TokenID // required to maintain the order of the batched rpc calls for mapping the results.
+	sourceOrder          []string             // name of the multi source price source of each call, empty for single aggregator tokens.
 }
 
 func (d *DynamicPriceGetter) Close() error {
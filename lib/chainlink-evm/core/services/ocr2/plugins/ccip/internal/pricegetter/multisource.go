@@ -0,0 +1,133 @@
+package pricegetter
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+const (
+	sourceResultOK       = "ok"
+	sourceResultError    = "error"
+	sourceResultStale    = "stale"
+	sourceResultOutlier  = "outlier"
+	sourceResultFallback = "fallback"
+)
+
+var (
+	priceSourceResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_getter_source_results",
+		Help: "Number of price reads per multi source token and source, by result (ok, error, stale, outlier, fallback)",
+	}, []string{"chainSelector", "token", "source", "result"})
+	priceSourceDeviation = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_price_getter_source_deviation_ppb",
+		Help: "Deviation of the last answer of a source from the aggregated median, in parts per billion",
+	}, []string{"chainSelector", "token", "source"})
+)
+
+var ppb = big.NewInt(1_000_000_000)
+
+// sourceObservation is the result of reading one source of a multi source token price.
+type sourceObservation struct {
+	source    string
+	price     *big.Int // normalized to 1e18
+	updatedAt time.Time
+	err       error
+}
+
+// aggregateMultiSource returns the median price of the healthy sources of a token.
+// Sources with errors or stale answers are discarded first, then sources deviating from the median of the remaining
+// ones by more than the configured threshold. The fallback price is used when too few sources are left.
+func aggregateMultiSource(
+	token ccipcommon.TokenID,
+	cfg config.MultiSourcePriceConfig,
+	observations []sourceObservation,
+	now time.Time,
+) (*big.Int, error) {
+	chainLabel := strconv.FormatUint(token.ChainSelector, 10)
+	tokenLabel := string(token.TokenAddress)
+	report := func(source, result string) {
+		priceSourceResults.WithLabelValues(chainLabel, tokenLabel, source, result).Inc()
+	}
+
+	fresh := make([]sourceObservation, 0, len(observations))
+	for _, obs := range observations {
+		switch {
+		case obs.err != nil || obs.price == nil:
+			report(obs.source, sourceResultError)
+		case cfg.MaxStalenessSeconds > 0 && now.Sub(obs.updatedAt) > time.Duration(cfg.MaxStalenessSeconds)*time.Second:
+			report(obs.source, sourceResultStale)
+		default:
+			fresh = append(fresh, obs)
+		}
+	}
+
+	healthy := fresh
+	if len(fresh) > 0 && cfg.MaxDeviationPPB > 0 {
+		median := medianPrice(fresh)
+		healthy = make([]sourceObservation, 0, len(fresh))
+		for _, obs := range fresh {
+			deviation := deviationPPB(median, obs.price)
+			deviationF, _ := new(big.Float).SetInt(deviation).Float64()
+			priceSourceDeviation.WithLabelValues(chainLabel, tokenLabel, obs.source).Set(deviationF)
+			if deviation.Cmp(new(big.Int).SetUint64(cfg.MaxDeviationPPB)) > 0 {
+				report(obs.source, sourceResultOutlier)
+				continue
+			}
+			healthy = append(healthy, obs)
+		}
+	}
+
+	minSources := max(cfg.MinSources, 1)
+	if len(healthy) < minSources {
+		if cfg.Fallback != nil {
+			report("", sourceResultFallback)
+			return new(big.Int).Set(cfg.Fallback.Price), nil
+		}
+		return nil, fmt.Errorf("token %v has %d healthy price sources out of %d, %d required",
+			token, len(healthy), len(observations), minSources)
+	}
+
+	for _, obs := range healthy {
+		report(obs.source, sourceResultOK)
+	}
+	return medianPrice(healthy), nil
+}
+
+// medianPrice returns the median of the observation prices, the mean of the two middle values for an even count.
+func medianPrice(observations []sourceObservation) *big.Int {
+	prices := make([]*big.Int, len(observations))
+	for i, obs := range observations {
+		prices[i] = obs.price
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return new(big.Int).Set(prices[mid])
+	}
+	sum := new(big.Int).Add(prices[mid-1], prices[mid])
+	return sum.Div(sum, big.NewInt(2))
+}
+
+// deviationPPB returns |price - reference| / reference in parts per billion.
+func deviationPPB(reference, price *big.Int) *big.Int {
+	if reference.Sign() == 0 {
+		if price.Sign() == 0 {
+			return big.NewInt(0)
+		}
+		return new(big.Int).Set(ppb)
+	}
+	diff := new(big.Int).Sub(price, reference)
+	diff.Abs(diff)
+	diff.Mul(diff, ppb)
+	return diff.Div(diff, new(big.Int).Abs(reference))
+}
@@ -0,0 +1,192 @@
+package pricegetter
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestAggregateMultiSource(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	token := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), ChainSelector: 1}
+	obs := func(source string, price int64, age time.Duration) sourceObservation {
+		return sourceObservation{source: source, price: big.NewInt(price), updatedAt: now.Add(-age)}
+	}
+
+	testCases := []struct {
+		name         string
+		cfg          config.MultiSourcePriceConfig
+		observations []sourceObservation
+		expPrice     int64
+		expErr       bool
+	}{
+		{
+			name:         "median of odd count",
+			cfg:          config.MultiSourcePriceConfig{},
+			observations: []sourceObservation{obs("a", 300, 0), obs("b", 100, 0), obs("c", 200, 0)},
+			expPrice:     200,
+		},
+		{
+			name:         "median of even count",
+			cfg:          config.MultiSourcePriceConfig{},
+			observations: []sourceObservation{obs("a", 100, 0), obs("b", 200, 0)},
+			expPrice:     150,
+		},
+		{
+			name: "failed and stale sources are discarded",
+			cfg:  config.MultiSourcePriceConfig{MaxStalenessSeconds: 60},
+			observations: []sourceObservation{
+				obs("a", 100, 0),
+				obs("b", 1_000, 2*time.Minute),
+				{source: "c", err: assert.AnError},
+			},
+			expPrice: 100,
+		},
+		{
+			name: "outliers are discarded",
+			cfg:  config.MultiSourcePriceConfig{MaxDeviationPPB: 100_000_000}, // 10%
+			observations: []sourceObservation{
+				obs("a", 1_000, 0),
+				obs("b", 1_020, 0),
+				obs("c", 1_010, 0),
+				obs("d", 2_000, 0),
+			},
+			expPrice: 1_010,
+		},
+		{
+			name:         "quorum not reached",
+			cfg:          config.MultiSourcePriceConfig{MinSources: 2, MaxStalenessSeconds: 60},
+			observations: []sourceObservation{obs("a", 100, 0), obs("b", 100, time.Hour)},
+			expErr:       true,
+		},
+		{
+			name: "quorum not reached with fallback",
+			cfg: config.MultiSourcePriceConfig{
+				MinSources: 2,
+				Fallback:   &config.StaticPriceConfig{Price: big.NewInt(42)},
+			},
+			observations: []sourceObservation{obs("a", 100, 0), {source: "b", err: assert.AnError}},
+			expPrice:     42,
+		},
+		{
+			name:         "no observations",
+			cfg:          config.MultiSourcePriceConfig{},
+			observations: nil,
+			expErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := aggregateMultiSource(token, tc.cfg, tc.observations, now)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(tc.expPrice), price)
+		})
+	}
+}
+
+func TestDeviationPPB(t *testing.T) {
+	assert.Equal(t, big.NewInt(0), deviationPPB(big.NewInt(100), big.NewInt(100)))
+	assert.Equal(t, big.NewInt(100_000_000), deviationPPB(big.NewInt(100), big.NewInt(110)))
+	assert.Equal(t, big.NewInt(100_000_000), deviationPPB(big.NewInt(100), big.NewInt(90)))
+	assert.Equal(t, big.NewInt(1_000_000_000), deviationPPB(big.NewInt(0), big.NewInt(1)))
+}
+
+func TestDynamicPriceGetterMultiSource(t *testing.T) {
+	ctx := testutils.Context(t)
+	now := time.Unix(1_700_000_000, 0)
+	token := utils.RandomAddress()
+	aggA, aggB, aggC := utils.RandomAddress(), utils.RandomAddress(), utils.RandomAddress()
+
+	cfg := config.DynamicPriceGetterConfig{
+		TokenPrices: []config.TokenPriceConfig{
+			{
+				TokenAddress:  token,
+				ChainSelector: 10,
+				MultiSourceConfig: &config.MultiSourcePriceConfig{
+					Sources: []config.PriceSourceConfig{
+						{Name: "a", AggregatorPriceConfig: config.AggregatorPriceConfig{ChainID: 101, AggregatorContractAddress: aggA}},
+						{Name: "b", AggregatorPriceConfig: config.AggregatorPriceConfig{ChainID: 101, AggregatorContractAddress: aggB}},
+						{Name: "c", AggregatorPriceConfig: config.AggregatorPriceConfig{ChainID: 102, AggregatorContractAddress: aggC}},
+					},
+					MaxStalenessSeconds: 3600,
+					MaxDeviationPPB:     50_000_000,
+					MinSources:          2,
+				},
+			},
+		},
+	}
+
+	round := func(answer int64, updatedAt time.Time) aggregator_v3_interface.LatestRoundData {
+		return aggregator_v3_interface.LatestRoundData{
+			RoundId:         big.NewInt(1),
+			Answer:          big.NewInt(answer),
+			StartedAt:       big.NewInt(updatedAt.Unix()),
+			UpdatedAt:       big.NewInt(updatedAt.Unix()),
+			AnsweredInRound: big.NewInt(1),
+		}
+	}
+
+	contractReaders := map[uint64]types.ContractReader{
+		// 8 decimals
+		101: multiSourceMockCR(t, []common.Address{aggA, aggB}, []uint8{8, 8}, []aggregator_v3_interface.LatestRoundData{
+			round(2_000_00000000, now.Add(-time.Minute)),
+			round(2_010_00000000, now.Add(-time.Minute)),
+		}),
+		// second chain is down
+		102: mockErrCR(),
+	}
+
+	pg, err := NewDynamicPriceGetter(cfg, contractReaders)
+	require.NoError(t, err)
+	pg.now = func() time.Time { return now }
+
+	tokenID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(token), ChainSelector: 10}
+	prices, err := pg.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{tokenID})
+	require.NoError(t, err)
+	assert.Equal(t, multExp(big.NewInt(2_005), 18), prices[tokenID])
+
+	// one of the two remaining sources goes stale, leaving fewer healthy sources than MinSources
+	contractReaders[101] = multiSourceMockCR(t, []common.Address{aggA, aggB}, []uint8{8, 8}, []aggregator_v3_interface.LatestRoundData{
+		round(2_000_00000000, now.Add(-2*time.Hour)),
+		round(2_010_00000000, now.Add(-time.Minute)),
+	})
+	_, err = pg.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{tokenID})
+	require.ErrorContains(t, err, "healthy price sources")
+}
+
+func multiSourceMockCR(t *testing.T, aggregators []common.Address, decimals []uint8, rounds []aggregator_v3_interface.LatestRoundData) *mockContractReader {
+	require.Len(t, decimals, len(aggregators))
+	require.Len(t, rounds, len(aggregators))
+
+	result := make(types.BatchGetLatestValuesResult)
+	for i, agg := range aggregators {
+		boundContract := types.BoundContract{
+			Address: agg.Hex(),
+			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, i),
+		}
+		decimalsRes := types.BatchReadResult{ReadName: DecimalsMethodName}
+		decimalsRes.SetResult(&decimals[i], nil)
+		roundRes := types.BatchReadResult{ReadName: LatestRoundDataMethodName}
+		roundRes.SetResult(&rounds[i], nil)
+		result[boundContract] = types.ContractBatchResults{decimalsRes, roundRes}
+	}
+	return &mockContractReader{result: result}
+}
@@ -3,6 +3,7 @@ package core
 import (
 	ac "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/automation_compatible_utils"
 	autov2common "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/i_automation_v21_plus_common"
+	iregistry21 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/i_log_automation"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/streams_lookup_compatible_interface"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
@@ -12,3 +13,4 @@ var CompatibleUtilsABI = types.MustGetABI(ac.AutomationCompatibleUtilsABI)
 var AutoV2CommonABI = types.MustGetABI(autov2common.IAutomationV21PlusCommonABI)
 var StreamsCompatibleABI = types.MustGetABI(streams_lookup_compatible_interface.StreamsLookupCompatibleInterfaceABI)
 var ILogAutomationABI = types.MustGetABI(i_log_automation.ILogAutomationABI)
+var RegistryMasterV21ABI = types.MustGetABI(iregistry21.IKeeperRegistryMasterABI)
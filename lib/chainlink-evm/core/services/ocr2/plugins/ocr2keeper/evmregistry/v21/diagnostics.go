@@ -0,0 +1,353 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+)
+
+var (
+	ErrDiagnosisInvalidUpkeepID = errors.New("invalid upkeep ID")
+	ErrDiagnosisMissingLog      = errors.New("log trigger upkeeps are diagnosed for a triggering log, tx hash is required")
+
+	// lowBalanceThresholdPercent is how much an upkeep's balance may exceed the min balance before it is reported low
+	lowBalanceThresholdPercent = big.NewInt(5)
+)
+
+// DiagnosisStatus is the outcome of a step of an upkeep diagnosis.
+type DiagnosisStatus string
+
+const (
+	DiagnosisPassed  DiagnosisStatus = "passed"
+	DiagnosisWarning DiagnosisStatus = "warning"
+	DiagnosisFailed  DiagnosisStatus = "failed"
+)
+
+// Steps of an upkeep diagnosis, in the order they run.
+const (
+	DiagnosisStepRegistry       = "registry"
+	DiagnosisStepBalance        = "balance"
+	DiagnosisStepCheckBlock     = "check_block"
+	DiagnosisStepTriggerConfig  = "trigger_config"
+	DiagnosisStepLog            = "log"
+	DiagnosisStepUpkeepState    = "upkeep_state"
+	DiagnosisStepCheckUpkeep    = "check_upkeep"
+	DiagnosisStepStreamsLookup  = "streams_lookup"
+	DiagnosisStepOffchainConfig = "offchain_config"
+	DiagnosisStepSimulate       = "simulate_perform"
+)
+
+// DiagnosisStep is a single step of an upkeep diagnosis.
+type DiagnosisStep struct {
+	Name    string
+	Status  DiagnosisStatus
+	Message string
+}
+
+// DiagnoseOptions are the options of an upkeep diagnosis.
+type DiagnoseOptions struct {
+	// CheckBlock is the block conditional upkeeps are checked at, the latest block when zero.
+	// Log trigger upkeeps are checked at the block of the triggering log.
+	CheckBlock int64
+	// TxHash and LogIndex identify the triggering log of log trigger upkeeps
+	TxHash   common.Hash
+	LogIndex int64
+}
+
+// UpkeepDiagnosis explains why an upkeep is or isn't eligible to be performed by the node.
+type UpkeepDiagnosis struct {
+	UpkeepID    *big.Int
+	TriggerType string
+	CheckBlock  int64
+	WorkID      string
+	Eligible    bool
+	// Reason is set to the message of the failed step when the upkeep is not eligible
+	Reason      string
+	PerformData []byte
+	Steps       []DiagnosisStep
+}
+
+func (d *UpkeepDiagnosis) pass(step, format string, args ...any) {
+	d.Steps = append(d.Steps, DiagnosisStep{Name: step, Status: DiagnosisPassed, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *UpkeepDiagnosis) warn(step, format string, args ...any) {
+	d.Steps = append(d.Steps, DiagnosisStep{Name: step, Status: DiagnosisWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *UpkeepDiagnosis) fail(step, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	d.Steps = append(d.Steps, DiagnosisStep{Name: step, Status: DiagnosisFailed, Message: msg})
+	d.Eligible = false
+	d.Reason = msg
+}
+
+// UpkeepDiagnoser diagnoses upkeeps with the node's own registry, chain clients and upkeep state, the same way the
+// automation plugin checks them.
+type UpkeepDiagnoser interface {
+	// DiagnoseUpkeep runs the registry, balance, check, streams lookup, offchain config and perform simulation steps for
+	// the upkeep, stopping at the first failed step.
+	DiagnoseUpkeep(ctx context.Context, upkeepID *big.Int, opts DiagnoseOptions) (UpkeepDiagnosis, error)
+}
+
+var _ UpkeepDiagnoser = &EvmRegistry{}
+
+func (r *EvmRegistry) DiagnoseUpkeep(ctx context.Context, upkeepID *big.Int, opts DiagnoseOptions) (UpkeepDiagnosis, error) {
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	if upkeepID == nil || !uid.FromBigInt(upkeepID) {
+		return UpkeepDiagnosis{}, fmt.Errorf("%w: %s", ErrDiagnosisInvalidUpkeepID, upkeepID)
+	}
+
+	d := &UpkeepDiagnosis{UpkeepID: upkeepID}
+	switch core.GetUpkeepType(*uid) {
+	case types.ConditionTrigger:
+		d.TriggerType = "conditional"
+	case types.LogTrigger:
+		if opts.TxHash == (common.Hash{}) {
+			return UpkeepDiagnosis{}, ErrDiagnosisMissingLog
+		}
+		d.TriggerType = "log"
+	default:
+		return UpkeepDiagnosis{}, fmt.Errorf("%w: unknown trigger type of %s", ErrDiagnosisInvalidUpkeepID, upkeepID)
+	}
+
+	r.diagnose(ctx, d, *uid, opts)
+	return *d, nil
+}
+
+func (r *EvmRegistry) diagnose(ctx context.Context, d *UpkeepDiagnosis, uid ocr2keepers.UpkeepIdentifier, opts DiagnoseOptions) {
+	upkeepID := d.UpkeepID
+	latestOpts := r.buildCallOpts(ctx, nil)
+
+	info, err := r.registry.GetUpkeep(latestOpts, upkeepID)
+	if err != nil {
+		d.fail(DiagnosisStepRegistry, "failed to get upkeep info: %s", err)
+		return
+	}
+	if (info.Target == common.Address{}) {
+		d.fail(DiagnosisStepRegistry, "upkeep does not exist on registry %s", r.addr.Hex())
+		return
+	}
+	if info.Paused {
+		d.fail(DiagnosisStepRegistry, "upkeep is paused")
+		return
+	}
+	if info.MaxValidBlocknumber != math.MaxUint32 {
+		d.fail(DiagnosisStepRegistry, "upkeep is cancelled")
+		return
+	}
+	d.pass(DiagnosisStepRegistry, "upkeep is active, target %s, perform gas %d", info.Target.Hex(), info.PerformGas)
+
+	minBalance, err := r.registry.GetMinBalance(latestOpts, upkeepID)
+	if err != nil {
+		d.fail(DiagnosisStepBalance, "failed to get min balance: %s", err)
+		return
+	}
+	if info.Balance.Cmp(minBalance) < 0 {
+		d.fail(DiagnosisStepBalance, "balance %s is lower than the min balance %s", info.Balance, minBalance)
+		return
+	}
+	// warn when the balance exceeds the min balance by less than lowBalanceThresholdPercent
+	excess := new(big.Int).Mul(new(big.Int).Sub(info.Balance, minBalance), big.NewInt(100))
+	if excess.Cmp(new(big.Int).Mul(minBalance, lowBalanceThresholdPercent)) < 0 {
+		d.warn(DiagnosisStepBalance, "balance %s is less than %s%% higher than the min balance %s", info.Balance, lowBalanceThresholdPercent, minBalance)
+	} else {
+		d.pass(DiagnosisStepBalance, "balance %s is higher than the min balance %s", info.Balance, minBalance)
+	}
+
+	var payload ocr2keepers.UpkeepPayload
+	var ok bool
+	if d.TriggerType == "log" {
+		payload, ok = r.diagnoseLogPayload(ctx, d, upkeepID, opts)
+	} else {
+		payload, ok = r.diagnoseConditionalPayload(ctx, d, upkeepID, opts)
+	}
+	if !ok {
+		return
+	}
+	d.CheckBlock = int64(payload.Trigger.BlockNumber)
+	d.WorkID = core.UpkeepWorkID(uid, payload.Trigger)
+
+	if d.TriggerType == "log" && r.states != nil {
+		states, err := r.states.SelectByWorkIDs(ctx, d.WorkID)
+		switch {
+		case err != nil:
+			d.warn(DiagnosisStepUpkeepState, "failed to read the upkeep state store: %s", err)
+		case len(states) > 0 && states[0] == ocr2keepers.Performed:
+			d.fail(DiagnosisStepUpkeepState, "work %s was already performed", d.WorkID)
+			return
+		case len(states) > 0 && states[0] == ocr2keepers.Ineligible:
+			d.warn(DiagnosisStepUpkeepState, "work %s was already found ineligible, the node won't check it again", d.WorkID)
+		default:
+			d.pass(DiagnosisStepUpkeepState, "work %s was not performed yet", d.WorkID)
+		}
+	}
+
+	results, err := r.checkUpkeeps(ctx, []ocr2keepers.UpkeepPayload{payload})
+	if err != nil {
+		d.fail(DiagnosisStepCheckUpkeep, "failed to check upkeep: %s", err)
+		return
+	}
+	if results[0].IneligibilityReason == uint8(encoding.UpkeepFailureReasonTargetCheckReverted) {
+		results = r.streams.Lookup(ctx, results)
+		if reason := encoding.UpkeepFailureReason(results[0].IneligibilityReason); reason != encoding.UpkeepFailureReasonTargetCheckReverted {
+			// the revert was a streams lookup
+			if state := encoding.PipelineExecutionState(results[0].PipelineExecutionState); state != encoding.NoPipelineError {
+				d.fail(DiagnosisStepStreamsLookup, "streams lookup failed with %s", state)
+				return
+			}
+			if reason != encoding.UpkeepFailureReasonNone {
+				d.fail(DiagnosisStepStreamsLookup, "streams lookup failed with %s", reason)
+				return
+			}
+			d.pass(DiagnosisStepStreamsLookup, "streams lookup and checkCallback succeeded")
+		}
+	}
+	cr := results[0]
+	if state := encoding.PipelineExecutionState(cr.PipelineExecutionState); state != encoding.NoPipelineError {
+		d.fail(DiagnosisStepCheckUpkeep, "checkUpkeep failed with %s, retryable: %t", state, cr.Retryable)
+		return
+	}
+	if !cr.Eligible {
+		d.fail(DiagnosisStepCheckUpkeep, "checkUpkeep returned %s", encoding.UpkeepFailureReason(cr.IneligibilityReason))
+		return
+	}
+	d.PerformData = cr.PerformData
+	d.pass(DiagnosisStepCheckUpkeep, "upkeep is needed")
+
+	if len(info.OffchainConfig) == 0 {
+		d.pass(DiagnosisStepOffchainConfig, "upkeep has no offchain config")
+	} else {
+		if fr := r.checkOffchainConfig(ctx, upkeepID, info.OffchainConfig, cr); fr != encoding.UpkeepFailureReasonNone {
+			d.fail(DiagnosisStepOffchainConfig, "upkeep is not eligible due to its offchain config: %s", fr)
+			return
+		}
+		d.pass(DiagnosisStepOffchainConfig, "gas price and policies of the offchain config are met")
+	}
+
+	// the offchain config was checked above, simulatePerformUpkeeps would count it in the pipeline's metrics
+	results, err = r.simulatePerforms(ctx, results)
+	if err != nil {
+		d.fail(DiagnosisStepSimulate, "failed to simulate perform upkeep: %s", err)
+		return
+	}
+	cr = results[0]
+	if state := encoding.PipelineExecutionState(cr.PipelineExecutionState); state != encoding.NoPipelineError {
+		d.fail(DiagnosisStepSimulate, "simulatePerformUpkeep failed with %s, retryable: %t", state, cr.Retryable)
+		return
+	}
+	if !cr.Eligible {
+		d.fail(DiagnosisStepSimulate, "simulatePerformUpkeep returned %s, check the perform gas limit %d", encoding.UpkeepFailureReason(cr.IneligibilityReason), info.PerformGas)
+		return
+	}
+	d.pass(DiagnosisStepSimulate, "perform upkeep simulation succeeded")
+	d.Eligible = true
+}
+
+// diagnoseConditionalPayload builds the payload of a conditional upkeep at the check block of the options
+func (r *EvmRegistry) diagnoseConditionalPayload(ctx context.Context, d *UpkeepDiagnosis, upkeepID *big.Int, opts DiagnoseOptions) (ocr2keepers.UpkeepPayload, bool) {
+	var trigger ocr2keepers.Trigger
+	if opts.CheckBlock == 0 {
+		latest := r.bs.latestBlock.Load()
+		if latest == nil {
+			d.fail(DiagnosisStepCheckBlock, "no latest block available")
+			return ocr2keepers.UpkeepPayload{}, false
+		}
+		trigger = ocr2keepers.NewTrigger(latest.Number, latest.Hash)
+	} else {
+		hash, err := r.getBlockHash(ctx, big.NewInt(opts.CheckBlock))
+		if err != nil {
+			d.fail(DiagnosisStepCheckBlock, "failed to get the hash of block %d: %s", opts.CheckBlock, err)
+			return ocr2keepers.UpkeepPayload{}, false
+		}
+		trigger = ocr2keepers.NewTrigger(ocr2keepers.BlockNumber(opts.CheckBlock), hash)
+	}
+
+	payload, err := core.NewUpkeepPayload(upkeepID, trigger, nil)
+	if err != nil {
+		d.fail(DiagnosisStepCheckBlock, "failed to build payload: %s", err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	d.pass(DiagnosisStepCheckBlock, "checking at block %d", trigger.BlockNumber)
+	return payload, true
+}
+
+// diagnoseLogPayload builds the payload of a log trigger upkeep for the triggering log of the options, verifying the log
+// was picked up by the log poller and matches the upkeep's trigger config
+func (r *EvmRegistry) diagnoseLogPayload(ctx context.Context, d *UpkeepDiagnosis, upkeepID *big.Int, opts DiagnoseOptions) (ocr2keepers.UpkeepPayload, bool) {
+	raw, err := r.fetchTriggerConfig(ctx, upkeepID)
+	if err != nil {
+		d.fail(DiagnosisStepTriggerConfig, "failed to get trigger config: %s", err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	parsed, err := r.packer.UnpackLogTriggerConfig(raw)
+	if err != nil {
+		d.fail(DiagnosisStepTriggerConfig, "failed to unpack trigger config: %s", err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	cfg := logprovider.LogTriggerConfig(parsed)
+	if cfg.FilterSelector > 7 {
+		d.fail(DiagnosisStepTriggerConfig, "invalid filter selector %d", cfg.FilterSelector)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	d.pass(DiagnosisStepTriggerConfig, "listening to %s logs of %s", common.Hash(cfg.Topic0).Hex(), cfg.ContractAddress.Hex())
+
+	blockNumber, blockHash, err := core.GetTxBlock(ctx, r.client, opts.TxHash)
+	if err != nil || blockNumber == nil {
+		d.fail(DiagnosisStepLog, "tx %s was not found on chain: %v", opts.TxHash.Hex(), err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	logs, err := r.poller.LogsWithSigs(ctx, blockNumber.Int64(), blockNumber.Int64(), []common.Hash{cfg.Topic0}, cfg.ContractAddress)
+	if err != nil {
+		d.fail(DiagnosisStepLog, "failed to read logs of block %d: %s", blockNumber, err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	var log *logpoller.Log
+	for i := range logs {
+		if logs[i].TxHash == opts.TxHash && logs[i].LogIndex == opts.LogIndex && logs[i].BlockHash == blockHash {
+			log = &logs[i]
+			break
+		}
+	}
+	if log == nil {
+		d.fail(DiagnosisStepLog, "log %d of tx %s was not found by the log poller for the upkeep's trigger config", opts.LogIndex, opts.TxHash.Hex())
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	if !logprovider.MatchesTriggerConfig(*log, cfg) {
+		d.fail(DiagnosisStepLog, "log %d of tx %s does not match the topics of the upkeep's trigger config", opts.LogIndex, opts.TxHash.Hex())
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+
+	checkData, err := logprovider.NewLogEventsPacker().PackLogData(*log)
+	if err != nil {
+		d.fail(DiagnosisStepLog, "failed to pack log data: %s", err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	trigger := ocr2keepers.NewTrigger(ocr2keepers.BlockNumber(log.BlockNumber), log.BlockHash)
+	trigger.LogTriggerExtension = &ocr2keepers.LogTriggerExtension{
+		TxHash:      log.TxHash,
+		Index:       uint32(log.LogIndex),
+		BlockHash:   log.BlockHash,
+		BlockNumber: ocr2keepers.BlockNumber(log.BlockNumber),
+	}
+	payload, err := core.NewUpkeepPayload(upkeepID, trigger, checkData)
+	if err != nil {
+		d.fail(DiagnosisStepLog, "failed to build payload: %s", err)
+		return ocr2keepers.UpkeepPayload{}, false
+	}
+	d.pass(DiagnosisStepLog, "log %d of tx %s in block %d matches the trigger config", opts.LogIndex, opts.TxHash.Hex(), log.BlockNumber)
+	return payload, true
+}
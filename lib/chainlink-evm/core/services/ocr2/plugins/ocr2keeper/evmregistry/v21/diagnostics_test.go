@@ -0,0 +1,121 @@
+package evm
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	autotypes "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/mocks"
+)
+
+func TestRegistry_DiagnoseUpkeep(t *testing.T) {
+	conditionalID := core.GenUpkeepID(autotypes.ConditionTrigger, "1111").BigInt()
+	logID := core.GenUpkeepID(autotypes.LogTrigger, "2222").BigInt()
+	target := common.HexToAddress("0x1")
+	active := encoding.UpkeepInfo{Target: target, Balance: big.NewInt(1000), MaxValidBlocknumber: math.MaxUint32}
+
+	t.Run("invalid options", func(t *testing.T) {
+		r := setupEVMRegistry(t)
+		_, err := r.DiagnoseUpkeep(testutils.Context(t), big.NewInt(-1), DiagnoseOptions{})
+		require.ErrorIs(t, err, ErrDiagnosisInvalidUpkeepID)
+		_, err = r.DiagnoseUpkeep(testutils.Context(t), logID, DiagnoseOptions{})
+		require.ErrorIs(t, err, ErrDiagnosisMissingLog)
+	})
+
+	tests := []struct {
+		name          string
+		upkeepInfo    encoding.UpkeepInfo
+		upkeepInfoErr error
+		minBalance    *big.Int
+		steps         []DiagnosisStatus
+		failedStep    string
+		reason        string
+	}{
+		{
+			name:          "fail to get upkeep",
+			upkeepInfoErr: errors.New("rpc error"),
+			steps:         []DiagnosisStatus{DiagnosisFailed},
+			failedStep:    DiagnosisStepRegistry,
+			reason:        "failed to get upkeep info: rpc error",
+		},
+		{
+			name:       "upkeep does not exist",
+			upkeepInfo: encoding.UpkeepInfo{},
+			steps:      []DiagnosisStatus{DiagnosisFailed},
+			failedStep: DiagnosisStepRegistry,
+			reason:     "upkeep does not exist on registry 0x6cA639822c6C241Fa9A7A6b5032F6F7F1C513CAD",
+		},
+		{
+			name:       "paused upkeep",
+			upkeepInfo: encoding.UpkeepInfo{Target: target, Paused: true, MaxValidBlocknumber: math.MaxUint32},
+			steps:      []DiagnosisStatus{DiagnosisFailed},
+			failedStep: DiagnosisStepRegistry,
+			reason:     "upkeep is paused",
+		},
+		{
+			name:       "cancelled upkeep",
+			upkeepInfo: encoding.UpkeepInfo{Target: target, MaxValidBlocknumber: 100},
+			steps:      []DiagnosisStatus{DiagnosisFailed},
+			failedStep: DiagnosisStepRegistry,
+			reason:     "upkeep is cancelled",
+		},
+		{
+			name:       "underfunded upkeep",
+			upkeepInfo: active,
+			minBalance: big.NewInt(2000),
+			steps:      []DiagnosisStatus{DiagnosisPassed, DiagnosisFailed},
+			failedStep: DiagnosisStepBalance,
+			reason:     "balance 1000 is lower than the min balance 2000",
+		},
+		{
+			name:       "low balance and no latest block",
+			upkeepInfo: active,
+			minBalance: big.NewInt(990),
+			steps:      []DiagnosisStatus{DiagnosisPassed, DiagnosisWarning, DiagnosisFailed},
+			failedStep: DiagnosisStepCheckBlock,
+			reason:     "no latest block available",
+		},
+		{
+			name:       "no latest block",
+			upkeepInfo: active,
+			minBalance: big.NewInt(100),
+			steps:      []DiagnosisStatus{DiagnosisPassed, DiagnosisPassed, DiagnosisFailed},
+			failedStep: DiagnosisStepCheckBlock,
+			reason:     "no latest block available",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := setupEVMRegistry(t)
+			mockReg := mocks.NewRegistry(t)
+			mockReg.On("GetUpkeep", mock.Anything, conditionalID).Return(tc.upkeepInfo, tc.upkeepInfoErr).Once()
+			if tc.minBalance != nil {
+				mockReg.On("GetMinBalance", mock.Anything, conditionalID).Return(tc.minBalance, nil).Once()
+			}
+			r.registry = mockReg
+
+			d, err := r.DiagnoseUpkeep(testutils.Context(t), conditionalID, DiagnoseOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, "conditional", d.TriggerType)
+			assert.False(t, d.Eligible)
+			assert.Equal(t, tc.reason, d.Reason)
+
+			require.Len(t, d.Steps, len(tc.steps))
+			for i, status := range tc.steps {
+				assert.Equal(t, status, d.Steps[i].Status, d.Steps[i].Name)
+			}
+			assert.Equal(t, tc.failedStep, d.Steps[len(d.Steps)-1].Name)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package encoding
 
 import (
+	"fmt"
 	"net/http"
 
 	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
@@ -26,12 +27,16 @@ const (
 	UpkeepFailureReasonRegistryPaused          UpkeepFailureReason = 9
 	// leaving a gap here for more onchain failure reasons in the future
 	// upkeep failure offchain reasons
-	UpkeepFailureReasonMercuryAccessNotAllowed UpkeepFailureReason = 32
-	UpkeepFailureReasonTxHashNoLongerExists    UpkeepFailureReason = 33
-	UpkeepFailureReasonInvalidRevertDataInput  UpkeepFailureReason = 34
-	UpkeepFailureReasonSimulationFailed        UpkeepFailureReason = 35
-	UpkeepFailureReasonTxHashReorged           UpkeepFailureReason = 36
-	UpkeepFailureReasonGasPriceTooHigh         UpkeepFailureReason = 37
+	UpkeepFailureReasonMercuryAccessNotAllowed    UpkeepFailureReason = 32
+	UpkeepFailureReasonTxHashNoLongerExists       UpkeepFailureReason = 33
+	UpkeepFailureReasonInvalidRevertDataInput     UpkeepFailureReason = 34
+	UpkeepFailureReasonSimulationFailed           UpkeepFailureReason = 35
+	UpkeepFailureReasonTxHashReorged              UpkeepFailureReason = 36
+	UpkeepFailureReasonGasPriceTooHigh            UpkeepFailureReason = 37
+	UpkeepFailureReasonOutsideExecutionWindow     UpkeepFailureReason = 38
+	UpkeepFailureReasonPerformIntervalNotElapsed  UpkeepFailureReason = 39
+	UpkeepFailureReasonDailyPerformBudgetExceeded UpkeepFailureReason = 40
+	UpkeepFailureReasonL1DataFeeTooHigh           UpkeepFailureReason = 41
 
 	// pipeline execution error
 	NoPipelineError               PipelineExecutionState = 0
@@ -43,6 +48,53 @@ const (
 	PrivilegeConfigUnmarshalError PipelineExecutionState = 6
 )
 
+var upkeepFailureReasonNames = map[UpkeepFailureReason]string{
+	UpkeepFailureReasonNone:                       "NONE",
+	UpkeepFailureReasonUpkeepCancelled:            "UPKEEP_CANCELLED",
+	UpkeepFailureReasonUpkeepPaused:               "UPKEEP_PAUSED",
+	UpkeepFailureReasonTargetCheckReverted:        "TARGET_CHECK_REVERTED",
+	UpkeepFailureReasonUpkeepNotNeeded:            "UPKEEP_NOT_NEEDED",
+	UpkeepFailureReasonPerformDataExceedsLimit:    "PERFORM_DATA_EXCEEDS_LIMIT",
+	UpkeepFailureReasonInsufficientBalance:        "INSUFFICIENT_BALANCE",
+	UpkeepFailureReasonMercuryCallbackReverted:    "CALLBACK_REVERTED",
+	UpkeepFailureReasonRevertDataExceedsLimit:     "REVERT_DATA_EXCEEDS_LIMIT",
+	UpkeepFailureReasonRegistryPaused:             "REGISTRY_PAUSED",
+	UpkeepFailureReasonMercuryAccessNotAllowed:    "MERCURY_ACCESS_NOT_ALLOWED",
+	UpkeepFailureReasonTxHashNoLongerExists:       "TX_HASH_NO_LONGER_EXISTS",
+	UpkeepFailureReasonInvalidRevertDataInput:     "INVALID_REVERT_DATA_INPUT",
+	UpkeepFailureReasonSimulationFailed:           "SIMULATION_FAILED",
+	UpkeepFailureReasonTxHashReorged:              "TX_HASH_REORGED",
+	UpkeepFailureReasonGasPriceTooHigh:            "GAS_PRICE_TOO_HIGH",
+	UpkeepFailureReasonOutsideExecutionWindow:     "OUTSIDE_EXECUTION_WINDOW",
+	UpkeepFailureReasonPerformIntervalNotElapsed:  "PERFORM_INTERVAL_NOT_ELAPSED",
+	UpkeepFailureReasonDailyPerformBudgetExceeded: "DAILY_PERFORM_BUDGET_EXCEEDED",
+	UpkeepFailureReasonL1DataFeeTooHigh:           "L1_DATA_FEE_TOO_HIGH",
+}
+
+func (r UpkeepFailureReason) String() string {
+	if name, ok := upkeepFailureReasonNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(r))
+}
+
+var pipelineExecutionStateNames = map[PipelineExecutionState]string{
+	NoPipelineError:               "NO_PIPELINE_ERROR",
+	CheckBlockTooOld:              "CHECK_BLOCK_TOO_OLD",
+	CheckBlockInvalid:             "CHECK_BLOCK_INVALID",
+	RpcFlakyFailure:               "RPC_FLAKY_FAILURE",
+	MercuryFlakyFailure:           "MERCURY_FLAKY_FAILURE",
+	PackUnpackDecodeFailed:        "PACK_UNPACK_DECODE_FAILED",
+	PrivilegeConfigUnmarshalError: "PRIVILEGE_CONFIG_UNMARSHAL_ERROR",
+}
+
+func (s PipelineExecutionState) String() string {
+	if name, ok := pipelineExecutionStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(s))
+}
+
 // ErrCode is used for invoking an error handler with a specific error code.
 type ErrCode uint32
 
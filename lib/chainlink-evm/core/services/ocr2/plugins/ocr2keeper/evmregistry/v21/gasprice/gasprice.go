@@ -25,6 +25,14 @@ const (
 
 type UpkeepOffchainConfig struct {
 	MaxGasPrice *big.Int `json:"maxGasPrice" cbor:"maxGasPrice"`
+	// ExecutionWindows restricts performs to the daily UTC windows, performs are always allowed when it's empty
+	ExecutionWindows []ExecutionWindow `json:"executionWindows" cbor:"executionWindows"`
+	// MinPerformIntervalSeconds is the min time between two performs
+	MinPerformIntervalSeconds uint32 `json:"minPerformIntervalSeconds" cbor:"minPerformIntervalSeconds"`
+	// MaxDailyPerforms is the max number of performs per UTC day
+	MaxDailyPerforms uint32 `json:"maxDailyPerforms" cbor:"maxDailyPerforms"`
+	// MaxL1DataFee is the max L1 data fee of a perform on L2s, in wei
+	MaxL1DataFee *big.Int `json:"maxL1DataFee" cbor:"maxL1DataFee"`
 }
 
 // CheckGasPrice retrieves the current gas price and compare against the max gas price configured in upkeep's offchain config
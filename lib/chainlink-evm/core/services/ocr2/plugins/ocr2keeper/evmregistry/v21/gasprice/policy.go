@@ -0,0 +1,182 @@
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/cbor"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+)
+
+const minutesPerDay = 24 * 60
+
+// ExecutionWindow is a daily UTC time window in which an upkeep is allowed to perform, in minutes since midnight.
+// Start is inclusive and End is exclusive, a window ending before it starts wraps around midnight.
+type ExecutionWindow struct {
+	Start uint16 `json:"start" cbor:"start"`
+	End   uint16 `json:"end" cbor:"end"`
+}
+
+func (w ExecutionWindow) valid() bool {
+	return w.Start < minutesPerDay && w.End <= minutesPerDay && w.Start != w.End
+}
+
+func (w ExecutionWindow) contains(minute uint16) bool {
+	if w.Start < w.End {
+		return minute >= w.Start && minute < w.End
+	}
+	return minute >= w.Start || minute < w.End
+}
+
+// PerformHistory provides the performs of upkeeps.
+type PerformHistory interface {
+	// PerformedAfter returns the block timestamps of the upkeep's finalized performs after the given time.
+	PerformedAfter(ctx context.Context, upkeepId *big.Int, after time.Time) ([]time.Time, error)
+	// PendingPerforms returns the times of the upkeep's performs that aren't finalized, mined in unfinalized blocks or
+	// transmitted but not mined yet.
+	PendingPerforms(ctx context.Context, upkeepId *big.Int) ([]time.Time, error)
+}
+
+// CheckPolicies enforces the execution windows, min perform interval, daily perform budget and max L1 data fee configured
+// in upkeep's offchain config at time now, the timestamp of the check block. Pending performs count as performed for the
+// perform interval and the daily budget. performTx is the transmit transaction of the perform, its L1 data fee is
+// estimated. like CheckGasPrice, any errors in offchain config decoding or in fetching the performs or fees will disable the
+// affected checks
+func CheckPolicies(
+	ctx context.Context,
+	upkeepId *big.Int,
+	offchainConfigBytes []byte,
+	now time.Time,
+	history PerformHistory,
+	ge gas.EvmFeeEstimator,
+	performTx *types.Transaction,
+	lggr logger.Logger,
+) encoding.UpkeepFailureReason {
+	// check for empty offchain config
+	if len(offchainConfigBytes) == 0 {
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	var offchainConfig UpkeepOffchainConfig
+	if err := cbor.ParseDietCBORToStruct(offchainConfigBytes, &offchainConfig); err != nil {
+		lggr.Debugw("failed to parse upkeep offchain config, policy checks are disabled", "offchainconfig", hexutil.Encode(offchainConfigBytes), "upkeepId", upkeepId.String(), "err", err)
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	now = now.UTC()
+	if fr := checkExecutionWindows(upkeepId, offchainConfig.ExecutionWindows, now, lggr); fr != encoding.UpkeepFailureReasonNone {
+		return fr
+	}
+	if fr := checkPerformHistory(ctx, upkeepId, offchainConfig, now, history, lggr); fr != encoding.UpkeepFailureReasonNone {
+		return fr
+	}
+	return checkL1DataFee(ctx, upkeepId, offchainConfig.MaxL1DataFee, ge, performTx, lggr)
+}
+
+func checkExecutionWindows(upkeepId *big.Int, windows []ExecutionWindow, now time.Time, lggr logger.Logger) encoding.UpkeepFailureReason {
+	minute := uint16(now.Hour()*60 + now.Minute()) //nolint:gosec // at most minutesPerDay
+
+	var configured bool
+	for _, w := range windows {
+		if !w.valid() {
+			lggr.Warnw("ignoring invalid execution window in upkeep offchain config", "upkeepId", upkeepId.String(), "start", w.Start, "end", w.End)
+			continue
+		}
+		configured = true
+		if w.contains(minute) {
+			return encoding.UpkeepFailureReasonNone
+		}
+	}
+	if !configured {
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	lggr.Debugf("%s is outside of the execution windows of %s", now.Format(time.TimeOnly), upkeepId.String())
+	return encoding.UpkeepFailureReasonOutsideExecutionWindow
+}
+
+func checkPerformHistory(ctx context.Context, upkeepId *big.Int, offchainConfig UpkeepOffchainConfig, now time.Time, history PerformHistory, lggr logger.Logger) encoding.UpkeepFailureReason {
+	minInterval := time.Duration(offchainConfig.MinPerformIntervalSeconds) * time.Second
+	if minInterval == 0 && offchainConfig.MaxDailyPerforms == 0 {
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	startOfDay := now.Truncate(24 * time.Hour)
+	after := startOfDay
+	if minInterval > 0 && now.Add(-minInterval).Before(after) {
+		after = now.Add(-minInterval)
+	}
+
+	performs, err := history.PerformedAfter(ctx, upkeepId, after)
+	if err != nil {
+		lggr.Errorw("failed to get upkeep performs, perform interval and daily budget checks are disabled", "upkeepId", upkeepId.String(), "err", err)
+		return encoding.UpkeepFailureReasonNone
+	}
+	pending, err := history.PendingPerforms(ctx, upkeepId)
+	if err != nil {
+		lggr.Errorw("failed to get pending upkeep performs, perform interval and daily budget checks are disabled", "upkeepId", upkeepId.String(), "err", err)
+		return encoding.UpkeepFailureReasonNone
+	}
+	performs = append(performs, pending...)
+
+	var lastPerform time.Time
+	var performsToday uint32
+	for _, performedAt := range performs {
+		if performedAt.After(lastPerform) {
+			lastPerform = performedAt
+		}
+		if !performedAt.Before(startOfDay) {
+			performsToday++
+		}
+	}
+
+	if minInterval > 0 && !lastPerform.IsZero() && now.Sub(lastPerform) < minInterval {
+		lggr.Debugf("%s was last performed at %s, min perform interval is %s", upkeepId.String(), lastPerform, minInterval)
+		return encoding.UpkeepFailureReasonPerformIntervalNotElapsed
+	}
+	if offchainConfig.MaxDailyPerforms > 0 && performsToday >= offchainConfig.MaxDailyPerforms {
+		lggr.Debugf("%s was performed %d times today, max daily performs is %d", upkeepId.String(), performsToday, offchainConfig.MaxDailyPerforms)
+		return encoding.UpkeepFailureReasonDailyPerformBudgetExceeded
+	}
+
+	return encoding.UpkeepFailureReasonNone
+}
+
+func checkL1DataFee(ctx context.Context, upkeepId *big.Int, maxL1DataFee *big.Int, ge gas.EvmFeeEstimator, performTx *types.Transaction, lggr logger.Logger) encoding.UpkeepFailureReason {
+	if maxL1DataFee == nil || maxL1DataFee.Sign() <= 0 {
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	if performTx == nil {
+		lggr.Debugw("no transaction to estimate the L1 data fee of, max L1 data fee check is disabled", "upkeepId", upkeepId.String())
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	l1Oracle := ge.L1Oracle()
+	if l1Oracle == nil {
+		lggr.Debugw("chain has no L1 oracle, max L1 data fee check is disabled", "upkeepId", upkeepId.String())
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	l1DataFee, err := l1Oracle.GetGasCost(ctx, performTx, nil)
+	if err != nil {
+		lggr.Errorw("failed to get L1 data fee, max L1 data fee check is disabled", "upkeepId", upkeepId.String(), "err", err)
+		return encoding.UpkeepFailureReasonNone
+	}
+
+	if l1DataFee.Cmp(assets.NewWei(maxL1DataFee)) > 0 {
+		lggr.Warnf("maxL1DataFee %s for %s is LOWER than current L1 data fee %s", maxL1DataFee.String(), upkeepId.String(), l1DataFee.ToInt().String())
+		return encoding.UpkeepFailureReasonL1DataFeeTooHigh
+	}
+	lggr.Debugf("maxL1DataFee %s for %s is HIGHER than current L1 data fee %s", maxL1DataFee.String(), upkeepId.String(), l1DataFee.ToInt().String())
+
+	return encoding.UpkeepFailureReasonNone
+}
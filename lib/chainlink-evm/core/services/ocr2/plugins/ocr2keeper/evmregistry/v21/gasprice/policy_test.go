@@ -0,0 +1,226 @@
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	gasMocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/mocks"
+	rollupMocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/rollups/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+)
+
+type performHistory struct {
+	performedAfter  func(ctx context.Context, upkeepId *big.Int, after time.Time) ([]time.Time, error)
+	pendingPerforms func(ctx context.Context, upkeepId *big.Int) ([]time.Time, error)
+}
+
+func (h performHistory) PerformedAfter(ctx context.Context, upkeepId *big.Int, after time.Time) ([]time.Time, error) {
+	return h.performedAfter(ctx, upkeepId, after)
+}
+
+func (h performHistory) PendingPerforms(ctx context.Context, upkeepId *big.Int) ([]time.Time, error) {
+	return h.pendingPerforms(ctx, upkeepId)
+}
+
+func TestPolicies_Check(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	uid, _ := new(big.Int).SetString("1843548457736589226156809205796175506139185429616502850435279853710366065936", 10)
+	now := time.Date(2024, 5, 10, 14, 30, 0, 0, time.UTC)
+	performTx := types.NewTx(&types.DynamicFeeTx{To: &common.Address{}, Data: []byte{1, 2, 3}})
+
+	tests := []struct {
+		Name               string
+		OffchainConfig     *UpkeepOffchainConfig
+		Performs           []time.Time
+		Pending            []time.Time
+		FailedToGetLogs    bool
+		FailedToGetPending bool
+		NoL1Oracle         bool
+		L1DataFee          *big.Int
+		FailedToGetL1Fee   bool
+		ExpectedAfter      time.Time
+		ExpectedResult     encoding.UpkeepFailureReason
+	}{
+		{
+			Name:           "no offchain config",
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "no policies configured",
+			OffchainConfig: &UpkeepOffchainConfig{MaxGasPrice: big.NewInt(10_000_000_000)},
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "inside execution window",
+			OffchainConfig: &UpkeepOffchainConfig{ExecutionWindows: []ExecutionWindow{{Start: 60, End: 120}, {Start: 14 * 60, End: 15 * 60}}},
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "inside execution window wrapping around midnight",
+			OffchainConfig: &UpkeepOffchainConfig{ExecutionWindows: []ExecutionWindow{{Start: 14 * 60, End: 60}}},
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "outside execution windows",
+			OffchainConfig: &UpkeepOffchainConfig{ExecutionWindows: []ExecutionWindow{{Start: 60, End: 120}, {Start: 14*60 + 31, End: 15 * 60}}},
+			ExpectedResult: encoding.UpkeepFailureReasonOutsideExecutionWindow,
+		},
+		{
+			Name:           "invalid execution windows are ignored",
+			OffchainConfig: &UpkeepOffchainConfig{ExecutionWindows: []ExecutionWindow{{Start: 60, End: 60}, {Start: 1500, End: 60}}},
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "min perform interval elapsed",
+			OffchainConfig: &UpkeepOffchainConfig{MinPerformIntervalSeconds: 3600},
+			Performs:       []time.Time{now.Add(-2 * time.Hour)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "min perform interval not elapsed",
+			OffchainConfig: &UpkeepOffchainConfig{MinPerformIntervalSeconds: 3600},
+			Performs:       []time.Time{now.Add(-2 * time.Hour), now.Add(-30 * time.Minute)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonPerformIntervalNotElapsed,
+		},
+		{
+			Name:           "min perform interval longer than a day",
+			OffchainConfig: &UpkeepOffchainConfig{MinPerformIntervalSeconds: 2 * 24 * 3600},
+			Performs:       []time.Time{now.Add(-36 * time.Hour)},
+			ExpectedAfter:  now.Add(-48 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonPerformIntervalNotElapsed,
+		},
+		{
+			Name:           "daily perform budget left",
+			OffchainConfig: &UpkeepOffchainConfig{MaxDailyPerforms: 2},
+			Performs:       []time.Time{now.Add(-time.Hour)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "daily perform budget exceeded",
+			OffchainConfig: &UpkeepOffchainConfig{MaxDailyPerforms: 2},
+			Performs:       []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonDailyPerformBudgetExceeded,
+		},
+		{
+			Name:           "performs of previous days don't count against the daily budget",
+			OffchainConfig: &UpkeepOffchainConfig{MinPerformIntervalSeconds: 24 * 3600, MaxDailyPerforms: 1},
+			Performs:       []time.Time{now.Add(-25 * time.Hour)},
+			ExpectedAfter:  now.Add(-24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "pending perform within min perform interval",
+			OffchainConfig: &UpkeepOffchainConfig{MinPerformIntervalSeconds: 3600},
+			Performs:       []time.Time{now.Add(-2 * time.Hour)},
+			Pending:        []time.Time{now.Add(-time.Minute)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonPerformIntervalNotElapsed,
+		},
+		{
+			Name:           "pending performs count against the daily budget",
+			OffchainConfig: &UpkeepOffchainConfig{MaxDailyPerforms: 2},
+			Performs:       []time.Time{now.Add(-time.Hour)},
+			Pending:        []time.Time{now.Add(time.Second)},
+			ExpectedAfter:  now.Truncate(24 * time.Hour),
+			ExpectedResult: encoding.UpkeepFailureReasonDailyPerformBudgetExceeded,
+		},
+		{
+			Name:               "fail to get pending performs",
+			OffchainConfig:     &UpkeepOffchainConfig{MaxDailyPerforms: 1},
+			Pending:            []time.Time{now},
+			FailedToGetPending: true,
+			ExpectedAfter:      now.Truncate(24 * time.Hour),
+			ExpectedResult:     encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:            "fail to get performs",
+			OffchainConfig:  &UpkeepOffchainConfig{MaxDailyPerforms: 1},
+			FailedToGetLogs: true,
+			ExpectedAfter:   now.Truncate(24 * time.Hour),
+			ExpectedResult:  encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "L1 data fee is too high",
+			OffchainConfig: &UpkeepOffchainConfig{MaxL1DataFee: big.NewInt(1_000_000)},
+			L1DataFee:      big.NewInt(2_000_000),
+			ExpectedResult: encoding.UpkeepFailureReasonL1DataFeeTooHigh,
+		},
+		{
+			Name:           "L1 data fee is less than user's max L1 data fee",
+			OffchainConfig: &UpkeepOffchainConfig{MaxL1DataFee: big.NewInt(1_000_000)},
+			L1DataFee:      big.NewInt(500_000),
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:           "no L1 oracle",
+			OffchainConfig: &UpkeepOffchainConfig{MaxL1DataFee: big.NewInt(1_000_000)},
+			NoL1Oracle:     true,
+			ExpectedResult: encoding.UpkeepFailureReasonNone,
+		},
+		{
+			Name:             "fail to retrieve L1 data fee",
+			OffchainConfig:   &UpkeepOffchainConfig{MaxL1DataFee: big.NewInt(1_000_000)},
+			FailedToGetL1Fee: true,
+			ExpectedResult:   encoding.UpkeepFailureReasonNone,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+
+			history := performHistory{
+				performedAfter: func(_ context.Context, upkeepId *big.Int, after time.Time) ([]time.Time, error) {
+					assert.Equal(t, uid, upkeepId)
+					assert.Equal(t, test.ExpectedAfter, after)
+					if test.FailedToGetLogs {
+						return nil, errors.New("failed to read logs")
+					}
+					return test.Performs, nil
+				},
+				pendingPerforms: func(_ context.Context, upkeepId *big.Int) ([]time.Time, error) {
+					assert.Equal(t, uid, upkeepId)
+					if test.FailedToGetPending {
+						return nil, errors.New("failed to read logs")
+					}
+					return test.Pending, nil
+				},
+			}
+
+			ge := gasMocks.NewEvmFeeEstimator(t)
+			if test.NoL1Oracle {
+				ge.On("L1Oracle").Return(nil)
+			} else if test.L1DataFee != nil || test.FailedToGetL1Fee {
+				l1Oracle := rollupMocks.NewL1Oracle(t)
+				if test.FailedToGetL1Fee {
+					l1Oracle.On("GetGasCost", mock.Anything, performTx, mock.Anything).Return(nil, errors.New("failed to retrieve L1 data fee"))
+				} else {
+					l1Oracle.On("GetGasCost", mock.Anything, performTx, mock.Anything).Return(assets.NewWei(test.L1DataFee), nil)
+				}
+				ge.On("L1Oracle").Return(l1Oracle)
+			}
+
+			var oc []byte
+			if test.OffchainConfig != nil {
+				oc, _ = cbor.Marshal(test.OffchainConfig)
+			}
+			fr := CheckPolicies(ctx, uid, oc, now, history, ge, performTx, lggr)
+			assert.Equal(t, test.ExpectedResult, fr)
+		})
+	}
+}
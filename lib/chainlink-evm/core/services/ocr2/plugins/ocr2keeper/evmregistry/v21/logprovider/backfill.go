@@ -0,0 +1,377 @@
+package logprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/prommetrics"
+)
+
+var (
+	ErrBackfillInvalidRange    = errors.New("invalid backfill block range")
+	ErrBackfillUpkeepNotFound  = errors.New("log trigger upkeep not found")
+	ErrBackfillLimitExceeded   = errors.New("too many active backfills")
+	ErrBackfillPendingExceeded = errors.New("failed to add all backfilled logs to pending")
+
+	// BackfillRetention is the time a finished backfill is kept for reporting. Its logs stay recoverable
+	// for as long, so the replayed payloads can be performed.
+	BackfillRetention = 24 * time.Hour
+	// backfillBlocksPerRun is the number of blocks a backfill reads logs for in a single recovery run
+	backfillBlocksPerRun = int64(500)
+	// maxBackfillBlocks is the max block range of a single backfill
+	maxBackfillBlocks = int64(1_000_000)
+	// maxActiveBackfills is the max number of backfills reading logs at any given time
+	maxActiveBackfills = 10
+)
+
+// BackfillLogStatus is the status of a log found by a backfill.
+type BackfillLogStatus string
+
+const (
+	// BackfillLogReplayed logs were added to the recoverer's pending payloads
+	BackfillLogReplayed BackfillLogStatus = "replayed"
+	// BackfillLogPerformed logs were replayed and performed since
+	BackfillLogPerformed BackfillLogStatus = "performed"
+	// BackfillLogIneligible logs were replayed and found ineligible since
+	BackfillLogIneligible BackfillLogStatus = "ineligible"
+	// BackfillLogAlreadyPerformed logs were already performed according to the upkeep state store, they are not replayed
+	BackfillLogAlreadyPerformed BackfillLogStatus = "already_performed"
+	// BackfillLogAlreadyIneligible logs were already ineligible according to the upkeep state store, they are not replayed
+	BackfillLogAlreadyIneligible BackfillLogStatus = "already_ineligible"
+)
+
+// BackfillLog is a log found by a backfill.
+type BackfillLog struct {
+	WorkID      string
+	BlockNumber int64
+	TxHash      common.Hash
+	LogIndex    int64
+	Status      BackfillLogStatus
+}
+
+// BackfillReport reports the progress of a backfill.
+type BackfillReport struct {
+	ID        int64
+	UpkeepID  *big.Int
+	FromBlock int64
+	ToBlock   int64
+	// ScannedBlock is the last block the logs were read for
+	ScannedBlock int64
+	Done         bool
+	// Err is set when the backfill was aborted
+	Err       string
+	CreatedAt time.Time
+	Logs      []BackfillLog
+}
+
+// LogBackfiller replays the logs of log trigger upkeeps in block ranges the recoverer no longer covers,
+// e.g. when an upkeep was paused or underfunded for longer than the recovery window. The log poller replays the logs
+// from the start of the backfill first, as it didn't store the logs emitted while the upkeep's filter was unregistered.
+// Logs from before the upkeep's last config update are backfilled too.
+//
+// Backfills are not shared with the other nodes of the DON nor persisted. A node only recovers logs before the recovery
+// window that are in one of its own backfills, so a replayed log is only performed when enough nodes were given the
+// same backfill. Backfills are lost on restart.
+type LogBackfiller interface {
+	// Backfill enqueues a backfill of the upkeep's logs in the given block range, inclusive.
+	Backfill(ctx context.Context, upkeepID *big.Int, fromBlock, toBlock int64) (BackfillReport, error)
+	// BackfillReports returns the reports of the active and recently finished backfills.
+	BackfillReports(ctx context.Context) ([]BackfillReport, error)
+}
+
+var _ LogBackfiller = &logRecoverer{}
+
+type backfill struct {
+	report     BackfillReport
+	logIdx     map[string]int
+	finishedAt time.Time
+	// replayed is set once the log poller replayed the logs from the start of the backfill
+	replayed bool
+}
+
+func (b *backfill) finish(err error) {
+	b.report.Done = true
+	b.finishedAt = time.Now()
+	if err != nil {
+		b.report.Err = err.Error()
+	}
+}
+
+func (b *backfill) reportCopy() BackfillReport {
+	report := b.report
+	report.UpkeepID = new(big.Int).Set(b.report.UpkeepID)
+	report.Logs = make([]BackfillLog, len(b.report.Logs))
+	copy(report.Logs, b.report.Logs)
+	return report
+}
+
+func (r *logRecoverer) Backfill(ctx context.Context, upkeepID *big.Int, fromBlock, toBlock int64) (BackfillReport, error) {
+	if fromBlock < 0 || fromBlock > toBlock {
+		return BackfillReport{}, fmt.Errorf("%w: from block %d, to block %d", ErrBackfillInvalidRange, fromBlock, toBlock)
+	}
+	if toBlock-fromBlock >= maxBackfillBlocks {
+		return BackfillReport{}, fmt.Errorf("%w: range is larger than %d blocks", ErrBackfillInvalidRange, maxBackfillBlocks)
+	}
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	if !uid.FromBigInt(upkeepID) || core.GetUpkeepType(*uid) != types.LogTrigger || !r.filterStore.Has(upkeepID) {
+		return BackfillReport{}, fmt.Errorf("%w: %s", ErrBackfillUpkeepNotFound, upkeepID)
+	}
+	latest, err := r.poller.LatestBlock(ctx)
+	if err != nil {
+		return BackfillReport{}, fmt.Errorf("%w: %w", ErrHeadNotAvailable, err)
+	}
+	if toBlock > latest.BlockNumber {
+		return BackfillReport{}, fmt.Errorf("%w: to block %d is after the latest block %d", ErrBackfillInvalidRange, toBlock, latest.BlockNumber)
+	}
+
+	r.backfillLock.Lock()
+	defer r.backfillLock.Unlock()
+
+	active := 0
+	for _, b := range r.backfills {
+		if !b.report.Done {
+			active++
+		}
+	}
+	if active >= maxActiveBackfills {
+		return BackfillReport{}, fmt.Errorf("%w: %d backfills are active", ErrBackfillLimitExceeded, active)
+	}
+
+	r.lastBackfillID++
+	b := &backfill{
+		report: BackfillReport{
+			ID:           r.lastBackfillID,
+			UpkeepID:     new(big.Int).Set(upkeepID),
+			FromBlock:    fromBlock,
+			ToBlock:      toBlock,
+			ScannedBlock: fromBlock - 1,
+			CreatedAt:    time.Now(),
+		},
+		logIdx: make(map[string]int),
+	}
+	r.backfills = append(r.backfills, b)
+	r.lggr.Infow("enqueued backfill", "id", b.report.ID, "upkeepID", upkeepID, "fromBlock", fromBlock, "toBlock", toBlock)
+
+	return b.reportCopy(), nil
+}
+
+func (r *logRecoverer) BackfillReports(ctx context.Context) ([]BackfillReport, error) {
+	r.backfillLock.Lock()
+	reports := make([]BackfillReport, len(r.backfills))
+	for i, b := range r.backfills {
+		reports[i] = b.reportCopy()
+	}
+	r.backfillLock.Unlock()
+
+	// resolve the replayed logs that were performed or found ineligible since
+	var workIDs []string
+	for _, report := range reports {
+		for _, l := range report.Logs {
+			if l.Status == BackfillLogReplayed {
+				workIDs = append(workIDs, l.WorkID)
+			}
+		}
+	}
+	if len(workIDs) == 0 {
+		return reports, nil
+	}
+	states, err := r.states.SelectByWorkIDs(ctx, workIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("could not read states: %w", err)
+	}
+	if len(states) != len(workIDs) {
+		return nil, fmt.Errorf("work ID and state count mismatch: %d != %d", len(workIDs), len(states))
+	}
+	i := 0
+	for _, report := range reports {
+		for j := range report.Logs {
+			if report.Logs[j].Status != BackfillLogReplayed {
+				continue
+			}
+			switch states[i] {
+			case ocr2keepers.Performed:
+				report.Logs[j].Status = BackfillLogPerformed
+			case ocr2keepers.Ineligible:
+				report.Logs[j].Status = BackfillLogIneligible
+			default:
+			}
+			i++
+		}
+	}
+
+	return reports, nil
+}
+
+// runBackfills reads the logs of the active backfills, up to backfillBlocksPerRun blocks each,
+// and adds the logs that weren't performed yet to pending.
+func (r *logRecoverer) runBackfills(ctx context.Context) error {
+	r.backfillLock.Lock()
+	var active []*backfill
+	for _, b := range r.backfills {
+		if !b.report.Done {
+			active = append(active, b)
+		}
+	}
+	r.backfillLock.Unlock()
+	if len(active) == 0 {
+		return nil
+	}
+
+	latest, err := r.poller.LatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrHeadNotAvailable, err)
+	}
+	// logs after the offset block are covered by the provider and the recoverer
+	_, offsetBlock := r.getRecoveryWindow(latest.BlockNumber)
+
+	for _, b := range active {
+		if err := r.runBackfill(ctx, b, offsetBlock); err != nil {
+			r.lggr.Debugw("error running backfill", "id", b.report.ID, "err", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (r *logRecoverer) runBackfill(ctx context.Context, b *backfill, offsetBlock int64) error {
+	r.backfillLock.Lock()
+	upkeepID, start, toBlock, replayed := b.report.UpkeepID, b.report.ScannedBlock+1, b.report.ToBlock, b.replayed
+	r.backfillLock.Unlock()
+
+	f := r.filterStore.Get(upkeepID)
+	if f == nil {
+		// the upkeep was paused or canceled since the backfill was enqueued
+		r.backfillLock.Lock()
+		b.finish(fmt.Errorf("%w: %s", ErrBackfillUpkeepNotFound, upkeepID))
+		r.backfillLock.Unlock()
+		return nil
+	}
+	end := min(start+backfillBlocksPerRun-1, toBlock, offsetBlock)
+	if start > toBlock {
+		r.backfillLock.Lock()
+		b.report.ScannedBlock = toBlock
+		b.finish(nil)
+		r.backfillLock.Unlock()
+		return nil
+	}
+	if start > end {
+		// waiting for the blocks to be recoverable
+		return nil
+	}
+
+	if !replayed {
+		// blocks until the log poller caught up with the latest block again
+		if err := r.poller.Replay(ctx, start); err != nil {
+			return fmt.Errorf("could not replay logs from block %d: %w", start, err)
+		}
+		r.backfillLock.Lock()
+		b.replayed = true
+		r.backfillLock.Unlock()
+	}
+
+	logs, err := r.poller.LogsWithSigs(ctx, start, end, f.topics, common.BytesToAddress(f.addr))
+	if err != nil {
+		return fmt.Errorf("could not read logs: %w", err)
+	}
+	logs = f.Select(logs...)
+
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	uid.FromBigInt(upkeepID)
+	workIDs := make([]string, len(logs))
+	for i, log := range logs {
+		workIDs[i] = core.UpkeepWorkID(*uid, logToTrigger(log))
+	}
+	states, err := r.states.SelectByWorkIDs(ctx, workIDs...)
+	if err != nil {
+		return fmt.Errorf("could not read states: %w", err)
+	}
+	if len(logs) != len(states) {
+		return fmt.Errorf("log and state count mismatch: %d != %d", len(logs), len(states))
+	}
+
+	found := make([]BackfillLog, len(logs))
+	var replay []logpoller.Log
+	for i, log := range logs {
+		found[i] = BackfillLog{
+			WorkID:      workIDs[i],
+			BlockNumber: log.BlockNumber,
+			TxHash:      log.TxHash,
+			LogIndex:    log.LogIndex,
+			Status:      BackfillLogReplayed,
+		}
+		switch states[i] {
+		case ocr2keepers.Performed:
+			found[i].Status = BackfillLogAlreadyPerformed
+		case ocr2keepers.Ineligible:
+			found[i].Status = BackfillLogAlreadyIneligible
+		default:
+			replay = append(replay, log)
+		}
+	}
+
+	added, alreadyPending, ok := r.populatePending(*f, replay)
+	if !ok {
+		// the upkeep has too many pending payloads, the range is read again on the next run
+		return fmt.Errorf("%w: added %d of %d logs for upkeep %s", ErrBackfillPendingExceeded, added+alreadyPending, len(replay), upkeepID)
+	}
+
+	r.backfillLock.Lock()
+	defer r.backfillLock.Unlock()
+
+	for _, l := range found {
+		if idx, ok := b.logIdx[l.WorkID]; ok {
+			b.report.Logs[idx] = l
+			continue
+		}
+		b.logIdx[l.WorkID] = len(b.report.Logs)
+		b.report.Logs = append(b.report.Logs, l)
+		prommetrics.AutomationRecovererBackfilledLogs.WithLabelValues(string(l.Status)).Inc()
+	}
+	b.report.ScannedBlock = end
+	if end >= toBlock {
+		b.finish(nil)
+		r.lggr.Infow("finished backfill", "id", b.report.ID, "upkeepID", upkeepID, "logs", len(b.report.Logs))
+	}
+
+	return nil
+}
+
+// inBackfillRange returns whether the log block is in the range of one of the upkeep's backfills.
+// Such logs are recoverable even when they're before the recovery window.
+func (r *logRecoverer) inBackfillRange(upkeepID *big.Int, logBlock int64) bool {
+	r.backfillLock.Lock()
+	defer r.backfillLock.Unlock()
+
+	for _, b := range r.backfills {
+		if b.report.UpkeepID.Cmp(upkeepID) == 0 && logBlock >= b.report.FromBlock && logBlock <= b.report.ToBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanBackfills removes the backfills that finished more than BackfillRetention ago.
+func (r *logRecoverer) cleanBackfills() {
+	r.backfillLock.Lock()
+	defer r.backfillLock.Unlock()
+
+	backfills := make([]*backfill, 0, len(r.backfills))
+	for _, b := range r.backfills {
+		if b.report.Done && time.Since(b.finishedAt) > BackfillRetention {
+			continue
+		}
+		backfills = append(backfills, b)
+	}
+	r.backfills = backfills
+}
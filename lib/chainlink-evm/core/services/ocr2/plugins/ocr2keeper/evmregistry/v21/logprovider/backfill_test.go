@@ -0,0 +1,225 @@
+package logprovider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	autotypes "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+)
+
+func TestLogRecoverer_Backfill(t *testing.T) {
+	upkeepID := core.GenUpkeepID(autotypes.LogTrigger, "1111").BigInt()
+	conditionalID := core.GenUpkeepID(autotypes.ConditionTrigger, "2222").BigInt()
+	unknownID := core.GenUpkeepID(autotypes.LogTrigger, "3333").BigInt()
+
+	newRecoverer := func(t *testing.T) *logRecoverer {
+		lp := new(lpmocks.LogPoller)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 10_000}, nil)
+		filterStore := NewUpkeepFilterStore()
+		filterStore.AddActiveUpkeeps(upkeepFilter{
+			upkeepID: upkeepID,
+			addr:     common.HexToAddress("0x1").Bytes(),
+			topics:   []common.Hash{common.HexToHash("0x1")},
+		})
+		return NewLogRecoverer(logger.TestLogger(t), lp, nil, nil, &mockedPacker{}, filterStore, NewOptions(100, big.NewInt(1)))
+	}
+
+	tests := []struct {
+		name      string
+		upkeepID  *big.Int
+		fromBlock int64
+		toBlock   int64
+		err       error
+	}{
+		{"negative from block", upkeepID, -1, 100, ErrBackfillInvalidRange},
+		{"from block after to block", upkeepID, 200, 100, ErrBackfillInvalidRange},
+		{"range too large", upkeepID, 0, maxBackfillBlocks, ErrBackfillInvalidRange},
+		{"to block after latest block", upkeepID, 100, 10_001, ErrBackfillInvalidRange},
+		{"conditional upkeep", conditionalID, 100, 200, ErrBackfillUpkeepNotFound},
+		{"unknown upkeep", unknownID, 100, 200, ErrBackfillUpkeepNotFound},
+		{"happy flow", upkeepID, 100, 200, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecoverer(t)
+			report, err := r.Backfill(testutils.Context(t), tc.upkeepID, tc.fromBlock, tc.toBlock)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), report.ID)
+			assert.Equal(t, tc.upkeepID, report.UpkeepID)
+			assert.Equal(t, tc.fromBlock-1, report.ScannedBlock)
+			assert.False(t, report.Done)
+		})
+	}
+
+	t.Run("limits active backfills", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := newRecoverer(t)
+		for i := 0; i < maxActiveBackfills; i++ {
+			_, err := r.Backfill(ctx, upkeepID, 100, 200)
+			require.NoError(t, err)
+		}
+		_, err := r.Backfill(ctx, upkeepID, 100, 200)
+		require.ErrorIs(t, err, ErrBackfillLimitExceeded)
+	})
+}
+
+func TestLogRecoverer_RunBackfills(t *testing.T) {
+	ctx := testutils.Context(t)
+	upkeepID := core.GenUpkeepID(autotypes.LogTrigger, "1111")
+
+	lp := new(lpmocks.LogPoller)
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 10_000}, nil)
+	logs := []logpoller.Log{
+		{BlockNumber: 110, TxHash: common.HexToHash("0x111"), LogIndex: 1, BlockHash: common.HexToHash("0x11")},
+		{BlockNumber: 150, TxHash: common.HexToHash("0x222"), LogIndex: 2, BlockHash: common.HexToHash("0x22")},
+		{BlockNumber: 190, TxHash: common.HexToHash("0x333"), LogIndex: 3, BlockHash: common.HexToHash("0x33")},
+	}
+	lp.On("Replay", mock.Anything, int64(100)).Return(nil).Once()
+	lp.On("LogsWithSigs", mock.Anything, int64(100), int64(200), mock.Anything, mock.Anything).Return(logs, nil).Once()
+
+	// the upkeep was unpaused within the backfill range
+	filterStore := NewUpkeepFilterStore()
+	filterStore.AddActiveUpkeeps(upkeepFilter{
+		upkeepID:          upkeepID.BigInt(),
+		addr:              common.HexToAddress("0x1").Bytes(),
+		topics:            []common.Hash{common.HexToHash("0x1")},
+		configUpdateBlock: 180,
+	})
+
+	workIDs := make([]string, len(logs))
+	for i, log := range logs {
+		workIDs[i] = core.UpkeepWorkID(upkeepID, logToTrigger(log))
+	}
+	states := map[string]ocr2keepers.UpkeepState{
+		workIDs[0]: ocr2keepers.Performed,
+		workIDs[2]: ocr2keepers.Ineligible,
+	}
+	stateReader := &mockStateReader{
+		SelectByWorkIDsFn: func(ctx context.Context, workIDs ...string) ([]ocr2keepers.UpkeepState, error) {
+			res := make([]ocr2keepers.UpkeepState, len(workIDs))
+			for i, workID := range workIDs {
+				res[i] = states[workID]
+			}
+			return res, nil
+		},
+	}
+
+	r := NewLogRecoverer(logger.TestLogger(t), lp, nil, stateReader, &mockedPacker{}, filterStore, NewOptions(100, big.NewInt(1)))
+
+	_, err := r.Backfill(ctx, upkeepID.BigInt(), 100, 200)
+	require.NoError(t, err)
+	require.NoError(t, r.runBackfills(ctx))
+
+	// only the log that wasn't performed yet is replayed
+	proposals, err := r.GetRecoveryProposals(ctx)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, workIDs[1], proposals[0].WorkID)
+	assert.True(t, r.inBackfillRange(upkeepID.BigInt(), 150))
+	assert.False(t, r.inBackfillRange(upkeepID.BigInt(), 250))
+
+	reports, err := r.BackfillReports(ctx)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.True(t, reports[0].Done)
+	assert.Equal(t, int64(200), reports[0].ScannedBlock)
+	require.Len(t, reports[0].Logs, 3)
+	assert.Equal(t, BackfillLogAlreadyPerformed, reports[0].Logs[0].Status)
+	assert.Equal(t, BackfillLogReplayed, reports[0].Logs[1].Status)
+	assert.Equal(t, BackfillLogAlreadyIneligible, reports[0].Logs[2].Status)
+
+	// the replayed log is reported performed once it's in the upkeep state store
+	states[workIDs[1]] = ocr2keepers.Performed
+	reports, err = r.BackfillReports(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, BackfillLogPerformed, reports[0].Logs[1].Status)
+
+	// finished backfills don't replay or read logs again
+	require.NoError(t, r.runBackfills(ctx))
+	lp.AssertNumberOfCalls(t, "Replay", 1)
+	lp.AssertNumberOfCalls(t, "LogsWithSigs", 1)
+}
+
+func TestLogRecoverer_BackfillProposalRejectedByPeers(t *testing.T) {
+	ctx := testutils.Context(t)
+	upkeepID := core.GenUpkeepID(autotypes.LogTrigger, "123")
+	// the log is before the recovery window and the upkeep's last config update
+	log := logpoller.Log{
+		LogIndex:    3,
+		BlockHash:   [32]byte{1},
+		BlockNumber: 500,
+		TxHash:      [32]byte{2},
+		Data:        []byte{1, 2, 3},
+	}
+	proposal := ocr2keepers.CoordinatedBlockProposal{
+		UpkeepID: upkeepID,
+		Trigger:  logToTrigger(log),
+	}
+	proposal.WorkID = core.UpkeepWorkID(upkeepID, proposal.Trigger)
+
+	newNode := func() *logRecoverer {
+		filterStore := NewUpkeepFilterStore()
+		filterStore.AddActiveUpkeeps(upkeepFilter{
+			upkeepID:          upkeepID.BigInt(),
+			addr:              common.HexToAddress("0x1").Bytes(),
+			topics:            []common.Hash{common.HexToHash("0x1")},
+			configUpdateBlock: 600,
+		})
+		return NewLogRecoverer(logger.TestLogger(t), &mockLogPoller{
+			LatestBlockFn: func(ctx context.Context) (int64, error) {
+				return 100_000, nil
+			},
+			LogsWithSigsFn: func(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address) ([]logpoller.Log, error) {
+				return []logpoller.Log{log}, nil
+			},
+		}, &mockClient{
+			CallContextFn: func(ctx context.Context, receipt *evmtypes.Receipt, method string, args ...interface{}) error {
+				receipt.Status = 1
+				receipt.BlockNumber = big.NewInt(log.BlockNumber)
+				receipt.BlockHash = log.BlockHash
+				return nil
+			},
+		}, &mockStateReader{
+			SelectByWorkIDsFn: func(ctx context.Context, workIDs ...string) ([]ocr2keepers.UpkeepState, error) {
+				return make([]ocr2keepers.UpkeepState, len(workIDs)), nil
+			},
+		}, &mockedPacker{}, filterStore, NewOptions(100, big.NewInt(1)))
+	}
+
+	node := newNode()
+	_, err := node.Backfill(ctx, upkeepID.BigInt(), 100, 1_000)
+	require.NoError(t, err)
+	data, err := node.GetProposalData(ctx, proposal)
+	require.NoError(t, err)
+	assert.Equal(t, log.Data, data)
+
+	// backfills are kept in memory of the node they were enqueued on, peers without the same backfill don't
+	// recover the log and the proposal doesn't reach consensus
+	peer := newNode()
+	_, err = peer.GetProposalData(ctx, proposal)
+	require.EqualError(t, err, "log block is not recoverable")
+
+	_, err = peer.Backfill(ctx, upkeepID.BigInt(), 100, 1_000)
+	require.NoError(t, err)
+	data, err = peer.GetProposalData(ctx, proposal)
+	require.NoError(t, err)
+	assert.Equal(t, log.Data, data)
+}
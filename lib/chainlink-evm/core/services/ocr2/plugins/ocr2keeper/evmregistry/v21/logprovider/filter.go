@@ -52,6 +52,16 @@ func (f upkeepFilter) Select(logs ...logpoller.Log) []logpoller.Log {
 	return selected
 }
 
+// MatchesTriggerConfig returns a bool indicating if the log's topics data matches the selector and indexed topics
+// of the log trigger config.
+func MatchesTriggerConfig(log logpoller.Log, cfg LogTriggerConfig) bool {
+	f := upkeepFilter{
+		selector: cfg.FilterSelector,
+		topics:   []common.Hash{cfg.Topic0, cfg.Topic1, cfg.Topic2, cfg.Topic3},
+	}
+	return f.match(log)
+}
+
 // match returns a bool indicating if the log's topics data matches selector and indexed topics in upkeep filter.
 func (f upkeepFilter) match(log logpoller.Log) bool {
 	filters := f.topics[1:]
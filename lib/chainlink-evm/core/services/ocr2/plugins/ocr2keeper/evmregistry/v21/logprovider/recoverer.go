@@ -89,6 +89,10 @@ type logRecoverer struct {
 	blockTimeResolver *blockTimeResolver
 
 	finalityDepth int64
+
+	backfillLock   sync.Mutex
+	backfills      []*backfill
+	lastBackfillID int64
 }
 
 var _ LogRecoverer = &logRecoverer{}
@@ -141,6 +145,9 @@ func (r *logRecoverer) Start(ctx context.Context) error {
 					if err := r.recover(ctx); err != nil {
 						r.lggr.Warnw("failed to recover logs", "err", err)
 					}
+					if err := r.runBackfills(ctx); err != nil {
+						r.lggr.Warnw("failed to backfill logs", "err", err)
+					}
 				case <-ctx.Done():
 					return
 				}
@@ -229,7 +236,8 @@ func (r *logRecoverer) getLogTriggerCheckData(ctx context.Context, proposal ocr2
 		return nil, errors.New("log tx reorged")
 	}
 	logBlock := bn.Int64()
-	if isRecoverable := logBlock < offsetBlock && logBlock > start; !isRecoverable {
+	isRecoverable := logBlock < offsetBlock && (logBlock > start || r.inBackfillRange(proposal.UpkeepID.BigInt(), logBlock))
+	if !isRecoverable {
 		return nil, errors.New("log block is not recoverable")
 	}
 
@@ -255,7 +263,7 @@ func (r *logRecoverer) getLogTriggerCheckData(ctx context.Context, proposal ocr2
 	if len(filter.addr) == 0 {
 		return nil, fmt.Errorf("invalid filter found for upkeepID %s", proposal.UpkeepID.String())
 	}
-	if filter.configUpdateBlock > uint64(logBlock) {
+	if filter.configUpdateBlock > uint64(logBlock) && !r.inBackfillRange(proposal.UpkeepID.BigInt(), logBlock) {
 		return nil, fmt.Errorf("log block %d is before the filter configUpdateBlock %d for upkeepID %s", logBlock, filter.configUpdateBlock, proposal.UpkeepID.String())
 	}
 
@@ -583,6 +591,8 @@ func logToTrigger(log logpoller.Log) ocr2keepers.Trigger {
 }
 
 func (r *logRecoverer) clean(ctx context.Context) {
+	r.cleanBackfills()
+
 	r.lock.RLock()
 	var expired []string
 	for id, t := range r.visited {
@@ -629,7 +639,8 @@ func (r *logRecoverer) tryExpire(ctx context.Context, ids ...string) error {
 				// in case it was removed by another thread
 				continue
 			}
-			if logBlock := rec.payload.Trigger.LogTriggerExtension.BlockNumber; int64(logBlock) < start {
+			logBlock := rec.payload.Trigger.LogTriggerExtension.BlockNumber
+			if int64(logBlock) < start && !r.inBackfillRange(rec.payload.UpkeepID.BigInt(), int64(logBlock)) {
 				// we can't recover this log anymore, so we remove it from the visited list
 				lggr.Debugw("removing expired log: old block", "upkeepID", rec.payload.UpkeepID,
 					"latestBlock", latestBlock, "logBlock", logBlock, "start", start)
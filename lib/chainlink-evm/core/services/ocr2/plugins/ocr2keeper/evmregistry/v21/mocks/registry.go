@@ -148,6 +148,65 @@ func (_c *Registry_GetActiveUpkeepIDs_Call) RunAndReturn(run func(*bind.CallOpts
 	return _c
 }
 
+// GetMinBalance provides a mock function with given fields: opts, upkeepId
+func (_m *Registry) GetMinBalance(opts *bind.CallOpts, upkeepId *big.Int) (*big.Int, error) {
+	ret := _m.Called(opts, upkeepId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMinBalance")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, *big.Int) (*big.Int, error)); ok {
+		return rf(opts, upkeepId)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, *big.Int) *big.Int); ok {
+		r0 = rf(opts, upkeepId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts, *big.Int) error); ok {
+		r1 = rf(opts, upkeepId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Registry_GetMinBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMinBalance'
+type Registry_GetMinBalance_Call struct {
+	*mock.Call
+}
+
+// GetMinBalance is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+//   - upkeepId *big.Int
+func (_e *Registry_Expecter) GetMinBalance(opts interface{}, upkeepId interface{}) *Registry_GetMinBalance_Call {
+	return &Registry_GetMinBalance_Call{Call: _e.mock.On("GetMinBalance", opts, upkeepId)}
+}
+
+func (_c *Registry_GetMinBalance_Call) Run(run func(opts *bind.CallOpts, upkeepId *big.Int)) *Registry_GetMinBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Registry_GetMinBalance_Call) Return(_a0 *big.Int, _a1 error) *Registry_GetMinBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Registry_GetMinBalance_Call) RunAndReturn(run func(*bind.CallOpts, *big.Int) (*big.Int, error)) *Registry_GetMinBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetState provides a mock function with given fields: opts
 func (_m *Registry) GetState(opts *bind.CallOpts) (i_automation_v21_plus_common.GetState, error) {
 	ret := _m.Called(opts)
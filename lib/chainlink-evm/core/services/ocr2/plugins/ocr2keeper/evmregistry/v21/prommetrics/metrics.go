@@ -7,8 +7,9 @@ import (
 
 // Namespaces
 const (
-	NamespaceAutomationLogTrigger = "automation_log_trigger"
-	NamespaceAutomationStreams    = "automation_streams"
+	NamespaceAutomationLogTrigger     = "automation_log_trigger"
+	NamespaceAutomationStreams        = "automation_streams"
+	NamespaceAutomationOffchainConfig = "automation_offchain_config"
 )
 
 // Streams steps
@@ -36,6 +37,15 @@ const (
 	StreamsVersion03 = "v03"
 )
 
+// Offchain config ineligibility reasons
+const (
+	OffchainConfigReasonGasPriceTooHigh            = "gas_price_too_high"
+	OffchainConfigReasonOutsideExecutionWindow     = "outside_execution_window"
+	OffchainConfigReasonPerformIntervalNotElapsed  = "perform_interval_not_elapsed"
+	OffchainConfigReasonDailyPerformBudgetExceeded = "daily_perform_budget_exceeded"
+	OffchainConfigReasonL1DataFeeTooHigh           = "l1_data_fee_too_high"
+)
+
 // Metric labels
 const (
 	LogBufferFlowDirectionIngress = "ingress"
@@ -59,6 +69,13 @@ var (
 		Name:      "num_recoverer_missed_logs",
 		Help:      "How many valid log triggers were identified as being missed by the recoverer",
 	})
+	AutomationRecovererBackfilledLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: NamespaceAutomationLogTrigger,
+		Name:      "num_recoverer_backfilled_logs",
+		Help:      "How many logs were found by operator triggered backfills, by their status when found",
+	}, []string{
+		"status",
+	})
 	AutomationRecovererPendingPayloads = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: NamespaceAutomationLogTrigger,
 		Name:      "num_recoverer_pending_payloads",
@@ -105,4 +122,13 @@ var (
 		"version",
 		"status",
 	})
+
+	// Offchain config metrics
+	AutomationOffchainConfigIneligible = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: NamespaceAutomationOffchainConfig,
+		Name:      "num_ineligible_upkeeps",
+		Help:      "How many eligible check results were made ineligible by the upkeep offchain config",
+	}, []string{
+		"reason",
+	})
 )
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +48,11 @@ const (
 	// cleanupInterval decides when the expired items in cache will be deleted.
 	cleanupInterval            = 5 * time.Minute
 	logTriggerRefreshBatchSize = 32
+	// transmittedPerformTTL is how long a perform transmitted by the node is in flight when its UpkeepPerformed log
+	// isn't found, the transmit may have failed or been replaced.
+	transmittedPerformTTL = 10 * time.Minute
+	// transmitTimeSkew is how much earlier than the transmit time the block of a perform may be timestamped.
+	transmitTimeSkew = 5 * time.Second
 )
 
 var (
@@ -68,6 +74,7 @@ type Registry interface {
 	GetUpkeep(opts *bind.CallOpts, id *big.Int) (encoding.UpkeepInfo, error)
 	GetState(opts *bind.CallOpts) (ac.GetState, error)
 	GetActiveUpkeepIDs(opts *bind.CallOpts, startIndex *big.Int, maxCount *big.Int) ([]*big.Int, error)
+	GetMinBalance(opts *bind.CallOpts, upkeepId *big.Int) (*big.Int, error)
 	GetUpkeepPrivilegeConfig(opts *bind.CallOpts, upkeepId *big.Int) ([]byte, error)
 	GetUpkeepTriggerConfig(opts *bind.CallOpts, upkeepId *big.Int) ([]byte, error)
 	CheckCallback(opts *bind.CallOpts, id *big.Int, values [][]byte, extraData []byte) (ac.CheckCallback, error)
@@ -89,6 +96,7 @@ func NewEvmRegistry(
 	packer encoding.Packer,
 	blockSub *BlockSubscriber,
 	finalityDepth uint32,
+	states core.UpkeepStateReader,
 ) *EvmRegistry {
 	mercuryConfig := NewMercuryConfig(mc, core.StreamsCompatibleABI)
 	hc := http.DefaultClient
@@ -113,6 +121,7 @@ func NewEvmRegistry(
 		finalityDepth:    finalityDepth,
 		streams:          streams.NewStreamsLookup(mercuryConfig, blockSub, client.Client(), registry, lggr),
 		ge:               client.GasEstimator(),
+		states:           states,
 	}
 }
 
@@ -195,6 +204,12 @@ type EvmRegistry struct {
 	finalityDepth    uint32
 	streams          streams.Lookup
 	ge               gas.EvmFeeEstimator
+	states           core.UpkeepStateReader
+
+	transmittedMu sync.Mutex
+	// transmitted are the transmit times of the performs of the reports transmitted by the node, by upkeep id, oldest
+	// first. They are dropped once mined or after transmittedPerformTTL.
+	transmitted map[string][]time.Time
 }
 
 func (r *EvmRegistry) Name() string {
@@ -638,3 +653,120 @@ func (r *EvmRegistry) fetchUpkeepOffchainConfig(ctx context.Context, id *big.Int
 	}
 	return ui.OffchainConfig, nil
 }
+
+// PerformedAfter returns the block timestamps of the upkeep's finalized performs after the given time, from the
+// UpkeepPerformed logs of the transmit event provider.
+func (r *EvmRegistry) PerformedAfter(ctx context.Context, upkeepId *big.Int, after time.Time) ([]time.Time, error) {
+	return r.performedAfter(ctx, upkeepId, after, evmtypes.Finalized)
+}
+
+// PendingPerforms returns the times of the upkeep's performs that aren't finalized: the block timestamps of the
+// performs in unfinalized blocks and the transmit times of the performs transmitted by the node but not mined yet.
+func (r *EvmRegistry) PendingPerforms(ctx context.Context, upkeepId *big.Int) ([]time.Time, error) {
+	latest, err := r.poller.LatestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrHeadNotAvailable, err)
+	}
+
+	var pending []time.Time
+	if latest.BlockNumber > latest.FinalizedBlockNumber {
+		logs, err := r.poller.IndexedLogsByBlockRange(
+			ctx,
+			latest.FinalizedBlockNumber+1,
+			latest.BlockNumber,
+			ac.IAutomationV21PlusCommonUpkeepPerformed{}.Topic(),
+			r.addr,
+			1,
+			[]common.Hash{common.BigToHash(upkeepId)},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrLogReadFailure, err)
+		}
+		for _, l := range logs {
+			pending = append(pending, l.BlockTimestamp)
+		}
+	}
+
+	inFlight, err := r.inFlightPerforms(ctx, upkeepId)
+	if err != nil {
+		return nil, err
+	}
+	return append(pending, inFlight...), nil
+}
+
+// RecordTransmitted records the performs of a report transmitted by the node, they are pending performs of their
+// upkeeps until mined.
+func (r *EvmRegistry) RecordTransmitted(upkeeps []ocr2keepers.ReportedUpkeep) {
+	now := time.Now()
+
+	r.transmittedMu.Lock()
+	defer r.transmittedMu.Unlock()
+
+	if r.transmitted == nil {
+		r.transmitted = make(map[string][]time.Time)
+	}
+	for _, upkeep := range upkeeps {
+		id := upkeep.UpkeepID.String()
+		r.transmitted[id] = append(r.transmitted[id], now)
+	}
+}
+
+// inFlightPerforms returns the transmit times of the upkeep's performs transmitted by the node whose UpkeepPerformed
+// log wasn't found. A perform mined after a transmit is taken to be the perform of the oldest transmit before it.
+func (r *EvmRegistry) inFlightPerforms(ctx context.Context, upkeepId *big.Int) ([]time.Time, error) {
+	r.transmittedMu.Lock()
+	defer r.transmittedMu.Unlock()
+
+	id := upkeepId.String()
+	transmitted := r.transmitted[id]
+	expired := time.Now().Add(-transmittedPerformTTL)
+	for len(transmitted) > 0 && transmitted[0].Before(expired) {
+		transmitted = transmitted[1:]
+	}
+	if len(transmitted) == 0 {
+		delete(r.transmitted, id)
+		return nil, nil
+	}
+
+	mined, err := r.performedAfter(ctx, upkeepId, transmitted[0].Add(-transmitTimeSkew), evmtypes.Unconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(mined, time.Time.Compare)
+
+	var inFlight []time.Time
+	for _, transmittedAt := range transmitted {
+		for len(mined) > 0 && mined[0].Before(transmittedAt.Add(-transmitTimeSkew)) {
+			mined = mined[1:]
+		}
+		if len(mined) > 0 {
+			mined = mined[1:]
+			continue
+		}
+		inFlight = append(inFlight, transmittedAt)
+	}
+	r.transmitted[id] = inFlight
+
+	return slices.Clone(inFlight), nil
+}
+
+func (r *EvmRegistry) performedAfter(ctx context.Context, upkeepId *big.Int, after time.Time, confs evmtypes.Confirmations) ([]time.Time, error) {
+	logs, err := r.poller.IndexedLogsCreatedAfter(
+		ctx,
+		ac.IAutomationV21PlusCommonUpkeepPerformed{}.Topic(),
+		r.addr,
+		1,
+		[]common.Hash{common.BigToHash(upkeepId)},
+		after,
+		confs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLogReadFailure, err)
+	}
+
+	performs := make([]time.Time, len(logs))
+	for i, l := range logs {
+		performs[i] = l.BlockTimestamp
+	}
+	return performs, nil
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	coreTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/gasprice"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/prommetrics"
 )
 
 const (
@@ -27,6 +30,16 @@ const (
 	zeroAddress           = "0x0000000000000000000000000000000000000000"
 )
 
+// offchainConfigFailureReasons are the upkeep failure reasons of the checks against the upkeep offchain config,
+// mapped to their metric labels
+var offchainConfigFailureReasons = map[encoding.UpkeepFailureReason]string{
+	encoding.UpkeepFailureReasonGasPriceTooHigh:            prommetrics.OffchainConfigReasonGasPriceTooHigh,
+	encoding.UpkeepFailureReasonOutsideExecutionWindow:     prommetrics.OffchainConfigReasonOutsideExecutionWindow,
+	encoding.UpkeepFailureReasonPerformIntervalNotElapsed:  prommetrics.OffchainConfigReasonPerformIntervalNotElapsed,
+	encoding.UpkeepFailureReasonDailyPerformBudgetExceeded: prommetrics.OffchainConfigReasonDailyPerformBudgetExceeded,
+	encoding.UpkeepFailureReasonL1DataFeeTooHigh:           prommetrics.OffchainConfigReasonL1DataFeeTooHigh,
+}
+
 type checkResult struct {
 	cr  []ocr2keepers.CheckResult
 	err error
@@ -301,13 +314,9 @@ func (r *EvmRegistry) checkUpkeeps(ctx context.Context, payloads []ocr2keepers.U
 	return results, nil
 }
 
+// simulatePerformUpkeeps marks the eligible check results not eligible due to their upkeep's offchain config, counting
+// them in the offchain config metric, and simulates the perform of the remaining ones.
 func (r *EvmRegistry) simulatePerformUpkeeps(ctx context.Context, checkResults []ocr2keepers.CheckResult) ([]ocr2keepers.CheckResult, error) {
-	var (
-		performReqs     = make([]rpc.BatchElem, 0, len(checkResults))
-		performResults  = make([]*string, 0, len(checkResults))
-		performToKeyIdx = make([]int, 0, len(checkResults))
-	)
-
 	for i, cr := range checkResults {
 		if !cr.Eligible {
 			continue
@@ -320,15 +329,35 @@ func (r *EvmRegistry) simulatePerformUpkeeps(ctx context.Context, checkResults [
 			// this is mostly caused by RPC flakiness
 			r.lggr.Errorw("failed get offchain config, gas price check will be disabled", "err", err, "upkeepId", upkeepId, "block", block)
 		}
-		fr := gasprice.CheckGasPrice(ctx, upkeepId, oc, r.ge, r.lggr)
-		if uint8(fr) == uint8(encoding.UpkeepFailureReasonGasPriceTooHigh) {
-			r.lggr.Debugf("upkeep %s upkeep failure reason is %d", upkeepId, fr)
+		fr := r.checkOffchainConfig(ctx, upkeepId, oc, cr)
+		if reason, ok := offchainConfigFailureReasons[fr]; ok {
+			r.lggr.Debugw("upkeep is not eligible due to its offchain config", "upkeepId", upkeepId, "failureReason", fr, "reason", reason)
+			prommetrics.AutomationOffchainConfigIneligible.WithLabelValues(reason).Inc()
 			checkResults[i].Eligible = false
 			checkResults[i].Retryable = false
 			checkResults[i].IneligibilityReason = uint8(fr)
+		}
+	}
+
+	return r.simulatePerforms(ctx, checkResults)
+}
+
+// simulatePerforms simulates the perform of the eligible check results, marking the ones whose perform fails not
+// eligible. Unlike simulatePerformUpkeeps, it doesn't check the offchain config and has no side effects.
+func (r *EvmRegistry) simulatePerforms(ctx context.Context, checkResults []ocr2keepers.CheckResult) ([]ocr2keepers.CheckResult, error) {
+	var (
+		performReqs     = make([]rpc.BatchElem, 0, len(checkResults))
+		performResults  = make([]*string, 0, len(checkResults))
+		performToKeyIdx = make([]int, 0, len(checkResults))
+	)
+
+	for i, cr := range checkResults {
+		if !cr.Eligible {
 			continue
 		}
 
+		block, _, upkeepId := r.getBlockAndUpkeepId(cr.UpkeepID, cr.Trigger)
+
 		// Since checkUpkeep is true, simulate perform upkeep to ensure it doesn't revert
 		payload, err := r.abi.Pack("simulatePerformUpkeep", upkeepId, cr.PerformData)
 		if err != nil {
@@ -401,3 +430,50 @@ func (r *EvmRegistry) simulatePerformUpkeeps(ctx context.Context, checkResults [
 
 	return checkResults, nil
 }
+
+// checkOffchainConfig checks the gas price and the policies configured in the upkeep's offchain config for the check result
+func (r *EvmRegistry) checkOffchainConfig(ctx context.Context, upkeepId *big.Int, oc []byte, cr ocr2keepers.CheckResult) encoding.UpkeepFailureReason {
+	if len(oc) == 0 {
+		return encoding.UpkeepFailureReasonNone
+	}
+	fr := gasprice.CheckGasPrice(ctx, upkeepId, oc, r.ge, r.lggr)
+	if fr != encoding.UpkeepFailureReasonNone {
+		return fr
+	}
+	transmitTx, err := r.transmitTx(cr)
+	if err != nil {
+		r.lggr.Warnw("failed to build transmit transaction, max L1 data fee check will be disabled", "err", err, "upkeepId", upkeepId)
+	}
+	checkTime, err := r.checkBlockTime(ctx, cr.Trigger.BlockNumber)
+	if err != nil {
+		r.lggr.Warnw("failed to get the check block timestamp, checking the policies at the current time", "err", err, "upkeepId", upkeepId, "block", cr.Trigger.BlockNumber)
+		checkTime = time.Now()
+	}
+	return gasprice.CheckPolicies(ctx, upkeepId, oc, checkTime, r, r.ge, transmitTx, r.lggr)
+}
+
+// checkBlockTime returns the timestamp of the block the upkeep was checked at
+func (r *EvmRegistry) checkBlockTime(ctx context.Context, block ocr2keepers.BlockNumber) (time.Time, error) {
+	blocks, err := r.poller.GetBlocksRange(ctx, []uint64{uint64(block)})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(blocks) == 0 {
+		return time.Time{}, fmt.Errorf("block %d not found", block)
+	}
+	return blocks[0].BlockTimestamp, nil
+}
+
+// transmitTx returns the transmit transaction of a report performing only the check result, whose calldata the L1 data
+// fee of the perform is charged for. The signatures are not known before the report is signed and are left empty.
+func (r *EvmRegistry) transmitTx(cr ocr2keepers.CheckResult) (*coreTypes.Transaction, error) {
+	report, err := encoding.NewReportEncoder(r.packer).Encode(cr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+	data, err := core.RegistryMasterV21ABI.Pack("transmit", [3][32]byte{}, report, [][32]byte{}, [][32]byte{}, [32]byte{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack transmit: %w", err)
+	}
+	return coreTypes.NewTx(&coreTypes.DynamicFeeTx{To: &r.addr, Gas: cr.GasAllocated, Data: data}), nil
+}
@@ -664,6 +664,39 @@ func TestRegistry_SimulatePerformUpkeeps(t *testing.T) {
 	}
 }
 
+func TestRegistry_TransmitTx(t *testing.T) {
+	e := setupEVMRegistry(t)
+	extension := &ocr2keepers.LogTriggerExtension{
+		TxHash:      common.HexToHash("0xc8def8abdcf3a4eaaf6cc13bff3e4e2a7168d86ea41dbbf97451235aa76c3651"),
+		BlockHash:   common.HexToHash("0x9840e5b709bfccf6a1b44f34c884bc39403f57923f3f5ead6243cc090546b857"),
+		BlockNumber: 550,
+	}
+	cr := ocr2keepers.CheckResult{
+		Eligible:     true,
+		UpkeepID:     core.GenUpkeepID(autotypes.LogTrigger, "p1"),
+		Trigger:      ocr2keepers.NewLogTrigger(570, common.HexToHash("0x1222d75217e2dd461cc77e4091c37abe76277430d97f1963a822b4e94ebb83fc"), extension),
+		GasAllocated: 20000,
+		PerformData:  []byte{0, 0, 0, 1, 2, 3},
+		FastGasWei:   big.NewInt(20000),
+		LinkNative:   big.NewInt(20000),
+	}
+
+	tx, err := e.transmitTx(cr)
+	require.NoError(t, err)
+	assert.Equal(t, e.addr, *tx.To())
+
+	// the L1 data fee is estimated for the transmit calldata, which carries the encoded report
+	transmit := core.RegistryMasterV21ABI.Methods["transmit"]
+	require.Equal(t, transmit.ID, tx.Data()[:4])
+	args, err := transmit.Inputs.Unpack(tx.Data()[4:])
+	require.NoError(t, err)
+	report, err := e.packer.UnpackReport(args[1].([]byte))
+	require.NoError(t, err)
+	require.Len(t, report.UpkeepIds, 1)
+	assert.Zero(t, cr.UpkeepID.BigInt().Cmp(report.UpkeepIds[0]))
+	assert.Equal(t, cr.PerformData, report.PerformDatas[0])
+}
+
 // setups up an evm registry for tests.
 func setupEVMRegistry(t *testing.T) *EvmRegistry {
 	lggr := logger.Test(t)
@@ -17,6 +17,7 @@ import (
 	autotypes "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	evmheads "github.com/smartcontractkit/chainlink-evm/pkg/heads"
@@ -611,3 +612,38 @@ type mockPacker struct {
 func (p *mockPacker) UnpackLogTriggerConfig(raw []byte) (ac.IAutomationV21PlusCommonLogTriggerConfig, error) {
 	return p.UnpackLogTriggerConfigFn(raw)
 }
+
+func TestRegistry_PendingPerforms(t *testing.T) {
+	ctx := testutils.Context(t)
+	addr := common.HexToAddress("0x6cA639822c6C241Fa9A7A6b5032F6F7F1C513CAD")
+	upkeepID := big.NewInt(1)
+	performed := autov2common.IAutomationV21PlusCommonUpkeepPerformed{}.Topic()
+	topics := []common.Hash{common.BigToHash(upkeepID)}
+
+	mp := mocks.NewLogPoller(t)
+	r := &EvmRegistry{lggr: logger.Sugared(logger.Test(t)), poller: mp, addr: addr}
+
+	unfinalized := time.Now().Add(-time.Minute)
+	mp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 110, FinalizedBlockNumber: 100}, nil)
+	mp.On("IndexedLogsByBlockRange", mock.Anything, int64(101), int64(110), performed, addr, 1, topics).
+		Return([]logpoller.Log{{BlockTimestamp: unfinalized}}, nil)
+
+	// one of the two transmitted performs was mined
+	r.RecordTransmitted([]ocr2keepers.ReportedUpkeep{{UpkeepID: ocr2keepers.UpkeepIdentifier(common.BigToHash(upkeepID))}})
+	r.RecordTransmitted([]ocr2keepers.ReportedUpkeep{{UpkeepID: ocr2keepers.UpkeepIdentifier(common.BigToHash(upkeepID))}})
+	transmittedAt := r.transmitted[upkeepID.String()][1]
+	mp.On("IndexedLogsCreatedAfter", mock.Anything, performed, addr, 1, topics, mock.Anything, evmtypes.Unconfirmed).
+		Return([]logpoller.Log{{BlockTimestamp: time.Now().Add(time.Second)}}, nil).Once()
+
+	pending, err := r.PendingPerforms(ctx, upkeepID)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{unfinalized, transmittedAt}, pending)
+	assert.Len(t, r.transmitted[upkeepID.String()], 1)
+
+	// transmitted performs that weren't mined expire
+	r.transmitted[upkeepID.String()] = []time.Time{time.Now().Add(-transmittedPerformTTL - time.Second)}
+	pending, err = r.PendingPerforms(ctx, upkeepID)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{unfinalized}, pending)
+	assert.NotContains(t, r.transmitted, upkeepID.String())
+}
@@ -74,14 +74,26 @@ func NewChainReaderService(_ context.Context, lggr logger.Logger, lp logpoller.L
 		return nil, err
 	}
 
-	cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
-		cr.lggr,
-		cr.codec,
-		cr.client,
-		read.DefaultRpcBatchSizeLimit,
-		read.DefaultRpcBatchBackOffMultiplier,
-		read.DefaultMaxParallelRpcCalls,
-	))
+	if config.Multicall3Address != nil {
+		cr.bindings.SetBatchCaller(read.NewMulticall3BatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			*config.Multicall3Address,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	} else {
+		cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	}
 
 	cr.bindings.SetCodecAll(cr.codec)
 
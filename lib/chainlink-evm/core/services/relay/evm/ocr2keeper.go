@@ -132,15 +132,49 @@ func (r *ocr2keeperRelayer) NewOCR2KeeperProvider(ctx context.Context, rargs com
 	al := evm.NewActiveUpkeepList()
 	services.payloadBuilder = evm.NewPayloadBuilder(al, logRecoverer, r.lggr)
 
-	services.registry = evm.NewEvmRegistry(r.lggr, addr, client,
+	registry := evm.NewEvmRegistry(r.lggr, addr, client,
 		registryContract, rargs.MercuryCredentials, al, logProvider,
-		packer, blockSubscriber, finalityDepth)
+		packer, blockSubscriber, finalityDepth, services.upkeepStateStore)
+	services.registry = registry
+	services.contractTransmitter = &performRecordingContractTransmitter{
+		ContractTransmitter: contractTransmitter,
+		lggr:                r.lggr,
+		encoder:             services.encoder,
+		registry:            registry,
+	}
 
 	services.conditionalUpkeepProvider = evm.NewUpkeepProvider(al, blockSubscriber, client.LogPoller())
 
 	return services, nil
 }
 
+// performRecordingContractTransmitter records the performs of the transmitted reports with the registry, they count
+// against the perform policies of their upkeeps until mined.
+type performRecordingContractTransmitter struct {
+	ContractTransmitter
+	lggr     logger.Logger
+	encoder  automation.Encoder
+	registry *evm.EvmRegistry
+}
+
+func (t *performRecordingContractTransmitter) Transmit(
+	ctx context.Context,
+	reportCtx ocrtypes.ReportContext,
+	report ocrtypes.Report,
+	sigs []ocrtypes.AttributedOnchainSignature,
+) error {
+	if err := t.ContractTransmitter.Transmit(ctx, reportCtx, report, sigs); err != nil {
+		return err
+	}
+	upkeeps, err := t.encoder.Extract(report)
+	if err != nil {
+		t.lggr.Warnw("failed to extract the performs of a transmitted report", "err", err)
+		return nil
+	}
+	t.registry.RecordTransmitted(upkeeps)
+	return nil
+}
+
 type ocr3keeperProviderContractTransmitter struct {
 	contractTransmitter ocrtypes.ContractTransmitter
 }
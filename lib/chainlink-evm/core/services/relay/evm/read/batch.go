@@ -136,10 +136,7 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 		return nil, nil
 	}
 
-	blockNumStr := "latest"
-	if blockNumber > 0 {
-		blockNumStr = hexutil.EncodeBig(big.NewInt(0).SetUint64(blockNumber))
-	}
+	blockNumStr := blockNumberToString(blockNumber)
 
 	rpcBatchCalls, hexEncodedOutputs, err := c.createBatchCalls(ctx, batchCall, blockNumStr)
 	if err != nil {
@@ -172,14 +169,9 @@ func (c *defaultEvmBatchCaller) createBatchCalls(
 	hexEncodedOutputs := make([]string, len(batchCall))
 
 	for idx, call := range batchCall {
-		data, err := c.codec.Encode(ctx, call.Params, codec.WrapItemType(call.ContractName, call.ReadName, true))
+		data, err := c.encodeCall(ctx, call, block)
 		if err != nil {
-			return nil, nil, newErrorFromCall(
-				fmt.Errorf("%w: encode params: %s", types.ErrInvalidConfig, err.Error()),
-				call,
-				block,
-				batchReadType,
-			)
+			return nil, nil, err
 		}
 
 		rpcBatchCalls[idx] = rpc.BatchElem{
@@ -243,36 +235,10 @@ func (c *defaultEvmBatchCaller) unpackBatchResults(
 			return nil, callErr
 		}
 
-		// the codec can't do anything with no bytes, so skip decoding and allow
-		// the result to be the empty struct or value
-		if len(packedBytes) > 0 {
-			if err = c.codec.Decode(
-				ctx,
-				packedBytes,
-				call.ReturnVal,
-				codec.WrapItemType(call.ContractName, call.ReadName, false),
-			); err != nil {
-				if len(packedBytes) == 0 {
-					callErr := newErrorFromCall(
-						fmt.Errorf("%w: %w: %s", types.ErrInternal, errEmptyOutput, err.Error()),
-						call, block, batchReadType,
-					)
-
-					callErr.Result = &hexEncodedOutputs[idx]
-
-					results[idx].err = callErr
-				} else {
-					callErr := newErrorFromCall(
-						fmt.Errorf("%w: codec decode result: %s", types.ErrInvalidType, err.Error()),
-						call, block, batchReadType,
-					)
-
-					callErr.Result = &hexEncodedOutputs[idx]
-					results[idx].err = callErr
-				}
+		if err = c.decodeCallResult(ctx, call, packedBytes, &hexEncodedOutputs[idx], block); err != nil {
+			results[idx].err = err
 
-				continue
-			}
+			continue
 		}
 
 		results[idx].returnVal = call.ReturnVal
@@ -281,7 +247,65 @@ func (c *defaultEvmBatchCaller) unpackBatchResults(
 	return results, nil
 }
 
+// encodeCall encodes the call params into the contract call data.
+func (c *defaultEvmBatchCaller) encodeCall(ctx context.Context, call Call, block string) ([]byte, error) {
+	data, err := c.codec.Encode(ctx, call.Params, codec.WrapItemType(call.ContractName, call.ReadName, true))
+	if err != nil {
+		return nil, newErrorFromCall(
+			fmt.Errorf("%w: encode params: %s", types.ErrInvalidConfig, err.Error()),
+			call,
+			block,
+			batchReadType,
+		)
+	}
+
+	return data, nil
+}
+
+// decodeCallResult decodes the packed result of a call into the call return value.
+func (c *defaultEvmBatchCaller) decodeCallResult(
+	ctx context.Context,
+	call Call,
+	packedBytes []byte,
+	hexEncodedOutput *string,
+	block string,
+) error {
+	// the codec can't do anything with no bytes, so skip decoding and allow
+	// the result to be the empty struct or value
+	if len(packedBytes) == 0 {
+		return nil
+	}
+
+	if err := c.codec.Decode(
+		ctx,
+		packedBytes,
+		call.ReturnVal,
+		codec.WrapItemType(call.ContractName, call.ReadName, false),
+	); err != nil {
+		callErr := newErrorFromCall(
+			fmt.Errorf("%w: codec decode result: %s", types.ErrInvalidType, err.Error()),
+			call, block, batchReadType,
+		)
+
+		callErr.Result = hexEncodedOutput
+
+		return callErr
+	}
+
+	return nil
+}
+
 func (c *defaultEvmBatchCaller) batchCallDynamicLimitRetries(ctx context.Context, blockNumber uint64, calls BatchCall) (BatchResult, error) {
+	results, err := c.batchCallDynamicLimitRetriesOrdered(ctx, blockNumber, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToBatchResult(results), nil
+}
+
+// batchCallDynamicLimitRetriesOrdered is batchCallDynamicLimitRetries returning the results in the order of the calls.
+func (c *defaultEvmBatchCaller) batchCallDynamicLimitRetriesOrdered(ctx context.Context, blockNumber uint64, calls BatchCall) ([]dataAndErr, error) {
 	lim := c.batchSizeLimit
 
 	// Limit the batch size to the number of calls
@@ -313,6 +337,15 @@ func (c *defaultEvmBatchCaller) batchCallDynamicLimitRetries(ctx context.Context
 	}
 }
 
+// blockNumberToString returns the eth_call block parameter, blockNumber=0 is the latest block.
+func blockNumberToString(blockNumber uint64) string {
+	if blockNumber == 0 {
+		return "latest"
+	}
+
+	return hexutil.EncodeBig(big.NewInt(0).SetUint64(blockNumber))
+}
+
 type dataAndErr struct {
 	address                  string
 	contractName, methodName string
@@ -320,11 +353,9 @@ type dataAndErr struct {
 	err                      error
 }
 
-func (c *defaultEvmBatchCaller) batchCallLimit(ctx context.Context, blockNumber uint64, calls BatchCall, batchSizeLimit uint) (BatchResult, error) {
+func (c *defaultEvmBatchCaller) batchCallLimit(ctx context.Context, blockNumber uint64, calls BatchCall, batchSizeLimit uint) ([]dataAndErr, error) {
 	if batchSizeLimit <= 0 {
-		res, err := c.batchCall(ctx, blockNumber, calls)
-
-		return convertToBatchResult(res), err
+		return c.batchCall(ctx, blockNumber, calls)
 	}
 
 	type job struct {
@@ -383,7 +414,7 @@ func (c *defaultEvmBatchCaller) batchCallLimit(ctx context.Context, blockNumber
 		results = append(results, jb.results...)
 	}
 
-	return convertToBatchResult(results), nil
+	return results, nil
 }
 
 func convertToBatchResult(data []dataAndErr) BatchResult {
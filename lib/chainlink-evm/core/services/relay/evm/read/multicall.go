@@ -0,0 +1,287 @@
+package read
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	// DefaultMulticall3CallsLimit defines the maximum number of calls aggregated into a single Multicall3 call.
+	DefaultMulticall3CallsLimit = 250
+
+	// multicall3NotDeployedTTL is how long a deployment check that found no Multicall3 contract is reused, the
+	// contract can be deployed after the node started.
+	multicall3NotDeployedTTL = 10 * time.Minute
+
+	multicall3Aggregate3 = "aggregate3"
+
+	// Multicall3ABI is the ABI of the Multicall3 aggregate3 method.
+	Multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+)
+
+// CanonicalMulticall3Address is the address Multicall3 is deployed at on most EVM chains, see https://www.multicall3.com.
+var CanonicalMulticall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+var multicall3Parsed = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(Multicall3ABI))
+	if err != nil {
+		panic(fmt.Errorf("parse multicall3 abi: %w", err))
+	}
+
+	return parsed
+}()
+
+// multicall3Call is the Multicall3.Call3 struct.
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// multicall3Result is the Multicall3.Result struct.
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicall3BatchCaller packs calls into Multicall3 aggregate3 calls, reverting calls fail individually. Calls are
+// made with JSON-RPC batching when Multicall3 is not deployed on the chain, or not yet deployed at the requested block.
+type multicall3BatchCaller struct {
+	lggr       logger.Logger
+	address    common.Address
+	bc         *defaultEvmBatchCaller
+	callsLimit uint
+	clock      clockwork.Clock
+
+	deployedMu sync.Mutex
+	deployed   bool
+	// notDeployedAt is when the last deployment check found no contract, zero when not checked yet.
+	notDeployedAt time.Time
+}
+
+// NewMulticall3BatchCaller returns a BatchCaller aggregating calls with the Multicall3 contract at multicall3Address.
+// batchSizeLimit, backOffMultiplier and parallelRpcCallsLimit configure the JSON-RPC batching fallback, see
+// NewDynamicLimitedBatchCaller.
+func NewMulticall3BatchCaller(
+	lggr logger.Logger,
+	codec types.Codec,
+	evmClient EVMBatchCaller,
+	multicall3Address common.Address,
+	batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit uint,
+) BatchCaller {
+	return &multicall3BatchCaller{
+		lggr:       logger.Named(lggr, "Multicall3BatchCaller"),
+		address:    multicall3Address,
+		bc:         newDefaultEvmBatchCaller(lggr, evmClient, codec, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit),
+		callsLimit: DefaultMulticall3CallsLimit,
+		clock:      clockwork.NewRealClock(),
+	}
+}
+
+func (c *multicall3BatchCaller) BatchCall(ctx context.Context, blockNumber uint64, reqs BatchCall) (BatchResult, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	deployed, err := c.isDeployed(ctx)
+	if err != nil {
+		c.lggr.Warnw("failed to check Multicall3 deployment, using JSON-RPC batching", "address", c.address, "err", err)
+	}
+	if !deployed {
+		return c.bc.batchCallDynamicLimitRetries(ctx, blockNumber, reqs)
+	}
+
+	block := blockNumberToString(blockNumber)
+	results := make([]dataAndErr, len(reqs))
+
+	var fallbackIdxs []int
+	for from := 0; from < len(reqs); from += int(c.callsLimit) {
+		to := min(from+int(c.callsLimit), len(reqs))
+
+		aggregated, ok, err := c.aggregate3(ctx, reqs[from:to], block)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			for idx := from; idx < to; idx++ {
+				fallbackIdxs = append(fallbackIdxs, idx)
+			}
+
+			continue
+		}
+
+		copy(results[from:to], aggregated)
+	}
+
+	if len(fallbackIdxs) > 0 {
+		fallbackCalls := make(BatchCall, 0, len(fallbackIdxs))
+		for _, idx := range fallbackIdxs {
+			fallbackCalls = append(fallbackCalls, reqs[idx])
+		}
+
+		fallbackResults, err := c.bc.batchCallDynamicLimitRetriesOrdered(ctx, blockNumber, fallbackCalls)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, idx := range fallbackIdxs {
+			results[idx] = fallbackResults[i]
+		}
+	}
+
+	return convertToBatchResult(results), nil
+}
+
+// aggregate3 makes the calls with a single Multicall3 aggregate3 call. Returns false when Multicall3 can't make the
+// calls, in which case they should be made with JSON-RPC batching.
+func (c *multicall3BatchCaller) aggregate3(ctx context.Context, calls BatchCall, block string) ([]dataAndErr, bool, error) {
+	multicalls := make([]multicall3Call, len(calls))
+	for idx, call := range calls {
+		data, err := c.bc.encodeCall(ctx, call, block)
+		if err != nil {
+			return nil, false, err
+		}
+
+		multicalls[idx] = multicall3Call{Target: call.ContractAddress, AllowFailure: true, CallData: data}
+	}
+
+	packed, err := multicall3Parsed.Pack(multicall3Aggregate3, multicalls)
+	if err != nil {
+		return nil, false, newErrorFromCalls(
+			fmt.Errorf("%w: pack multicall3 calls: %s", types.ErrInternal, err.Error()),
+			calls, block, batchReadType,
+		)
+	}
+
+	var hexEncodedOutput string
+	rpcBatchCalls := []rpc.BatchElem{{
+		Method: "eth_call",
+		Args: []any{
+			map[string]interface{}{
+				"from": common.Address{},
+				"to":   c.address,
+				"data": hexutil.Bytes(packed),
+			},
+			block,
+		},
+		Result: &hexEncodedOutput,
+	}}
+
+	if err = c.bc.evmClient.BatchCallContext(ctx, rpcBatchCalls); err != nil {
+		return nil, false, Error{
+			Err:  fmt.Errorf("%w: batch call context: %s", types.ErrInternal, err.Error()),
+			Type: batchReadType,
+		}
+	}
+
+	// the multicall itself fails when a call runs out of gas or Multicall3 was deployed after the block
+	if rpcBatchCalls[0].Error != nil {
+		c.lggr.Debugw("Multicall3 call failed, using JSON-RPC batching",
+			"calls", len(calls), "block", block, "err", rpcBatchCalls[0].Error)
+
+		return nil, false, nil
+	}
+
+	packedOutput, err := hexutil.Decode(hexEncodedOutput)
+	if err != nil || len(packedOutput) == 0 {
+		c.lggr.Debugw("Multicall3 call failed, using JSON-RPC batching",
+			"calls", len(calls), "block", block, "err", errEmptyOutput, "output", hexEncodedOutput)
+
+		return nil, false, nil
+	}
+
+	unpacked, err := multicall3Parsed.Unpack(multicall3Aggregate3, packedOutput)
+	if err != nil || len(unpacked) != 1 {
+		callErr := newErrorFromCalls(
+			fmt.Errorf("%w: unpack multicall3 results: %v", types.ErrInternal, err),
+			calls, block, batchReadType,
+		)
+		callErr.Result = &hexEncodedOutput
+
+		return nil, false, callErr
+	}
+
+	multicallResults := *abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(multicallResults) != len(calls) {
+		return nil, false, newErrorFromCalls(
+			fmt.Errorf("%w: got %d multicall3 results for %d calls", types.ErrInternal, len(multicallResults), len(calls)),
+			calls, block, batchReadType,
+		)
+	}
+
+	results := make([]dataAndErr, len(calls))
+	for idx, call := range calls {
+		results[idx] = dataAndErr{
+			address:      call.ContractAddress.Hex(),
+			contractName: call.ContractName,
+			methodName:   call.ReadName,
+			returnVal:    call.ReturnVal,
+		}
+
+		hexEncodedResult := hexutil.Encode(multicallResults[idx].ReturnData)
+		if !multicallResults[idx].Success {
+			callErr := newErrorFromCall(
+				fmt.Errorf("%w: multicall3 call reverted", types.ErrInternal),
+				call, block, batchReadType,
+			)
+			callErr.Result = &hexEncodedResult
+			results[idx].err = callErr
+
+			continue
+		}
+
+		if err = c.bc.decodeCallResult(ctx, call, multicallResults[idx].ReturnData, &hexEncodedResult, block); err != nil {
+			results[idx].err = err
+		}
+	}
+
+	return results, true, nil
+}
+
+// isDeployed returns whether the Multicall3 contract is deployed. A deployed contract is checked once, a missing one
+// is checked again after multicall3NotDeployedTTL.
+func (c *multicall3BatchCaller) isDeployed(ctx context.Context) (bool, error) {
+	c.deployedMu.Lock()
+	defer c.deployedMu.Unlock()
+
+	if c.deployed {
+		return true, nil
+	}
+	if !c.notDeployedAt.IsZero() && c.clock.Since(c.notDeployedAt) < multicall3NotDeployedTTL {
+		return false, nil
+	}
+
+	var code hexutil.Bytes
+	rpcBatchCalls := []rpc.BatchElem{{
+		Method: "eth_getCode",
+		Args:   []any{c.address, "latest"},
+		Result: &code,
+	}}
+	if err := c.bc.evmClient.BatchCallContext(ctx, rpcBatchCalls); err != nil {
+		return false, err
+	}
+	if rpcBatchCalls[0].Error != nil {
+		return false, rpcBatchCalls[0].Error
+	}
+
+	c.deployed = len(code) > 0
+	if !c.deployed {
+		c.lggr.Warnw("Multicall3 is not deployed, using JSON-RPC batching", "address", c.address,
+			"recheckAfter", multicall3NotDeployedTTL)
+		c.notDeployedAt = c.clock.Now()
+	}
+
+	return c.deployed, nil
+}
@@ -0,0 +1,54 @@
+package read
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestMulticall3BatchCaller_isDeployed(t *testing.T) {
+	var code []byte
+	ec := clienttest.NewClient(t)
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		require.Equal(t, "eth_getCode", elems[0].Method)
+		*elems[0].Result.(*hexutil.Bytes) = code
+	}).Return(nil)
+
+	clock := clockwork.NewFakeClock()
+	bc := NewMulticall3BatchCaller(logger.Test(t), nil, ec, CanonicalMulticall3Address, 0, 0, 1).(*multicall3BatchCaller)
+	bc.clock = clock
+	ctx := testutils.Context(t)
+
+	deployed, err := bc.isDeployed(ctx)
+	require.NoError(t, err)
+	require.False(t, deployed)
+
+	// a missing contract is not checked again until the check expires
+	code = []byte{0x1}
+	deployed, err = bc.isDeployed(ctx)
+	require.NoError(t, err)
+	require.False(t, deployed)
+	ec.AssertNumberOfCalls(t, "BatchCallContext", 1)
+
+	clock.Advance(multicall3NotDeployedTTL)
+	deployed, err = bc.isDeployed(ctx)
+	require.NoError(t, err)
+	require.True(t, deployed)
+	ec.AssertNumberOfCalls(t, "BatchCallContext", 2)
+
+	// a deployed contract is checked once
+	clock.Advance(multicall3NotDeployedTTL)
+	deployed, err = bc.isDeployed(ctx)
+	require.NoError(t, err)
+	require.True(t, deployed)
+	ec.AssertNumberOfCalls(t, "BatchCallContext", 2)
+}
@@ -0,0 +1,181 @@
+package read_test
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestMulticall3BatchCaller(t *testing.T) {
+	type MethodParam struct {
+		A uint64
+	}
+	type MethodReturn struct {
+		B uint64
+	}
+
+	const (
+		numCalls     = 5
+		contractName = "Contract"
+		// the multicall of this call reverts
+		revertingCall = 3
+	)
+	multicall3, err := abi.JSON(strings.NewReader(read.Multicall3ABI))
+	require.NoError(t, err)
+
+	codecConfig := evmtypes.CodecConfig{Configs: map[string]evmtypes.ChainCodecConfig{}}
+	for j := 0; j < numCalls; j++ {
+		methodName := fmt.Sprintf("method_%d", j)
+		codecConfig.Configs[fmt.Sprintf("params.%s.%s", contractName, methodName)] = evmtypes.ChainCodecConfig{
+			TypeABI: `[{"type":"uint64","name":"A"}]`,
+		}
+		codecConfig.Configs[fmt.Sprintf("return.%s.%s", contractName, methodName)] = evmtypes.ChainCodecConfig{
+			TypeABI: `[{"type":"uint64","name":"B"}]`,
+		}
+	}
+	testCodec, err := codec.NewCodec(codecConfig)
+	require.NoError(t, err)
+
+	newCalls := func() read.BatchCall {
+		calls := make(read.BatchCall, numCalls)
+		for j := range calls {
+			calls[j] = read.Call{
+				ContractAddress: common.BigToAddress(big.NewInt(int64(j + 1))),
+				ContractName:    contractName,
+				ReadName:        fmt.Sprintf("method_%d", j),
+				Params:          &MethodParam{A: uint64(j)},
+				ReturnVal:       new(MethodReturn),
+			}
+		}
+		return calls
+	}
+
+	// returns B = 10 * A
+	returnData := func(callData []byte) []byte {
+		return common.LeftPadBytes(big.NewInt(10*new(big.Int).SetBytes(callData[24:]).Int64()).Bytes(), 32)
+	}
+
+	// newClient serves the JSON-RPC calls, counting the eth_call batches sent to Multicall3 and to the contracts
+	newClient := func(t *testing.T, code []byte, multicallOutput bool) (*clienttest.Client, *int, *int) {
+		var multicalls, rpcBatches int
+		ec := clienttest.NewClient(t)
+		ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			if elems[0].Method == "eth_getCode" {
+				*elems[0].Result.(*hexutil.Bytes) = code
+				return
+			}
+
+			req := elems[0].Args[0].(map[string]interface{})
+			if req["to"] != read.CanonicalMulticall3Address {
+				rpcBatches++
+				for i := range elems {
+					data := elems[i].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+					*elems[i].Result.(*string) = hexutil.Encode(returnData(data))
+				}
+				return
+			}
+
+			multicalls++
+			if !multicallOutput {
+				*elems[0].Result.(*string) = "0x"
+				return
+			}
+
+			data := req["data"].(hexutil.Bytes)
+			unpacked, err := multicall3.Methods["aggregate3"].Inputs.Unpack(data[4:])
+			require.NoError(t, err)
+			calls := *abi.ConvertType(unpacked[0], new([]struct {
+				Target       common.Address
+				AllowFailure bool
+				CallData     []byte
+			})).(*[]struct {
+				Target       common.Address
+				AllowFailure bool
+				CallData     []byte
+			})
+
+			type result struct {
+				Success    bool
+				ReturnData []byte
+			}
+			results := make([]result, len(calls))
+			for i, call := range calls {
+				require.True(t, call.AllowFailure)
+				results[i] = result{Success: i != revertingCall, ReturnData: returnData(call.CallData)}
+			}
+			out, err := multicall3.Methods["aggregate3"].Outputs.Pack(results)
+			require.NoError(t, err)
+			*elems[0].Result.(*string) = hexutil.Encode(out)
+		}).Return(nil)
+
+		return ec, &multicalls, &rpcBatches
+	}
+
+	assertResults := func(t *testing.T, results read.BatchResult, revertIdx int) {
+		require.Len(t, results[contractName], numCalls)
+		for j, result := range results[contractName] {
+			assert.Equal(t, fmt.Sprintf("method_%d", j), result.MethodName)
+			if j == revertIdx {
+				require.ErrorIs(t, result.Err, commontypes.ErrInternal)
+				continue
+			}
+			require.NoError(t, result.Err)
+			assert.Equal(t, uint64(10*j), result.ReturnValue.(*MethodReturn).B)
+		}
+	}
+
+	t.Run("aggregates calls", func(t *testing.T) {
+		ec, multicalls, rpcBatches := newClient(t, []byte{0x1}, true)
+		bc := read.NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, read.CanonicalMulticall3Address, 0, 0, 1)
+
+		for i := 0; i < 2; i++ {
+			results, err := bc.BatchCall(testutils.Context(t), 0, newCalls())
+			require.NoError(t, err)
+			assertResults(t, results, revertingCall)
+		}
+		assert.Equal(t, 2, *multicalls)
+		assert.Zero(t, *rpcBatches)
+		// the deployment is checked once
+		ec.AssertNumberOfCalls(t, "BatchCallContext", 3)
+	})
+
+	t.Run("falls back to JSON-RPC batching without Multicall3", func(t *testing.T) {
+		ec, multicalls, rpcBatches := newClient(t, nil, true)
+		bc := read.NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, read.CanonicalMulticall3Address, 0, 0, 1)
+
+		results, err := bc.BatchCall(testutils.Context(t), 0, newCalls())
+		require.NoError(t, err)
+		assertResults(t, results, -1)
+		assert.Zero(t, *multicalls)
+		assert.Equal(t, 1, *rpcBatches)
+	})
+
+	t.Run("falls back to JSON-RPC batching when Multicall3 returns nothing", func(t *testing.T) {
+		ec, multicalls, rpcBatches := newClient(t, []byte{0x1}, false)
+		bc := read.NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, read.CanonicalMulticall3Address, 0, 0, 1)
+
+		results, err := bc.BatchCall(testutils.Context(t), 100, newCalls())
+		require.NoError(t, err)
+		assertResults(t, results, -1)
+		assert.Equal(t, 1, *multicalls)
+		assert.Equal(t, 1, *rpcBatches)
+	})
+}
@@ -46,6 +46,9 @@ type ChainWriterDefinition struct {
 type ChainReaderConfig struct {
 	// Contracts key is contract name
 	Contracts map[string]ChainContractReader `json:"contracts" toml:"contracts"`
+	// Multicall3Address enables aggregating batched reads into Multicall3 calls, batched reads are sent as
+	// JSON-RPC batches when unset or when Multicall3 isn't deployed at this address.
+	Multicall3Address *common.Address `json:"multicall3Address,omitempty" toml:"multicall3Address,omitempty"`
 }
 
 type CodecConfig struct {
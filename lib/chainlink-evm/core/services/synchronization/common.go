@@ -37,6 +37,34 @@ const (
 	LLOReport      TelemetryType = "llo-report"
 )
 
+// TelemetryTypes are all the supported telemetry types.
+var TelemetryTypes = []TelemetryType{
+	EnhancedEA,
+	FunctionsRequests,
+	EnhancedEAMercury,
+	OCR,
+	OCR2Automation,
+	OCR2Functions,
+	OCR2CCIPCommit,
+	OCR2CCIPExec,
+	OCR2Threshold,
+	OCR2S4,
+	OCR2Median,
+	OCR3Mercury,
+	OCR3DataFeeds,
+	AutomationCustom,
+	OCR3Automation,
+	OCR3Rebalancer,
+	OCR3CCIPCommit,
+	OCR3CCIPExec,
+	OCR3CCIPBootstrap,
+	HeadReport,
+	PipelineBridge,
+	LLOObservation,
+	LLOOutcome,
+	LLOReport,
+}
+
 type TelemPayload struct {
 	Telemetry  []byte
 	TelemType  TelemetryType
@@ -14,3 +14,23 @@ type MonitoringEndpointGenerator interface {
 type MultitypeMonitoringEndpoint interface {
 	SendTypedLog(telemType synchronization.TelemetryType, log []byte)
 }
+
+// TypedFanoutAgent sends telemetry to several monitoring endpoints
+type TypedFanoutAgent []ocrtypes.MonitoringEndpoint
+
+// SendLog sends a telemetry log to all the monitoring endpoints
+func (t TypedFanoutAgent) SendLog(log []byte) {
+	for _, e := range t {
+		e.SendLog(log)
+	}
+}
+
+// MultitypeFanoutAgent sends telemetry of any type to several monitoring endpoints
+type MultitypeFanoutAgent []MultitypeMonitoringEndpoint
+
+// SendTypedLog sends a telemetry log to all the monitoring endpoints
+func (t MultitypeFanoutAgent) SendTypedLog(telemType synchronization.TelemetryType, log []byte) {
+	for _, e := range t {
+		e.SendTypedLog(telemType, log)
+	}
+}
@@ -0,0 +1,306 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	ocrtypes "github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	datastreamsllo "github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var _ MonitoringEndpointGenerator = &FileSink{}
+
+// FileSinkFormat is the encoding of the telemetry written by a FileSink.
+type FileSinkFormat string
+
+const (
+	// FileSinkFormatNDJSON writes one FileSinkRecord JSON object per line.
+	FileSinkFormatNDJSON FileSinkFormat = "ndjson"
+	// FileSinkFormatProtobuf writes size delimited telem.TelemRequest messages, as sent to the ingress server.
+	FileSinkFormatProtobuf FileSinkFormat = "protobuf"
+)
+
+func (f FileSinkFormat) extension() string {
+	if f == FileSinkFormatProtobuf {
+		return ".pb"
+	}
+	return ".ndjson"
+}
+
+// fileSinkMessages are the protobuf messages of the telemetry types, which the NDJSON format writes as JSON.
+var fileSinkMessages = map[synchronization.TelemetryType]func() proto.Message{
+	synchronization.EnhancedEA:        func() proto.Message { return &telemPb.EnhancedEA{} },
+	synchronization.EnhancedEAMercury: func() proto.Message { return &telemPb.EnhancedEAMercury{} },
+	synchronization.FunctionsRequests: func() proto.Message { return &telemPb.FunctionsRequest{} },
+	synchronization.AutomationCustom:  func() proto.Message { return &telemPb.AutomationTelemWrapper{} },
+	synchronization.HeadReport:        func() proto.Message { return &telemPb.HeadReportRequest{} },
+	synchronization.LLOOutcome:        func() proto.Message { return &datastreamsllo.LLOOutcomeTelemetry{} },
+	synchronization.LLOReport:         func() proto.Message { return &datastreamsllo.LLOReportTelemetry{} },
+}
+
+// FileSinkRecord is a telemetry log written by a FileSink in the NDJSON format. The telemetry of the types with a known
+// protobuf message is written as its JSON Message, and any other telemetry as the raw Telemetry bytes.
+type FileSinkRecord struct {
+	Timestamp     time.Time                     `json:"timestamp"`
+	Network       string                        `json:"network"`
+	ChainID       string                        `json:"chainID"`
+	ContractID    string                        `json:"contractID"`
+	TelemetryType synchronization.TelemetryType `json:"telemetryType"`
+	Telemetry     []byte                        `json:"telemetry,omitempty"`
+	Message       json.RawMessage               `json:"message,omitempty"`
+}
+
+// decodeMessage replaces the Telemetry of the record with its JSON Message, if it decodes to the protobuf message of its
+// type without unknown fields.
+func (r *FileSinkRecord) decodeMessage() {
+	newMsg, ok := fileSinkMessages[r.TelemetryType]
+	if !ok {
+		return
+	}
+	msg := newMsg()
+	if err := proto.Unmarshal(r.Telemetry, msg); err != nil || len(msg.ProtoReflect().GetUnknown()) > 0 {
+		return
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return
+	}
+	r.Telemetry, r.Message = nil, b
+}
+
+// FileSink writes telemetry to rotating local files, one per telemetry type, so that it can be analysed offline without
+// an ingress endpoint. Like the ingress clients, it buffers telemetry and drops it once the buffer is full.
+type FileSink struct {
+	services.Service
+	eng *services.Engine
+
+	dir        string
+	format     FileSinkFormat
+	types      map[synchronization.TelemetryType]FileSinkFormat // nil when all types are written in format
+	maxSizeMB  int
+	maxBackups int
+	logging    bool
+
+	dropMessageCount atomic.Uint32
+	chTelemetry      chan FileSinkRecord
+	// writers are only accessed by the goroutine writing the telemetry, and when closing
+	writers map[synchronization.TelemetryType]*lumberjack.Logger
+}
+
+// NewFileSink returns a FileSink writing telemetry to the configured directory.
+func NewFileSink(cfg config.TelemetryIngressFileSink, lggr logger.Logger, logging bool, bufferSize uint) *FileSink {
+	var types map[synchronization.TelemetryType]FileSinkFormat
+	if t := cfg.Types(); t != nil {
+		types = make(map[synchronization.TelemetryType]FileSinkFormat, len(t))
+		for name, format := range t {
+			types[synchronization.TelemetryType(name)] = FileSinkFormat(format)
+		}
+	}
+	s := &FileSink{
+		dir:         cfg.Dir(),
+		format:      FileSinkFormat(cfg.Format()),
+		types:       types,
+		maxSizeMB:   int(cfg.MaxSize() / utils.MB),
+		maxBackups:  int(cfg.MaxBackups()),
+		logging:     logging,
+		chTelemetry: make(chan FileSinkRecord, bufferSize),
+		writers:     make(map[synchronization.TelemetryType]*lumberjack.Logger),
+	}
+	s.Service, s.eng = services.Config{
+		Name:  "TelemetryFileSink",
+		Start: s.start,
+		Close: s.close,
+	}.NewServiceEngine(lggr)
+	return s
+}
+
+func (s *FileSink) start(context.Context) error {
+	s.eng.Go(func(ctx context.Context) {
+		for {
+			select {
+			case r := <-s.chTelemetry:
+				s.write(r)
+			case <-ctx.Done():
+				// Flush the buffered telemetry before closing the files
+				for {
+					select {
+					case r := <-s.chTelemetry:
+						s.write(r)
+					default:
+						return
+					}
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (s *FileSink) close() (err error) {
+	for _, w := range s.writers {
+		err = multierr.Append(err, w.Close())
+	}
+	return
+}
+
+// Enabled returns whether telemetry of the given type is written.
+func (s *FileSink) Enabled(telemType synchronization.TelemetryType) bool {
+	if s.types == nil {
+		return true
+	}
+	_, ok := s.types[telemType]
+	return ok
+}
+
+func (s *FileSink) formatOf(telemType synchronization.TelemetryType) FileSinkFormat {
+	if format, ok := s.types[telemType]; ok {
+		return format
+	}
+	return s.format
+}
+
+// Send buffers the telemetry to be written to the file of its type, throwing it away once the buffer is full.
+func (s *FileSink) Send(network string, chainID string, contractID string, telemType synchronization.TelemetryType, telemetry []byte) {
+	if !s.Enabled(telemType) {
+		return
+	}
+	r := FileSinkRecord{
+		Timestamp:     time.Now(),
+		Network:       network,
+		ChainID:       chainID,
+		ContractID:    contractID,
+		TelemetryType: telemType,
+		Telemetry:     telemetry,
+	}
+
+	select {
+	case s.chTelemetry <- r:
+		s.dropMessageCount.Store(0)
+	default:
+		s.logBufferFullWithExpBackoff(r)
+	}
+}
+
+// logBufferFullWithExpBackoff logs messages at 1, 2, 4, 8, ..., 64, 100, 200, 300, etc. dropped messages.
+func (s *FileSink) logBufferFullWithExpBackoff(r FileSinkRecord) {
+	count := s.dropMessageCount.Add(1)
+	if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+		s.eng.Warnw("telemetry file sink buffer full, dropping message", "telemetryType", r.TelemetryType, "droppedCount", count)
+	}
+}
+
+func (s *FileSink) write(r FileSinkRecord) {
+	format := s.formatOf(r.TelemetryType)
+	w, ok := s.writers[r.TelemetryType]
+	if !ok {
+		w = &lumberjack.Logger{
+			Filename:   filepath.Join(s.dir, string(r.TelemetryType)+format.extension()),
+			MaxSize:    s.maxSizeMB,
+			MaxBackups: s.maxBackups,
+		}
+		s.writers[r.TelemetryType] = w
+	}
+
+	if err := writeRecord(w, format, r); err != nil {
+		s.eng.Errorw("Could not write telemetry to file", "telemetryType", r.TelemetryType, "file", w.Filename, "err", err)
+		return
+	}
+	if s.logging {
+		s.eng.Debugw("successfully wrote telemetry to file", "contractID", r.ContractID, "telemetryType", r.TelemetryType, "file", w.Filename)
+	}
+}
+
+// writeRecord writes the record to w with a single write, so that a rotation never splits it across files.
+func writeRecord(w io.Writer, format FileSinkFormat, r FileSinkRecord) error {
+	var b []byte
+	var err error
+	switch format {
+	case FileSinkFormatNDJSON:
+		r.decodeMessage()
+		if b, err = json.Marshal(r); err != nil {
+			return errors.Wrap(err, "failed to marshal telemetry to JSON")
+		}
+		b = append(b, '\n')
+	case FileSinkFormatProtobuf:
+		req := &telemPb.TelemRequest{
+			Telemetry:     r.Telemetry,
+			Address:       r.ContractID,
+			TelemetryType: string(r.TelemetryType),
+			SentAt:        r.Timestamp.UnixNano(),
+		}
+		var buf bytes.Buffer
+		if _, err = protodelim.MarshalTo(&buf, req); err != nil {
+			return errors.Wrap(err, "failed to marshal telemetry to protobuf")
+		}
+		b = buf.Bytes()
+	default:
+		return fmt.Errorf("unsupported telemetry file format %q", format)
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// GenMonitoringEndpoint returns a new file agent writing telemetry of the given type
+func (s *FileSink) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) ocrtypes.MonitoringEndpoint {
+	return &TypedFileAgent{NewMultiFileAgent(s, network, chainID, contractID), telemType}
+}
+
+// GenMultitypeMonitoringEndpoint returns a new file agent writing telemetry of any type
+func (s *FileSink) GenMultitypeMonitoringEndpoint(network string, chainID string, contractID string) MultitypeMonitoringEndpoint {
+	return NewMultiFileAgent(s, network, chainID, contractID)
+}
+
+// TypedFileAgent writes telemetry of a given type for a contractID to a FileSink
+type TypedFileAgent struct {
+	*MultiFileAgent
+	telemType synchronization.TelemetryType
+}
+
+// SendLog writes a telemetry log to the file sink
+func (t *TypedFileAgent) SendLog(telemetry []byte) {
+	t.SendTypedLog(t.telemType, telemetry)
+}
+
+// MultiFileAgent writes telemetry of any type for a contractID to a FileSink
+type MultiFileAgent struct {
+	sink       *FileSink
+	network    string
+	chainID    string
+	contractID string
+}
+
+// NewMultiFileAgent creates a new MultiFileAgent with the given file sink and contractID
+func NewMultiFileAgent(sink *FileSink, network string, chainID string, contractID string) *MultiFileAgent {
+	return &MultiFileAgent{
+		sink,
+		network,
+		chainID,
+		contractID,
+	}
+}
+
+// SendTypedLog writes a telemetry log to the file sink
+func (t *MultiFileAgent) SendTypedLog(telemType synchronization.TelemetryType, telemetry []byte) {
+	t.sink.Send(t.network, t.chainID, t.contractID, telemType, telemetry)
+}
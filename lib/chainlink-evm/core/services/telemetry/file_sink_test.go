@@ -0,0 +1,139 @@
+package telemetry_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func newFileSinkConfig(t *testing.T, dir string, maxSize utils.FileSize, types map[string]string) *mocks.TelemetryIngressFileSink {
+	cfg := mocks.NewTelemetryIngressFileSink(t)
+	cfg.On("Dir").Return(dir)
+	cfg.On("Format").Return("ndjson")
+	cfg.On("MaxSize").Return(maxSize)
+	cfg.On("MaxBackups").Return(int64(1))
+	cfg.On("Types").Return(types)
+	return cfg
+}
+
+func readNDJSON(t *testing.T, path string) (records []telemetry.FileSinkRecord) {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, line := range bytes.Split(bytes.TrimSpace(b), []byte("\n")) {
+		var r telemetry.FileSinkRecord
+		require.NoError(t, json.Unmarshal(line, &r))
+		records = append(records, r)
+	}
+	return
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	cfg := newFileSinkConfig(t, dir, utils.MB, map[string]string{
+		string(synchronization.OCR):        "ndjson",
+		string(synchronization.HeadReport): "protobuf",
+	})
+	sink := telemetry.NewFileSink(cfg, logger.TestLogger(t), true, 100)
+	require.NoError(t, sink.Start(testutils.Context(t)))
+
+	assert.True(t, sink.Enabled(synchronization.OCR))
+	assert.False(t, sink.Enabled(synchronization.OCR2Median))
+
+	ocr := sink.GenMonitoringEndpoint("EVM", "1", "0xa", synchronization.OCR)
+	ocr.SendLog([]byte("ocr log 1"))
+	ocr.SendLog([]byte("ocr log 2"))
+	multi := sink.GenMultitypeMonitoringEndpoint("EVM", "1", "0xb")
+	multi.SendTypedLog(synchronization.HeadReport, []byte("head report"))
+	multi.SendTypedLog(synchronization.OCR2Median, []byte("median log"))
+
+	require.NoError(t, sink.Close())
+
+	records := readNDJSON(t, filepath.Join(dir, "ocr.ndjson"))
+	require.Len(t, records, 2)
+	for i, r := range records {
+		assert.Equal(t, "EVM", r.Network)
+		assert.Equal(t, "1", r.ChainID)
+		assert.Equal(t, "0xa", r.ContractID)
+		assert.Equal(t, synchronization.OCR, r.TelemetryType)
+		assert.False(t, r.Timestamp.IsZero())
+		assert.Equal(t, []byte(fmt.Sprintf("ocr log %d", i+1)), r.Telemetry)
+	}
+
+	f, err := os.Open(filepath.Join(dir, "head-report.pb"))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+	var req telemPb.TelemRequest
+	require.NoError(t, protodelim.UnmarshalFrom(bufio.NewReader(f), &req))
+	assert.Equal(t, []byte("head report"), req.Telemetry)
+	assert.Equal(t, "0xb", req.Address)
+	assert.Equal(t, string(synchronization.HeadReport), req.TelemetryType)
+	assert.NotZero(t, req.SentAt)
+
+	assert.NoFileExists(t, filepath.Join(dir, "ocr2-median.ndjson"))
+}
+
+func TestFileSink_rotation(t *testing.T) {
+	dir := t.TempDir()
+	sink := telemetry.NewFileSink(newFileSinkConfig(t, dir, utils.MB, nil), logger.TestLogger(t), false, 100)
+	require.NoError(t, sink.Start(testutils.Context(t)))
+
+	me := sink.GenMonitoringEndpoint("EVM", "1", "0xa", synchronization.OCR3Mercury)
+	for _, b := range []byte("abc") {
+		me.SendLog(bytes.Repeat([]byte{b}, 400*utils.KB))
+	}
+	require.NoError(t, sink.Close())
+
+	// Each record is over half of the max size once base64 encoded, so that every write rotates the file
+	records := readNDJSON(t, filepath.Join(dir, "ocr3-mercury.ndjson"))
+	require.Len(t, records, 1)
+	assert.Equal(t, bytes.Repeat([]byte("c"), 400*utils.KB), records[0].Telemetry)
+
+	backups, err := filepath.Glob(filepath.Join(dir, "ocr3-mercury-*.ndjson"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, backups)
+}
+
+func TestFileSink_ndjsonMessages(t *testing.T) {
+	dir := t.TempDir()
+	sink := telemetry.NewFileSink(newFileSinkConfig(t, dir, utils.MB, nil), logger.TestLogger(t), false, 100)
+	require.NoError(t, sink.Start(testutils.Context(t)))
+
+	report := &telemPb.HeadReportRequest{
+		ChainID: "1",
+		Latest:  &telemPb.Block{Timestamp: 1700000000, Number: 100, Hash: "0x64"},
+	}
+	b, err := proto.Marshal(report)
+	require.NoError(t, err)
+	me := sink.GenMonitoringEndpoint("EVM", "1", "", synchronization.HeadReport)
+	me.SendLog(b)
+	me.SendLog([]byte("not a head report"))
+	require.NoError(t, sink.Close())
+
+	records := readNDJSON(t, filepath.Join(dir, "head-report.ndjson"))
+	require.Len(t, records, 2)
+	assert.Nil(t, records[0].Telemetry)
+	var decoded telemPb.HeadReportRequest
+	require.NoError(t, protojson.Unmarshal(records[0].Message, &decoded))
+	assert.True(t, proto.Equal(report, &decoded))
+
+	assert.Nil(t, records[1].Message)
+	assert.Equal(t, []byte("not a head report"), records[1].Telemetry)
+}
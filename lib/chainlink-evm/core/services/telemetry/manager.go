@@ -31,6 +31,7 @@ type Manager struct {
 	sendTimeout                 time.Duration
 	uniConn                     bool
 	useBatchSend                bool
+	fileSink                    *FileSink
 	MonitoringEndpointGenerator MonitoringEndpointGenerator
 }
 
@@ -57,6 +58,10 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 	m.Service, m.eng = services.Config{
 		Name: "TelemetryManager",
 		NewSubServices: func(lggr common.Logger) (subs []services.Service) {
+			if fs := cfg.FileSink(); fs.Enabled() {
+				m.fileSink = NewFileSink(fs, lggr, cfg.Logging(), cfg.BufferSize())
+				subs = append(subs, m.fileSink)
+			}
 			for _, e := range cfg.Endpoints() {
 				if sub, err := m.newEndpoint(e, lggr, cfg); err != nil {
 					lggr.Error(err)
@@ -72,34 +77,53 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 }
 
 // GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
+// When the file sink is enabled, the telemetry is also written to local files, even if no endpoint exists.
 func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
 	e, found := m.getEndpoint(network, chainID)
 
 	if !found {
+		if m.fileSink != nil {
+			return m.fileSink.GenMonitoringEndpoint(network, chainID, contractID, telemType)
+		}
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry %q for contractID %q will NOT be sent", network, chainID, telemType, contractID)
 		return &NoopAgent{}
 	}
 
+	var agent commontypes.MonitoringEndpoint
 	if m.useBatchSend {
-		return NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+		agent = NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+	} else {
+		agent = NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
 	}
 
-	return NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
+	if m.fileSink != nil {
+		return TypedFanoutAgent{agent, m.fileSink.GenMonitoringEndpoint(network, chainID, contractID, telemType)}
+	}
+	return agent
 }
 
 func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string, contractID string) MultitypeMonitoringEndpoint {
 	e, found := m.getEndpoint(network, chainID)
 
 	if !found {
+		if m.fileSink != nil {
+			return m.fileSink.GenMultitypeMonitoringEndpoint(network, chainID, contractID)
+		}
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry for contractID %q will NOT be sent", network, chainID, contractID)
 		return &NoopAgent{}
 	}
 
+	var agent MultitypeMonitoringEndpoint
 	if m.useBatchSend {
-		return NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+		agent = NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+	} else {
+		agent = NewMultiIngressAgent(e.client, network, chainID, contractID)
 	}
 
-	return NewMultiIngressAgent(e.client, network, chainID, contractID)
+	if m.fileSink != nil {
+		return MultitypeFanoutAgent{agent, m.fileSink.GenMultitypeMonitoringEndpoint(network, chainID, contractID)}
+	}
+	return agent
 }
 
 func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Logger, cfg config.TelemetryIngress) (services.Service, error) {
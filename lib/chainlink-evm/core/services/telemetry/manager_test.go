@@ -3,6 +3,8 @@ package telemetry
 import (
 	"math/big"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -21,10 +23,18 @@ import (
 	keymocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
+	fs := mocks.NewTelemetryIngressFileSink(t)
+	fs.On("Enabled").Return(false)
+	return setupMockConfigWithFileSink(t, useBatchSend, fs)
+}
+
+func setupMockConfigWithFileSink(t *testing.T, useBatchSend bool, fs config.TelemetryIngressFileSink) *mocks.TelemetryIngress {
 	tic := mocks.NewTelemetryIngress(t)
+	tic.On("FileSink").Return(fs)
 	tic.On("BufferSize").Return(uint(123))
 	tic.On("Logging").Return(true)
 	tic.On("MaxBatchSize").Return(uint(51))
@@ -281,3 +291,61 @@ func TestCorrectEndpointRouting(t *testing.T) {
 		require.Equal(t, []byte(e.chainID), clientSent[i].Telemetry)
 	}
 }
+
+func TestManagerFileSink(t *testing.T) {
+	dir := t.TempDir()
+	fs := mocks.NewTelemetryIngressFileSink(t)
+	fs.On("Enabled").Return(true)
+	fs.On("Dir").Return(dir)
+	fs.On("Format").Return("ndjson")
+	fs.On("MaxSize").Return(utils.FileSize(utils.MB))
+	fs.On("MaxBackups").Return(int64(1))
+	fs.On("Types").Return(nil)
+	tic := setupMockConfigWithFileSink(t, true, fs)
+	tic.On("Endpoints").Return(nil)
+
+	lggr, obsLogs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+	tm := NewManager(tic, keymocks.NewCSA(t), lggr)
+	require.NotNil(t, tm.fileSink)
+
+	clientSent := make([]synchronization.TelemPayload, 0)
+	clientMock := mocks2.NewTelemetryService(t)
+	clientMock.On("Send", mock.Anything, mock.AnythingOfType("[]uint8"), mock.AnythingOfType("string"), mock.AnythingOfType("TelemetryType")).Return().Run(func(args mock.Arguments) {
+		clientSent = append(clientSent, synchronization.TelemPayload{
+			Telemetry:  args[1].([]byte),
+			ContractID: args[2].(string),
+			TelemType:  args[3].(synchronization.TelemetryType),
+		})
+	})
+	tm.endpoints = []*telemetryEndpoint{{Network: "NETWORK-1", ChainID: "NETWORK-1-CHAINID-1", client: clientMock}}
+	require.NoError(t, tm.fileSink.Start(testutils.Context(t)))
+
+	// Unknown networks are only written to files
+	me := tm.GenMonitoringEndpoint("unknown-network", "unknown-chainID", "contractID-1", synchronization.OCR)
+	require.Equal(t, "*telemetry.TypedFileAgent", reflect.TypeOf(me).String())
+	me.SendLog([]byte("file only"))
+	require.Equal(t, 0, obsLogs.Len())
+
+	// Known networks are sent to both the endpoint and files
+	me = tm.GenMonitoringEndpoint("network-1", "network-1-chainID-1", "contractID-2", synchronization.OCR)
+	require.Equal(t, "telemetry.TypedFanoutAgent", reflect.TypeOf(me).String())
+	me.SendLog([]byte("both"))
+	mme := tm.GenMultitypeMonitoringEndpoint("network-1", "network-1-chainID-1", "contractID-3")
+	require.Equal(t, "telemetry.MultitypeFanoutAgent", reflect.TypeOf(mme).String())
+	mme.SendTypedLog(synchronization.HeadReport, []byte("head report"))
+
+	require.NoError(t, tm.fileSink.Close())
+
+	require.Equal(t, []synchronization.TelemPayload{
+		{Telemetry: []byte("both"), ContractID: "contractID-2", TelemType: synchronization.OCR},
+		{Telemetry: []byte("head report"), ContractID: "contractID-3", TelemType: synchronization.HeadReport},
+	}, clientSent)
+
+	b, err := os.ReadFile(filepath.Join(dir, "ocr.ndjson"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"contractID":"contractID-1"`)
+	assert.Contains(t, lines[1], `"contractID":"contractID-2"`)
+	assert.FileExists(t, filepath.Join(dir, "head-report.ndjson"))
+}
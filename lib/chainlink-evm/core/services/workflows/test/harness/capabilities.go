@@ -0,0 +1,165 @@
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+// Responder produces the response of a fake capability for a request.
+type Responder func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error)
+
+// EchoInputs is the default Responder, it returns the step inputs as the step outputs.
+func EchoInputs(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	return capabilities.CapabilityResponse{Value: req.Inputs}, nil
+}
+
+// Capability is a fake action, consensus or target capability with a scriptable response.
+type Capability struct {
+	capabilities.CapabilityInfo
+
+	mu        sync.Mutex
+	responder Responder
+	requests  []capabilities.CapabilityRequest
+}
+
+var _ capabilities.ExecutableCapability = (*Capability)(nil)
+
+func newCapability(info capabilities.CapabilityInfo) *Capability {
+	return &Capability{CapabilityInfo: info, responder: EchoInputs}
+}
+
+// RespondWith replaces the responder of the capability.
+func (c *Capability) RespondWith(r Responder) *Capability {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responder = r
+	return c
+}
+
+// Returns makes the capability respond with the given outputs.
+func (c *Capability) Returns(outputs map[string]any) *Capability {
+	return c.RespondWith(func(capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		m, err := values.NewMap(outputs)
+		if err != nil {
+			return capabilities.CapabilityResponse{}, err
+		}
+		return capabilities.CapabilityResponse{Value: m}, nil
+	})
+}
+
+// Fails makes the capability fail with the given error.
+func (c *Capability) Fails(err error) *Capability {
+	return c.RespondWith(func(capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		return capabilities.CapabilityResponse{}, err
+	})
+}
+
+// StopsExecution makes the capability terminate the execution early, like a consensus capability that has nothing
+// to report.
+func (c *Capability) StopsExecution() *Capability {
+	return c.Fails(capabilities.ErrStopExecution)
+}
+
+// Requests returns the requests received by the capability so far.
+func (c *Capability) Requests() []capabilities.CapabilityRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]capabilities.CapabilityRequest(nil), c.requests...)
+}
+
+func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, req)
+	responder := c.responder
+	c.mu.Unlock()
+
+	return responder(req)
+}
+
+func (c *Capability) RegisterToWorkflow(ctx context.Context, req capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *Capability) UnregisterFromWorkflow(ctx context.Context, req capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+// Trigger is a fake trigger capability. Events are buffered until the engine registers the trigger.
+type Trigger struct {
+	capabilities.CapabilityInfo
+
+	mu         sync.Mutex
+	ch         chan capabilities.TriggerResponse
+	registered map[string]bool
+	count      int
+}
+
+var _ capabilities.TriggerCapability = (*Trigger)(nil)
+
+func newTrigger(info capabilities.CapabilityInfo, bufferSize int) *Trigger {
+	return &Trigger{
+		CapabilityInfo: info,
+		ch:             make(chan capabilities.TriggerResponse, bufferSize),
+		registered:     map[string]bool{},
+	}
+}
+
+// Emit sends a trigger event with the given outputs and returns its ID.
+func (tr *Trigger) Emit(outputs map[string]any) (string, error) {
+	m, err := values.NewMap(outputs)
+	if err != nil {
+		return "", err
+	}
+
+	tr.mu.Lock()
+	tr.count++
+	id := fmt.Sprintf("%s:%d", tr.ID, tr.count)
+	tr.mu.Unlock()
+
+	return id, tr.send(capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{TriggerType: tr.ID, ID: id, Outputs: m},
+	})
+}
+
+// EmitErr sends a failed trigger response.
+func (tr *Trigger) EmitErr(err error) error {
+	return tr.send(capabilities.TriggerResponse{Err: err})
+}
+
+func (tr *Trigger) send(resp capabilities.TriggerResponse) error {
+	select {
+	case tr.ch <- resp:
+		return nil
+	default:
+		return errors.New("trigger event buffer is full")
+	}
+}
+
+// Registered reports whether the engine has registered to the trigger.
+func (tr *Trigger) Registered() bool {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return len(tr.registered) > 0
+}
+
+func (tr *Trigger) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.registered[req.TriggerID] = true
+	return tr.ch, nil
+}
+
+func (tr *Trigger) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if !tr.registered[req.TriggerID] {
+		return fmt.Errorf("trigger %s is not registered", req.TriggerID)
+	}
+	delete(tr.registered, req.TriggerID)
+	return nil
+}
@@ -0,0 +1,271 @@
+// Package harness runs a workflow spec through the workflow engine against fake capabilities, so that workflows
+// can be tested with plain `go test` without wiring a capabilities registry by hand.
+//
+//	h := harness.New(t, spec)
+//	h.Capability("write_ethereum-testnet-sepolia@1.0.0").Fails(errors.New("rpc down"))
+//	h.Start()
+//	h.Trigger("mercury-trigger@1.0.0").Emit(map[string]any{"price": 42})
+//	ex := h.WaitForExecution()
+//	require.Equal(t, store.StatusErrored, ex.Status)
+package harness
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
+)
+
+const (
+	DefaultWorkflowID    = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+	DefaultWorkflowOwner = "c3c5e0b7b8e2c7f6b0b5c1d9e5a9b4f1c2d3e4f5"
+	DefaultWorkflowName  = "harness-workflow"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// Config customizes the harness. The zero value is valid.
+type Config struct {
+	WorkflowID    string
+	WorkflowOwner string
+	WorkflowName  string
+	// Timeout bounds how long WaitForExecution blocks, defaults to 30s
+	Timeout time.Duration
+	// TriggerBufferSize is the number of events a fake trigger can hold before they are consumed, defaults to 100
+	TriggerBufferSize int
+	// EngineConfig is applied last to the engine config, to override limits or timeouts
+	EngineConfig func(cfg *workflows.Config)
+}
+
+// Harness runs a single workflow engine, every capability referenced by the spec is replaced by a fake.
+type Harness struct {
+	t       testing.TB
+	cfg     Config
+	spec    sdk.WorkflowSpec
+	engine  *workflows.Engine
+	store   *recordingStore
+	started bool
+
+	triggers     map[string]*Trigger
+	capabilities map[string]*Capability
+}
+
+// New parses the YAML workflow spec and registers a fake for each of its capabilities. Capabilities can be scripted
+// until Start is called.
+func New(t testing.TB, yamlSpec string, opts ...func(*Config)) *Harness {
+	spec, err := (&job.WorkflowSpec{
+		Workflow: yamlSpec,
+		SpecType: job.YamlSpec,
+	}).SDKSpec(testutils.Context(t))
+	require.NoError(t, err, "failed to parse workflow spec")
+	return NewWithSpec(t, spec, opts...)
+}
+
+// NewWithSpec is like New for an already parsed spec.
+func NewWithSpec(t testing.TB, spec sdk.WorkflowSpec, opts ...func(*Config)) *Harness {
+	cfg := Config{
+		WorkflowID:        DefaultWorkflowID,
+		WorkflowOwner:     DefaultWorkflowOwner,
+		WorkflowName:      DefaultWorkflowName,
+		Timeout:           defaultTimeout,
+		TriggerBufferSize: 100,
+	}
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	h := &Harness{
+		t:            t,
+		cfg:          cfg,
+		spec:         spec,
+		triggers:     map[string]*Trigger{},
+		capabilities: map[string]*Capability{},
+	}
+
+	for _, s := range spec.Triggers {
+		if _, ok := h.triggers[s.ID]; ok {
+			continue
+		}
+		info, err := capabilities.NewCapabilityInfo(s.ID, capabilities.CapabilityTypeTrigger, "fake trigger")
+		require.NoError(t, err)
+		h.triggers[s.ID] = newTrigger(info, cfg.TriggerBufferSize)
+	}
+
+	sections := []struct {
+		steps   []sdk.StepDefinition
+		capType capabilities.CapabilityType
+	}{
+		{spec.Actions, capabilities.CapabilityTypeAction},
+		{spec.Consensus, capabilities.CapabilityTypeConsensus},
+		{spec.Targets, capabilities.CapabilityTypeTarget},
+	}
+	for _, section := range sections {
+		for _, s := range section.steps {
+			if _, ok := h.capabilities[s.ID]; ok {
+				continue
+			}
+			info, err := capabilities.NewCapabilityInfo(s.ID, section.capType, fmt.Sprintf("fake %s", section.capType))
+			require.NoError(t, err)
+			h.capabilities[s.ID] = newCapability(info)
+		}
+	}
+	return h
+}
+
+// Trigger returns the fake for the trigger capability ID, failing the test if the spec does not use it.
+func (h *Harness) Trigger(id string) *Trigger {
+	tr, ok := h.triggers[id]
+	require.True(h.t, ok, "trigger %s is not used by the workflow", id)
+	return tr
+}
+
+// Capability returns the fake for the action, consensus or target capability ID, failing the test if the spec does
+// not use it.
+func (h *Harness) Capability(id string) *Capability {
+	c, ok := h.capabilities[id]
+	require.True(h.t, ok, "capability %s is not used by the workflow", id)
+	return c
+}
+
+// Start registers the fakes and starts the engine, it is stopped when the test ends.
+func (h *Harness) Start() {
+	require.False(h.t, h.started, "harness already started")
+	h.started = true
+
+	ctx := testutils.Context(h.t)
+	lggr := logger.TestLogger(h.t)
+
+	reg := coreCap.NewRegistry(lggr)
+	reg.SetLocalRegistry(&coreCap.TestMetadataRegistry{})
+	for _, tr := range h.triggers {
+		require.NoError(h.t, reg.Add(ctx, tr))
+	}
+	for _, c := range h.capabilities {
+		require.NoError(h.t, reg.Add(ctx, c))
+	}
+
+	rl, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
+		GlobalRPS:      1000.0,
+		GlobalBurst:    1000,
+		PerSenderRPS:   100.0,
+		PerSenderBurst: 100,
+	})
+	require.NoError(h.t, err)
+	limits, err := syncerlimiter.NewWorkflowLimits(lggr, syncerlimiter.Config{
+		Global:   200,
+		PerOwner: 200,
+	})
+	require.NoError(h.t, err)
+
+	h.store = newRecordingStore(store.NewInMemoryStore(lggr, clockwork.NewRealClock()))
+	cfg := workflows.Config{
+		Workflow:      h.spec,
+		WorkflowID:    h.cfg.WorkflowID,
+		WorkflowOwner: h.cfg.WorkflowOwner,
+		WorkflowName:  workflows.NewLegacyWorkflowName(h.cfg.WorkflowName),
+		Lggr:          lggr,
+		Registry:      reg,
+		Store:         h.store,
+		SecretsFetcher: func(ctx context.Context, workflowOwner, hexWorkflowName, decodedWorkflowName, workflowID string) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		RateLimiter:    rl,
+		WorkflowLimits: limits,
+	}
+	if h.cfg.EngineConfig != nil {
+		h.cfg.EngineConfig(&cfg)
+	}
+
+	h.engine, err = workflows.NewEngine(ctx, cfg)
+	require.NoError(h.t, err)
+	servicetest.Run(h.t, h.engine)
+}
+
+// Emit starts the harness if needed and emits an event on the only trigger of the workflow.
+func (h *Harness) Emit(outputs map[string]any) string {
+	require.Len(h.t, h.triggers, 1, "Emit requires a workflow with a single trigger, use Trigger(id).Emit")
+	if !h.started {
+		h.Start()
+	}
+	for _, tr := range h.triggers {
+		id, err := tr.Emit(outputs)
+		require.NoError(h.t, err)
+		return id
+	}
+	return ""
+}
+
+// WaitForExecution blocks until the next execution finishes and returns its final state.
+func (h *Harness) WaitForExecution() Execution {
+	require.True(h.t, h.started, "harness not started")
+	select {
+	case ex := <-h.store.finished:
+		return Execution{WorkflowExecution: ex}
+	case <-time.After(h.cfg.Timeout):
+		require.FailNow(h.t, "timed out waiting for workflow execution to finish")
+	}
+	return Execution{}
+}
+
+// DroppedExecutions returns the number of finished executions that were dropped because too many were not waited for.
+func (h *Harness) DroppedExecutions() int64 {
+	return h.store.dropped.Load()
+}
+
+// Run emits an event on the only trigger of the workflow and waits for the resulting execution.
+func (h *Harness) Run(outputs map[string]any) Execution {
+	h.Emit(outputs)
+	return h.WaitForExecution()
+}
+
+// Execution is the final state of a workflow execution.
+type Execution struct {
+	store.WorkflowExecution
+}
+
+// Step returns the state of the step with the given ref, "trigger" for the trigger.
+func (e Execution) Step(ref string) (*store.WorkflowExecutionStep, bool) {
+	s, ok := e.Steps[ref]
+	return s, ok
+}
+
+// Output unwraps the outputs of the step with the given ref into dest.
+func (e Execution) Output(ref string, dest any) error {
+	s, ok := e.Steps[ref]
+	if !ok {
+		return fmt.Errorf("step %s not found in execution %s", ref, e.ExecutionID)
+	}
+	if s.Outputs.Err != nil {
+		return fmt.Errorf("step %s failed: %w", ref, s.Outputs.Err)
+	}
+	if s.Outputs.Value == nil {
+		return fmt.Errorf("step %s has no outputs", ref)
+	}
+	return s.Outputs.Value.UnwrapTo(dest)
+}
+
+// OutputValue returns the raw outputs of the step with the given ref.
+func (e Execution) OutputValue(ref string) (values.Value, bool) {
+	s, ok := e.Steps[ref]
+	if !ok {
+		return nil, false
+	}
+	return s.Outputs.Value, true
+}
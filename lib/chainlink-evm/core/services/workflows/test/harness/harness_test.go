@@ -0,0 +1,107 @@
+package harness_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/test/harness"
+)
+
+const workflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedIds:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      encoder: "EVM"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+
+func TestHarness(t *testing.T) {
+	t.Run("completed", func(t *testing.T) {
+		h := harness.New(t, workflow)
+		h.Capability("offchain_reporting@1.0.0").Returns(map[string]any{"report": []byte{1, 2, 3}})
+
+		ex := h.Run(map[string]any{"price": int64(42)})
+		assert.Equal(t, store.StatusCompleted, ex.Status)
+
+		var consensus struct {
+			Report []byte
+		}
+		require.NoError(t, ex.Output("evm_median", &consensus))
+		assert.Equal(t, []byte{1, 2, 3}, consensus.Report)
+
+		requests := h.Capability("write_ethereum-testnet-sepolia@1.0.0").Requests()
+		require.Len(t, requests, 1)
+		report, err := values.Unwrap(requests[0].Inputs.Underlying["report"])
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, report)
+		assert.Equal(t, harness.DefaultWorkflowID, requests[0].Metadata.WorkflowID)
+		assert.True(t, h.Trigger("mercury-trigger@1.0.0").Registered())
+	})
+
+	t.Run("target failure", func(t *testing.T) {
+		h := harness.New(t, workflow)
+		h.Capability("offchain_reporting@1.0.0").Returns(map[string]any{"report": []byte{1}})
+		h.Capability("write_ethereum-testnet-sepolia@1.0.0").Fails(errors.New("rpc down"))
+
+		ex := h.Run(map[string]any{"price": int64(42)})
+		assert.Equal(t, store.StatusErrored, ex.Status)
+		assert.Len(t, h.Capability("write_ethereum-testnet-sepolia@1.0.0").Requests(), 1)
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+		h := harness.New(t, workflow)
+		h.Capability("offchain_reporting@1.0.0").StopsExecution()
+
+		ex := h.Run(map[string]any{"price": int64(42)})
+		assert.Equal(t, store.StatusCompletedEarlyExit, ex.Status)
+		assert.Empty(t, h.Capability("write_ethereum-testnet-sepolia@1.0.0").Requests())
+	})
+
+	t.Run("multiple executions", func(t *testing.T) {
+		h := harness.New(t, workflow)
+		h.Capability("offchain_reporting@1.0.0").RespondWith(func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			m, err := values.NewMap(map[string]any{"report": []byte(req.Metadata.WorkflowExecutionID)})
+			return capabilities.CapabilityResponse{Value: m}, err
+		})
+		h.Start()
+
+		tr := h.Trigger("mercury-trigger@1.0.0")
+		for range 3 {
+			_, err := tr.Emit(map[string]any{"price": int64(42)})
+			require.NoError(t, err)
+		}
+		seen := map[string]bool{}
+		for range 3 {
+			ex := h.WaitForExecution()
+			assert.Equal(t, store.StatusCompleted, ex.Status)
+			seen[ex.ExecutionID] = true
+		}
+		assert.Len(t, seen, 3)
+	})
+}
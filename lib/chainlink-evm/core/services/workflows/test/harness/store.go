@@ -0,0 +1,37 @@
+package harness
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// recordingStoreBufferSize is the number of finished executions kept until they are waited for.
+const recordingStoreBufferSize = 100
+
+// recordingStore publishes every finished execution, the engine completion hooks are internal to the package.
+// Executions finishing while the buffer is full are dropped and counted, so that the engine never blocks on a test
+// that doesn't wait for them.
+type recordingStore struct {
+	store.Store
+	finished chan store.WorkflowExecution
+	dropped  atomic.Int64
+}
+
+func newRecordingStore(s store.Store) *recordingStore {
+	return &recordingStore{Store: s, finished: make(chan store.WorkflowExecution, recordingStoreBufferSize)}
+}
+
+func (s *recordingStore) FinishExecution(ctx context.Context, executionID string, status string) (store.WorkflowExecution, error) {
+	ex, err := s.Store.FinishExecution(ctx, executionID, status)
+	if err != nil {
+		return ex, err
+	}
+	select {
+	case s.finished <- ex:
+	default:
+		s.dropped.Add(1)
+	}
+	return ex, nil
+}
@@ -0,0 +1,30 @@
+package harness
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestRecordingStore_DropsWhenFull(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := newRecordingStore(store.NewInMemoryStore(logger.TestLogger(t), clockwork.NewRealClock()))
+
+	for i := 0; i < recordingStoreBufferSize+2; i++ {
+		id := fmt.Sprintf("execution-%d", i)
+		_, err := s.Add(ctx, map[string]*store.WorkflowExecutionStep{}, id, "workflow", store.StatusStarted)
+		require.NoError(t, err)
+		_, err = s.FinishExecution(ctx, id, store.StatusCompleted)
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, s.finished, recordingStoreBufferSize)
+	assert.Equal(t, int64(2), s.dropped.Load())
+}
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs
+ADD COLUMN aggregation JSONB;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs DROP COLUMN aggregation;
+-- +goose StatementEnd
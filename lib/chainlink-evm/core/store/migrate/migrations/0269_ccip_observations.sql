@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ccip_observations (
+    key TEXT PRIMARY KEY,
+    data BYTEA NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE ccip_observations;
+-- +goose StatementEnd
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// AutomationBackfillsController manages the log trigger backfills of automation jobs.
+type AutomationBackfillsController struct {
+	App chainlink.Application
+}
+
+// CreateAutomationBackfillRequest is a JSONAPI request for backfilling the logs of a log trigger upkeep.
+type CreateAutomationBackfillRequest struct {
+	UpkeepID  *ubig.Big `json:"upkeepID"`
+	FromBlock int64     `json:"fromBlock"`
+	ToBlock   int64     `json:"toBlock"`
+}
+
+// Index lists the active and recently finished backfills of an automation job.
+// Example:
+// "GET <application>/jobs/:ID/automation/backfills"
+func (bc *AutomationBackfillsController) Index(c *gin.Context) {
+	backfiller, ok := bc.backfiller(c)
+	if !ok {
+		return
+	}
+
+	reports, err := backfiller.BackfillReports(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewAutomationBackfillResources(reports), "automation_backfill")
+}
+
+// Create enqueues a backfill of a log trigger upkeep's logs in a block range. The logs that were not performed yet are
+// replayed through the log recoverer of the automation job.
+// Backfills are kept in the node's memory only and peers reject the recovery of logs outside of their own backfills, so
+// the identical backfill must be enqueued on every node of the DON, and again after a node restarts.
+// Example:
+// "POST <application>/jobs/:ID/automation/backfills"
+func (bc *AutomationBackfillsController) Create(c *gin.Context) {
+	backfiller, ok := bc.backfiller(c)
+	if !ok {
+		return
+	}
+
+	request := &CreateAutomationBackfillRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.UpkeepID == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing upkeepID"))
+		return
+	}
+
+	report, err := backfiller.Backfill(c.Request.Context(), request.UpkeepID.ToInt(), request.FromBlock, request.ToBlock)
+	if err != nil {
+		if errors.Is(err, logprovider.ErrBackfillInvalidRange) || errors.Is(err, logprovider.ErrBackfillUpkeepNotFound) ||
+			errors.Is(err, logprovider.ErrBackfillLimitExceeded) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bc.App.GetAuditLogger().Audit(audit.AutomationBackfillCreated, map[string]interface{}{
+		"jobID":     c.Param("ID"),
+		"upkeepID":  report.UpkeepID.String(),
+		"fromBlock": report.FromBlock,
+		"toBlock":   report.ToBlock,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewAutomationBackfillResource(report), "automation_backfill", http.StatusCreated)
+}
+
+// backfiller returns the log backfiller of the active automation job, responding with an error when there is none.
+func (bc *AutomationBackfillsController) backfiller(c *gin.Context) (logprovider.LogBackfiller, bool) {
+	jobID, err := stringutils.ToInt32(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+
+	for _, srv := range bc.App.JobSpawner().ActiveJobServices(jobID) {
+		if backfiller, ok := srv.(logprovider.LogBackfiller); ok {
+			return backfiller, true
+		}
+	}
+
+	jsonAPIError(c, http.StatusNotFound, errors.New("job is not an active automation job with log triggers"))
+	return nil, false
+}
@@ -0,0 +1,86 @@
+package web
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	evm "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// AutomationDiagnosticsController diagnoses the upkeeps of automation jobs.
+type AutomationDiagnosticsController struct {
+	App chainlink.Application
+}
+
+// Show explains why an upkeep is or isn't eligible to be performed by the automation job, using the job's registry,
+// chain clients and upkeep state. Conditional upkeeps are checked at the optional block, log trigger upkeeps for the
+// log identified by txHash and logIndex.
+// Example:
+// "GET <application>/jobs/:ID/automation/upkeeps/:upkeepID/diagnosis?block=123"
+// "GET <application>/jobs/:ID/automation/upkeeps/:upkeepID/diagnosis?txHash=0x...&logIndex=1"
+func (dc *AutomationDiagnosticsController) Show(c *gin.Context) {
+	jobID, err := stringutils.ToInt32(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	upkeepID, ok := new(big.Int).SetString(c.Param("upkeepID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid upkeepID"))
+		return
+	}
+
+	var opts evm.DiagnoseOptions
+	if block := c.Query("block"); block != "" {
+		if opts.CheckBlock, err = strconv.ParseInt(block, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid block"))
+			return
+		}
+	}
+	if txHash := c.Query("txHash"); txHash != "" {
+		if b, err := hexutil.Decode(txHash); err != nil || len(b) != common.HashLength {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid txHash"))
+			return
+		}
+		opts.TxHash = common.HexToHash(txHash)
+	}
+	if logIndex := c.Query("logIndex"); logIndex != "" {
+		if opts.LogIndex, err = strconv.ParseInt(logIndex, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid logIndex"))
+			return
+		}
+	}
+
+	var diagnoser evm.UpkeepDiagnoser
+	for _, srv := range dc.App.JobSpawner().ActiveJobServices(jobID) {
+		if d, ok := srv.(evm.UpkeepDiagnoser); ok {
+			diagnoser = d
+			break
+		}
+	}
+	if diagnoser == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job is not an active automation job"))
+		return
+	}
+
+	diagnosis, err := diagnoser.DiagnoseUpkeep(c.Request.Context(), upkeepID, opts)
+	if err != nil {
+		if errors.Is(err, evm.ErrDiagnosisInvalidUpkeepID) || errors.Is(err, evm.ErrDiagnosisMissingLog) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewAutomationUpkeepDiagnosisResource(diagnosis), "automation_upkeep_diagnosis")
+}
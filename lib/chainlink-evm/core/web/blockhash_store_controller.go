@@ -0,0 +1,135 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// BlockhashStoreController inspects and backfills the blockhashes of blockhash store jobs.
+type BlockhashStoreController struct {
+	App chainlink.Application
+}
+
+// CreateBlockhashStoreBackfillRequest is a JSONAPI request for backfilling the blockhashes of a block range.
+type CreateBlockhashStoreBackfillRequest struct {
+	FromBlock                  uint64          `json:"fromBlock"`
+	ToBlock                    uint64          `json:"toBlock"`
+	DryRun                     bool            `json:"dryRun"`
+	GasBudget                  uint64          `json:"gasBudget"`
+	BatchBlockhashStoreAddress *common.Address `json:"batchBlockhashStoreAddress"`
+}
+
+// Requests lists the pending VRF requests of the job's coordinators in a block range, and whether their blockhashes
+// are stored. The block range ends at the latest block if only fromBlock is set, and defaults to the job's search
+// window if neither is set.
+// Example:
+// "GET <application>/jobs/:ID/blockhash_store/requests?fromBlock=100&toBlock=200"
+func (bc *BlockhashStoreController) Requests(c *gin.Context) {
+	jobID, inspector, ok := bc.inspector(c)
+	if !ok {
+		return
+	}
+
+	var fromBlock, toBlock uint64
+	var err error
+	if from := c.Query("fromBlock"); from != "" {
+		if fromBlock, err = strconv.ParseUint(from, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid fromBlock"))
+			return
+		}
+	}
+	if to := c.Query("toBlock"); to != "" {
+		if toBlock, err = strconv.ParseUint(to, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid toBlock"))
+			return
+		}
+	}
+
+	report, err := inspector.PendingRequests(c.Request.Context(), fromBlock, toBlock)
+	if err != nil {
+		if errors.Is(err, blockhashstore.ErrBackfillInvalidRange) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewBlockhashStoreRequestsResource(jobID, report), "blockhash_store_requests")
+}
+
+// Backfill stores the missing blockhashes of the blocks with pending VRF requests in a block range, within an optional
+// gas budget. A dry run reports the blockhashes which would be stored without sending any transaction.
+// Example:
+// "POST <application>/jobs/:ID/blockhash_store/backfills"
+func (bc *BlockhashStoreController) Backfill(c *gin.Context) {
+	jobID, inspector, ok := bc.inspector(c)
+	if !ok {
+		return
+	}
+
+	request := &CreateBlockhashStoreBackfillRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	report, err := inspector.Backfill(c.Request.Context(), blockhashstore.BackfillOptions{
+		FromBlock:                  request.FromBlock,
+		ToBlock:                    request.ToBlock,
+		DryRun:                     request.DryRun,
+		GasBudget:                  request.GasBudget,
+		BatchBlockhashStoreAddress: request.BatchBlockhashStoreAddress,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, blockhashstore.ErrBackfillInvalidRange):
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		case errors.Is(err, blockhashstore.ErrBackfillInProgress):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if !report.DryRun {
+		bc.App.GetAuditLogger().Audit(audit.BlockhashStoreBackfillCreated, map[string]interface{}{
+			"jobID":        c.Param("ID"),
+			"fromBlock":    report.FromBlock,
+			"toBlock":      report.ToBlock,
+			"gasBudget":    report.GasBudget,
+			"transactions": report.Transactions,
+		})
+	}
+	jsonAPIResponse(c, presenters.NewBlockhashStoreBackfillResource(jobID, report), "blockhash_store_backfill")
+}
+
+// inspector returns the feeder inspector of the active blockhash store job, responding with an error when there is
+// none.
+func (bc *BlockhashStoreController) inspector(c *gin.Context) (int32, blockhashstore.FeederInspector, bool) {
+	jobID, err := stringutils.ToInt32(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return 0, nil, false
+	}
+
+	for _, srv := range bc.App.JobSpawner().ActiveJobServices(jobID) {
+		if inspector, ok := srv.(blockhashstore.FeederInspector); ok {
+			return jobID, inspector, true
+		}
+	}
+
+	jsonAPIError(c, http.StatusNotFound, errors.New("job is not an active blockhash store job"))
+	return 0, nil, false
+}
@@ -2,8 +2,10 @@ package web
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap/zapcore"
@@ -21,6 +23,12 @@ type LogController struct {
 type LogPatchRequest struct {
 	Level      string `json:"level"`
 	SqlEnabled *bool  `json:"sqlEnabled"`
+	// Name limits Level to the loggers with this name, and their sub-loggers.
+	Name string `json:"name,omitempty"`
+	// TTL is the duration after which the Level of Name reverts, e.g. "30m". Empty for no expiry.
+	TTL string `json:"ttl,omitempty"`
+	// Reset reverts Name to its configured level.
+	Reset bool `json:"reset,omitempty"`
 }
 
 // Get retrieves the current log config settings
@@ -39,6 +47,7 @@ func (cc *LogController) Get(c *gin.Context) {
 		ServiceName:     svcs,
 		LogLevel:        lvls,
 		DefaultLogLevel: cc.App.GetConfig().Log().DefaultLevel().String(),
+		NamedLogLevels:  presenters.NewNamedLogLevels(cc.App.GetConfig().NamedLogLevels().List()),
 	}
 
 	jsonAPIResponse(c, response, "log")
@@ -56,12 +65,17 @@ func (cc *LogController) Patch(c *gin.Context) {
 	var svcs, lvls []string
 
 	// Validate request params
-	if request.Level == "" && request.SqlEnabled == nil {
+	if request.Level == "" && request.SqlEnabled == nil && request.Name == "" {
 		jsonAPIError(c, http.StatusBadRequest, errors.New("please check request params, no params configured"))
 		return
 	}
 
-	if request.Level != "" {
+	if request.Name != "" {
+		if err := cc.setNamedLogLevel(request); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+	} else if request.Level != "" {
 		var ll zapcore.Level
 		err := ll.UnmarshalText([]byte(request.Level))
 		if err != nil {
@@ -87,11 +101,14 @@ func (cc *LogController) Patch(c *gin.Context) {
 		JAID: presenters.JAID{
 			ID: "log",
 		},
-		ServiceName: svcs,
-		LogLevel:    lvls,
+		ServiceName:    svcs,
+		LogLevel:       lvls,
+		NamedLogLevels: presenters.NewNamedLogLevels(cc.App.GetConfig().NamedLogLevels().List()),
 	}
 
-	cc.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]interface{}{"logLevel": request.Level})
+	if request.Name == "" {
+		cc.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]interface{}{"logLevel": request.Level})
+	}
 
 	if request.Level == "debug" {
 		if request.SqlEnabled != nil && *request.SqlEnabled {
@@ -103,3 +120,35 @@ func (cc *LogController) Patch(c *gin.Context) {
 
 	jsonAPIResponse(c, response, "log")
 }
+
+// setNamedLogLevel sets or resets the log level of the loggers with request.Name.
+func (cc *LogController) setNamedLogLevel(request *LogPatchRequest) error {
+	levels := cc.App.GetConfig().NamedLogLevels()
+	if request.Reset {
+		if !levels.Unset(request.Name) {
+			return fmt.Errorf("no log level was set for %q at runtime", request.Name)
+		}
+		cc.App.GetAuditLogger().Audit(audit.NamedLogLevelReset, map[string]interface{}{"name": request.Name})
+		return nil
+	}
+
+	if request.Level == "" {
+		return errors.New("level is required with name, unless reset is set")
+	}
+	var ll zapcore.Level
+	if err := ll.UnmarshalText([]byte(request.Level)); err != nil {
+		return err
+	}
+	var ttl time.Duration
+	if request.TTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(request.TTL); err != nil {
+			return fmt.Errorf("invalid ttl: %w", err)
+		}
+	}
+	if err := levels.Set(request.Name, ll, ttl); err != nil {
+		return err
+	}
+	cc.App.GetAuditLogger().Audit(audit.NamedLogLevelSet, map[string]interface{}{"name": request.Name, "logLevel": request.Level, "ttl": request.TTL})
+	return nil
+}
@@ -141,3 +141,53 @@ func TestLogController_PatchLogConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLogController_PatchNamedLogLevel(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Log.Levels = map[string]toml.LogLevel{"CCIPCommit": toml.LogLevel(zapcore.WarnLevel)}
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	patch := func(t *testing.T, request web.LogPatchRequest, expectedCode int) presenters.ServiceLogConfigResource {
+		requestData, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/log", bytes.NewBuffer(requestData))
+		t.Cleanup(cleanup)
+
+		var svcLogConfig presenters.ServiceLogConfigResource
+		cltest.AssertServerResponse(t, resp, expectedCode)
+		if expectedCode == http.StatusOK {
+			require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &svcLogConfig))
+		}
+		return svcLogConfig
+	}
+
+	patch(t, web.LogPatchRequest{Name: "CCIPExec"}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{Name: "CCIPExec", Level: "test"}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{Name: "CCIPExec", Level: "debug", TTL: "test"}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{Name: "CCIPExec.", Level: "debug"}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{Name: "CCIPExec", Reset: true}, http.StatusBadRequest)
+
+	svcLogConfig := patch(t, web.LogPatchRequest{Name: "CCIPExec", Level: "debug", TTL: "1h"}, http.StatusOK)
+	require.Len(t, svcLogConfig.NamedLogLevels, 2)
+	assert.Equal(t, "CCIPCommit", svcLogConfig.NamedLogLevels[0].Name)
+	assert.Equal(t, "warn", svcLogConfig.NamedLogLevels[0].Level)
+	assert.Nil(t, svcLogConfig.NamedLogLevels[0].ExpiresAt)
+	assert.Equal(t, "CCIPExec", svcLogConfig.NamedLogLevels[1].Name)
+	assert.Equal(t, "debug", svcLogConfig.NamedLogLevels[1].Level)
+	require.NotNil(t, svcLogConfig.NamedLogLevels[1].ExpiresAt)
+	// the global level is unchanged
+	for i, svcName := range svcLogConfig.ServiceName {
+		if svcName == "Global" {
+			assert.Equal(t, cfg.Log().Level().String(), svcLogConfig.LogLevel[i])
+		}
+	}
+
+	svcLogConfig = patch(t, web.LogPatchRequest{Name: "CCIPExec", Reset: true}, http.StatusOK)
+	require.Len(t, svcLogConfig.NamedLogLevels, 1)
+	assert.Equal(t, "CCIPCommit", svcLogConfig.NamedLogLevels[0].Name)
+}
@@ -0,0 +1,72 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+)
+
+// AutomationBackfillLog is a log found by an automation log trigger backfill.
+type AutomationBackfillLog struct {
+	WorkID      string      `json:"workID"`
+	BlockNumber int64       `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    int64       `json:"logIndex"`
+	Status      string      `json:"status"`
+}
+
+// AutomationBackfillResource is an automation log trigger backfill JSONAPI resource.
+type AutomationBackfillResource struct {
+	JAID
+	UpkeepID     string                  `json:"upkeepID"`
+	FromBlock    int64                   `json:"fromBlock"`
+	ToBlock      int64                   `json:"toBlock"`
+	ScannedBlock int64                   `json:"scannedBlock"`
+	Done         bool                    `json:"done"`
+	Error        string                  `json:"error,omitempty"`
+	CreatedAt    time.Time               `json:"createdAt"`
+	Logs         []AutomationBackfillLog `json:"logs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AutomationBackfillResource) GetName() string {
+	return "automation_backfill"
+}
+
+// NewAutomationBackfillResource returns a new AutomationBackfillResource for the backfill report.
+func NewAutomationBackfillResource(report logprovider.BackfillReport) AutomationBackfillResource {
+	logs := make([]AutomationBackfillLog, len(report.Logs))
+	for i, l := range report.Logs {
+		logs[i] = AutomationBackfillLog{
+			WorkID:      l.WorkID,
+			BlockNumber: l.BlockNumber,
+			TxHash:      l.TxHash,
+			LogIndex:    l.LogIndex,
+			Status:      string(l.Status),
+		}
+	}
+
+	return AutomationBackfillResource{
+		JAID:         NewJAIDInt64(report.ID),
+		UpkeepID:     report.UpkeepID.String(),
+		FromBlock:    report.FromBlock,
+		ToBlock:      report.ToBlock,
+		ScannedBlock: report.ScannedBlock,
+		Done:         report.Done,
+		Error:        report.Err,
+		CreatedAt:    report.CreatedAt,
+		Logs:         logs,
+	}
+}
+
+// NewAutomationBackfillResources returns a slice of AutomationBackfillResources for the backfill reports.
+func NewAutomationBackfillResources(reports []logprovider.BackfillReport) []AutomationBackfillResource {
+	rs := []AutomationBackfillResource{}
+	for _, report := range reports {
+		rs = append(rs, NewAutomationBackfillResource(report))
+	}
+
+	return rs
+}
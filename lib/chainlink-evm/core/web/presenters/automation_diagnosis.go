@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evm "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21"
+)
+
+// AutomationDiagnosisStep is a step of an automation upkeep diagnosis.
+type AutomationDiagnosisStep struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// AutomationUpkeepDiagnosisResource is an automation upkeep diagnosis JSONAPI resource, identified by the upkeep ID.
+type AutomationUpkeepDiagnosisResource struct {
+	JAID
+	TriggerType string                    `json:"triggerType"`
+	CheckBlock  int64                     `json:"checkBlock"`
+	WorkID      string                    `json:"workID"`
+	Eligible    bool                      `json:"eligible"`
+	Reason      string                    `json:"reason,omitempty"`
+	PerformData string                    `json:"performData,omitempty"`
+	Steps       []AutomationDiagnosisStep `json:"steps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AutomationUpkeepDiagnosisResource) GetName() string {
+	return "automation_upkeep_diagnosis"
+}
+
+// NewAutomationUpkeepDiagnosisResource returns a new AutomationUpkeepDiagnosisResource for the upkeep diagnosis.
+func NewAutomationUpkeepDiagnosisResource(d evm.UpkeepDiagnosis) AutomationUpkeepDiagnosisResource {
+	steps := make([]AutomationDiagnosisStep, len(d.Steps))
+	for i, s := range d.Steps {
+		steps[i] = AutomationDiagnosisStep{
+			Name:    s.Name,
+			Status:  string(s.Status),
+			Message: s.Message,
+		}
+	}
+
+	r := AutomationUpkeepDiagnosisResource{
+		JAID:        NewJAID(d.UpkeepID.String()),
+		TriggerType: d.TriggerType,
+		CheckBlock:  d.CheckBlock,
+		WorkID:      d.WorkID,
+		Eligible:    d.Eligible,
+		Reason:      d.Reason,
+		Steps:       steps,
+	}
+	if len(d.PerformData) > 0 {
+		r.PerformData = hexutil.Encode(d.PerformData)
+	}
+
+	return r
+}
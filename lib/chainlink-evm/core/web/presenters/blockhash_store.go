@@ -0,0 +1,117 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+// BlockhashStoreRequest is a pending VRF request of a coordinator fed by a blockhash store job.
+type BlockhashStoreRequest struct {
+	ID     string `json:"id"`
+	Block  uint64 `json:"block"`
+	Stored bool   `json:"stored"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BlockhashStoreCoordinatorRequests are the pending VRF requests of a coordinator.
+type BlockhashStoreCoordinatorRequests struct {
+	Version  string                  `json:"version"`
+	Address  common.Address          `json:"address"`
+	Requests []BlockhashStoreRequest `json:"requests"`
+}
+
+// BlockhashStoreRequestsResource lists the pending VRF requests of a blockhash store job's coordinators JSONAPI
+// resource.
+type BlockhashStoreRequestsResource struct {
+	JAID
+	FromBlock    uint64                              `json:"fromBlock"`
+	ToBlock      uint64                              `json:"toBlock"`
+	LatestBlock  uint64                              `json:"latestBlock"`
+	Coordinators []BlockhashStoreCoordinatorRequests `json:"coordinators"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BlockhashStoreRequestsResource) GetName() string {
+	return "blockhash_store_requests"
+}
+
+// NewBlockhashStoreRequestsResource returns a new BlockhashStoreRequestsResource for the job's pending requests.
+func NewBlockhashStoreRequestsResource(jobID int32, report blockhashstore.PendingRequestsReport) BlockhashStoreRequestsResource {
+	coordinators := make([]BlockhashStoreCoordinatorRequests, len(report.Coordinators))
+	for i, c := range report.Coordinators {
+		requests := make([]BlockhashStoreRequest, len(c.Requests))
+		for j, r := range c.Requests {
+			requests[j] = BlockhashStoreRequest{
+				ID:     r.ID,
+				Block:  r.Block,
+				Stored: r.Stored,
+				Error:  r.Error,
+			}
+		}
+		coordinators[i] = BlockhashStoreCoordinatorRequests{
+			Version:  c.Version,
+			Address:  c.Address,
+			Requests: requests,
+		}
+	}
+
+	return BlockhashStoreRequestsResource{
+		JAID:         NewJAIDInt32(jobID),
+		FromBlock:    report.FromBlock,
+		ToBlock:      report.ToBlock,
+		LatestBlock:  report.LatestBlock,
+		Coordinators: coordinators,
+	}
+}
+
+// BlockhashStoreBackfillBlock is a block with pending VRF requests found by a blockhash store backfill.
+type BlockhashStoreBackfillBlock struct {
+	Block      uint64   `json:"block"`
+	RequestIDs []string `json:"requestIDs"`
+	Status     string   `json:"status"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+// BlockhashStoreBackfillResource is a blockhash store backfill JSONAPI resource.
+type BlockhashStoreBackfillResource struct {
+	JAID
+	FromBlock    uint64                        `json:"fromBlock"`
+	ToBlock      uint64                        `json:"toBlock"`
+	LatestBlock  uint64                        `json:"latestBlock"`
+	DryRun       bool                          `json:"dryRun"`
+	GasBudget    uint64                        `json:"gasBudget"`
+	GasLimit     uint64                        `json:"gasLimit"`
+	Transactions int                           `json:"transactions"`
+	Blocks       []BlockhashStoreBackfillBlock `json:"blocks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BlockhashStoreBackfillResource) GetName() string {
+	return "blockhash_store_backfill"
+}
+
+// NewBlockhashStoreBackfillResource returns a new BlockhashStoreBackfillResource for the job's backfill report.
+func NewBlockhashStoreBackfillResource(jobID int32, report blockhashstore.BackfillReport) BlockhashStoreBackfillResource {
+	blocks := make([]BlockhashStoreBackfillBlock, len(report.Blocks))
+	for i, b := range report.Blocks {
+		blocks[i] = BlockhashStoreBackfillBlock{
+			Block:      b.Block,
+			RequestIDs: b.RequestIDs,
+			Status:     string(b.Status),
+			Reason:     b.Reason,
+		}
+	}
+
+	return BlockhashStoreBackfillResource{
+		JAID:         NewJAIDInt32(jobID),
+		FromBlock:    report.FromBlock,
+		ToBlock:      report.ToBlock,
+		LatestBlock:  report.LatestBlock,
+		DryRun:       report.DryRun,
+		GasBudget:    report.GasBudget,
+		GasLimit:     report.GasLimit,
+		Transactions: report.Transactions,
+		Blocks:       blocks,
+	}
+}
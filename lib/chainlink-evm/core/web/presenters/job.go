@@ -75,20 +75,21 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 
 // FluxMonitorSpec defines the spec details of a FluxMonitor Job
 type FluxMonitorSpec struct {
-	ContractAddress     types.EIP55Address `json:"contractAddress"`
-	Threshold           float32            `json:"threshold"`
-	AbsoluteThreshold   float32            `json:"absoluteThreshold"`
-	PollTimerPeriod     string             `json:"pollTimerPeriod"`
-	PollTimerDisabled   bool               `json:"pollTimerDisabled"`
-	IdleTimerPeriod     string             `json:"idleTimerPeriod"`
-	IdleTimerDisabled   bool               `json:"idleTimerDisabled"`
-	DrumbeatEnabled     bool               `json:"drumbeatEnabled"`
-	DrumbeatSchedule    *string            `json:"drumbeatSchedule"`
-	DrumbeatRandomDelay *string            `json:"drumbeatRandomDelay"`
-	MinPayment          *commonassets.Link `json:"minPayment"`
-	CreatedAt           time.Time          `json:"createdAt"`
-	UpdatedAt           time.Time          `json:"updatedAt"`
-	EVMChainID          *big.Big           `json:"evmChainID"`
+	ContractAddress     types.EIP55Address          `json:"contractAddress"`
+	Threshold           float32                     `json:"threshold"`
+	AbsoluteThreshold   float32                     `json:"absoluteThreshold"`
+	PollTimerPeriod     string                      `json:"pollTimerPeriod"`
+	PollTimerDisabled   bool                        `json:"pollTimerDisabled"`
+	IdleTimerPeriod     string                      `json:"idleTimerPeriod"`
+	IdleTimerDisabled   bool                        `json:"idleTimerDisabled"`
+	DrumbeatEnabled     bool                        `json:"drumbeatEnabled"`
+	DrumbeatSchedule    *string                     `json:"drumbeatSchedule"`
+	DrumbeatRandomDelay *string                     `json:"drumbeatRandomDelay"`
+	MinPayment          *commonassets.Link          `json:"minPayment"`
+	CreatedAt           time.Time                   `json:"createdAt"`
+	UpdatedAt           time.Time                   `json:"updatedAt"`
+	EVMChainID          *big.Big                    `json:"evmChainID"`
+	Aggregation         *job.FluxMonitorAggregation `json:"aggregation,omitempty"`
 }
 
 // NewFluxMonitorSpec initializes a new DirectFluxMonitorSpec from a
@@ -118,6 +119,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		CreatedAt:           spec.CreatedAt,
 		UpdatedAt:           spec.UpdatedAt,
 		EVMChainID:          spec.EVMChainID,
+		Aggregation:         spec.Aggregation,
 	}
 }
 
@@ -1,13 +1,39 @@
 package presenters
 
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
 type ServiceLogConfigResource struct {
 	JAID
-	ServiceName     []string `json:"serviceName"`
-	LogLevel        []string `json:"logLevel"`
-	DefaultLogLevel string   `json:"defaultLogLevel"`
+	ServiceName     []string        `json:"serviceName"`
+	LogLevel        []string        `json:"logLevel"`
+	DefaultLogLevel string          `json:"defaultLogLevel"`
+	NamedLogLevels  []NamedLogLevel `json:"namedLogLevels,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
 func (r ServiceLogConfigResource) GetName() string {
 	return "serviceLevelLogs"
 }
+
+// NamedLogLevel is the log level of the loggers with Name, and their sub-loggers.
+type NamedLogLevel struct {
+	Name      string     `json:"name"`
+	Level     string     `json:"level"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// NewNamedLogLevels returns the presentation of the named log levels.
+func NewNamedLogLevels(levels []logger.NamedLevel) []NamedLogLevel {
+	res := make([]NamedLogLevel, len(levels))
+	for i, l := range levels {
+		res[i] = NamedLogLevel{Name: l.Name, Level: l.Level.String()}
+		if !l.ExpiresAt.IsZero() {
+			res[i].ExpiresAt = &l.ExpiresAt
+		}
+	}
+	return res
+}
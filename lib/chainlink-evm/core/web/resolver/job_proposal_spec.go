@@ -1,10 +1,14 @@
 package resolver
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // SpecStatus defines the enum values for GQL
@@ -96,6 +100,100 @@ func (r *JobProposalSpecResolver) UpdatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.UpdatedAt}
 }
 
+// Diff resolves to the changes to the fields of the job proposal's approved
+// spec in this spec.
+func (r *JobProposalSpecResolver) Diff(ctx context.Context) ([]*JobProposalSpecFieldChangeResolver, error) {
+	specs, err := loader.GetSpecsByJobProposalID(ctx, strconv.FormatInt(r.spec.JobProposalID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	var current string
+	for _, spec := range specs {
+		if spec.Status == feeds.SpecStatusApproved {
+			current = spec.Definition
+			break
+		}
+	}
+
+	changes, err := feeds.DiffSpecs(current, r.spec.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobProposalSpecFieldChanges(changes), nil
+}
+
+// SpecFieldChangeKind defines the enum values for GQL
+type SpecFieldChangeKind string
+
+const (
+	// revive:disable
+	SpecFieldChangeKindAdded   SpecFieldChangeKind = "ADDED"
+	SpecFieldChangeKindRemoved SpecFieldChangeKind = "REMOVED"
+	SpecFieldChangeKindChanged SpecFieldChangeKind = "CHANGED"
+	// revive:enable
+)
+
+// ToSpecFieldChangeKind converts the feeds spec field change kind into the
+// enum value.
+func ToSpecFieldChangeKind(k feeds.SpecFieldChangeKind) SpecFieldChangeKind {
+	switch k {
+	case feeds.SpecFieldAdded:
+		return SpecFieldChangeKindAdded
+	case feeds.SpecFieldRemoved:
+		return SpecFieldChangeKindRemoved
+	default:
+		return SpecFieldChangeKindChanged
+	}
+}
+
+// JobProposalSpecFieldChangeResolver resolves the Job Proposal Spec Field
+// Change type.
+type JobProposalSpecFieldChangeResolver struct {
+	change feeds.SpecFieldChange
+}
+
+// NewJobProposalSpecFieldChanges creates a slice of
+// JobProposalSpecFieldChangeResolvers.
+func NewJobProposalSpecFieldChanges(changes []feeds.SpecFieldChange) []*JobProposalSpecFieldChangeResolver {
+	resolvers := make([]*JobProposalSpecFieldChangeResolver, 0, len(changes))
+
+	for _, c := range changes {
+		resolvers = append(resolvers, &JobProposalSpecFieldChangeResolver{change: c})
+	}
+
+	return resolvers
+}
+
+// Path resolves to the dot-separated path of the changed field
+func (r *JobProposalSpecFieldChangeResolver) Path() string {
+	return r.change.Path
+}
+
+// Kind resolves to the kind of change
+func (r *JobProposalSpecFieldChangeResolver) Kind() SpecFieldChangeKind {
+	return ToSpecFieldChangeKind(r.change.Kind)
+}
+
+// Old resolves to the value of the field in the approved spec, or null if the
+// field was added.
+func (r *JobProposalSpecFieldChangeResolver) Old() *string {
+	if r.change.Kind == feeds.SpecFieldAdded {
+		return nil
+	}
+	return &r.change.Old
+}
+
+// New resolves to the value of the field in this spec, or null if the field
+// was removed.
+func (r *JobProposalSpecFieldChangeResolver) New() *string {
+	if r.change.Kind == feeds.SpecFieldRemoved {
+		return nil
+	}
+	return &r.change.New
+}
+
 // -- ApproveJobProposal Mutation --
 
 // ApproveJobProposalSpecPayloadResolver resolves the spec payload.
@@ -464,3 +464,92 @@ func TestResolver_GetJobProposal_Spec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_GetJobProposal_SpecDiff(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobProposal {
+			jobProposal(id: "1") {
+				... on JobProposal {
+					specs {
+						id
+						diff {
+							path
+							kind
+							old
+							new
+						}
+					}
+				}
+			}
+		}`
+
+	jpID := int64(1)
+	specs := []feeds.JobProposalSpec{
+		{
+			ID:            100,
+			Definition:    "name = 'spec'\n[pluginConfig]\nfeeIDs = ['a']\nversion = 1",
+			Status:        feeds.SpecStatusApproved,
+			JobProposalID: jpID,
+			Version:       1,
+		},
+		{
+			ID:            101,
+			Definition:    "name = 'spec'\nnew = true\n[pluginConfig]\nfeeIDs = ['a', 'b']",
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jpID,
+			Version:       2,
+		},
+	}
+	result := `
+		{
+			"jobProposal": {
+				"specs": [{
+					"id": "100",
+					"diff": []
+				}, {
+					"id": "101",
+					"diff": [{
+						"path": "new",
+						"kind": "ADDED",
+						"old": null,
+						"new": "true"
+					}, {
+						"path": "pluginConfig.feeIDs",
+						"kind": "CHANGED",
+						"old": "['a']",
+						"new": "['a', 'b']"
+					}, {
+						"path": "pluginConfig.version",
+						"kind": "REMOVED",
+						"old": "1",
+						"new": null
+					}]
+				}]
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.feedsSvc.On("GetJobProposal", mock.Anything, jpID).Return(&feeds.JobProposal{
+					ID:             jpID,
+					Status:         feeds.JobProposalStatusApproved,
+					FeedsManagerID: 1,
+					PendingUpdate:  true,
+				}, nil)
+				f.Mocks.feedsSvc.
+					On("ListSpecsByJobProposalIDs", mock.Anything, []int64{jpID}).
+					Return(specs, nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query:  query,
+			result: result,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -379,6 +379,20 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		// AutomationBackfillsController
+		abc := AutomationBackfillsController{app}
+		authv2.GET("/jobs/:ID/automation/backfills", abc.Index)
+		authv2.POST("/jobs/:ID/automation/backfills", auth.RequiresRunRole(abc.Create))
+
+		// AutomationDiagnosticsController
+		adc := AutomationDiagnosticsController{app}
+		authv2.GET("/jobs/:ID/automation/upkeeps/:upkeepID/diagnosis", auth.RequiresRunRole(adc.Show))
+
+		// BlockhashStoreController
+		bhsc := BlockhashStoreController{app}
+		authv2.GET("/jobs/:ID/blockhash_store/requests", bhsc.Requests)
+		authv2.POST("/jobs/:ID/blockhash_store/backfills", auth.RequiresRunRole(bhsc.Backfill))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
@@ -528,7 +542,8 @@ func loggerFunc(lggr logger.Logger) gin.HandlerFunc {
 		c.Next()
 		end := time.Now()
 
-		lggr.Debugw(fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path),
+		// correlate the request log with the span of the otelgin middleware
+		logger.WithSpanContext(c.Request.Context(), lggr).Debugw(fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path),
 			"method", c.Request.Method,
 			"status", c.Writer.Status(),
 			"path", c.Request.URL.Path,
@@ -2,17 +2,24 @@ package web_test
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 
 	"github.com/stretchr/testify/assert"
@@ -195,3 +202,39 @@ func TestRouter_GinHelmetHeaders(t *testing.T) {
 			"wrong header for helmet's %s handler", tt.HelmetName)
 	}
 }
+
+func TestRouter_RequestLogHasSpanContext(t *testing.T) {
+	ctx := testutils.Context(t)
+	tp := sdktrace.NewTracerProvider()
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		assert.NoError(t, tp.Shutdown(context.Background()))
+	})
+
+	lggr, observed := logger.TestLoggerObserved(t, zapcore.DebugLevel)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		f := false
+		for _, c := range c.EVM {
+			c.Enabled = &f
+		}
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, lggr)
+	require.NoError(t, app.Start(ctx))
+
+	router := web.Router(t, app, nil)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL+"/health", nil)
+	require.NoError(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+
+	logs := observed.FilterMessage("GET /health").All()
+	require.Len(t, logs, 1)
+	fields := logs[0].ContextMap()
+	assert.Len(t, fields[logger.TraceIDKey], 32)
+	assert.Len(t, fields[logger.SpanIDKey], 16)
+}
@@ -0,0 +1,391 @@
+package v1_6
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/globals"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/shared"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview/evm"
+)
+
+const (
+	// defaultInflightScanWindow is the number of committed sequence numbers checked for execution per lane
+	defaultInflightScanWindow = 256
+	// executionStateSuccess is the OffRamp MessageExecutionState of a successfully executed message
+	executionStateSuccess uint8 = 2
+)
+
+// CurseImpactReport describes what the curse actions of a RMNCurseConfig would do if applied on the current onchain state
+type CurseImpactReport struct {
+	// Lanes halted by the curse, sorted by source then destination chain selector
+	Lanes []LaneCurseImpact
+	// TokenPools that would refuse to lock/burn or release/mint for some of their remote chains, only EVM pools are reported
+	TokenPools []TokenPoolCurseImpact
+}
+
+// LaneCurseImpact is the impact of a curse on a single lane
+type LaneCurseImpact struct {
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+	// HaltedAtSource is set when the source chain is cursed for the destination, no new message can be sent on the lane
+	HaltedAtSource bool
+	// HaltedAtDest is set when the destination chain is cursed for the source, messages on the lane are neither committed nor executed
+	HaltedAtDest bool
+	// AlreadyHalted is set when the current curses already halt the lane
+	AlreadyHalted bool
+	// Inflight are the messages stuck on the lane, only set for lanes halted at destination between EVM chains
+	Inflight *LaneInflightMessages
+}
+
+// LaneInflightMessages counts the messages sent on a lane but not executed yet
+type LaneInflightMessages struct {
+	// LastSentSeqNr is the last sequence number assigned by the source OnRamp
+	LastSentSeqNr uint64
+	// LastCommittedSeqNr is the last sequence number committed on the destination OffRamp
+	LastCommittedSeqNr uint64
+	// Uncommitted is the number of sent messages that are not committed
+	Uncommitted uint64
+	// CommittedUnexecuted is the number of committed messages that are not successfully executed, counted from ScannedFromSeqNr
+	CommittedUnexecuted uint64
+	// ScannedFromSeqNr is the first committed sequence number checked for execution
+	ScannedFromSeqNr uint64
+}
+
+// TokenPoolCurseImpact is a token pool for which transfers to or from some remote chains would be blocked
+type TokenPoolCurseImpact struct {
+	ChainSelector        uint64
+	TokenSymbol          shared.TokenSymbol
+	Pool                 common.Address
+	RemoteChainSelectors []uint64
+}
+
+// Log logs the report, one line per lane and token pool
+func (r CurseImpactReport) Log(lggr logger.Logger) {
+	lggr.Infow("Curse impact", "haltedLanes", len(r.Lanes), "affectedTokenPools", len(r.TokenPools))
+	for _, lane := range r.Lanes {
+		kv := []any{
+			"source", lane.SourceChainSelector,
+			"dest", lane.DestChainSelector,
+			"haltedAtSource", lane.HaltedAtSource,
+			"haltedAtDest", lane.HaltedAtDest,
+			"alreadyHalted", lane.AlreadyHalted,
+		}
+		if lane.Inflight != nil {
+			kv = append(kv,
+				"uncommittedMessages", lane.Inflight.Uncommitted,
+				"committedUnexecutedMessages", lane.Inflight.CommittedUnexecuted)
+		}
+		lggr.Infow("Lane halted by curse", kv...)
+	}
+	for _, pool := range r.TokenPools {
+		lggr.Infow("Token pool affected by curse",
+			"chain", pool.ChainSelector,
+			"token", pool.TokenSymbol,
+			"pool", pool.Pool.Hex(),
+			"remoteChains", pool.RemoteChainSelectors)
+	}
+}
+
+// SimulateRMNCurse evaluates the curse actions of the config against the current onchain state without applying them.
+// It reports the lanes that would halt, the in-flight messages that would be stuck on them and the token pools that
+// would stop transferring to or from the cursed chains.
+//
+// A lane from A to B halts at source when A is cursed globally or for B, and halts at destination when B is cursed
+// globally or for A. Only lanes halted at destination have stuck in-flight messages, messages already sent on a lane
+// halted at source are still committed and executed.
+func SimulateRMNCurse(e cldf.Environment, cfg RMNCurseConfig) (CurseImpactReport, error) {
+	curseActions, err := cfg.curseActions(e)
+	if err != nil {
+		return CurseImpactReport{}, err
+	}
+	grouped, err := groupRMNSubjectBySelector(curseActions, true, true)
+	if err != nil {
+		return CurseImpactReport{}, fmt.Errorf("failed to group curse actions: %w", err)
+	}
+	proposed, err := newCurseState(grouped)
+	if err != nil {
+		return CurseImpactReport{}, err
+	}
+
+	cursableChains, err := GetCursableChains(e)
+	if err != nil {
+		return CurseImpactReport{}, fmt.Errorf("failed to get cursable chains: %w", err)
+	}
+	state, err := stateview.LoadOnchainState(e)
+	if err != nil {
+		return CurseImpactReport{}, fmt.Errorf("failed to load onchain state: %w", err)
+	}
+	current := newCurrentCurses(cursableChains)
+
+	selectors := make([]uint64, 0, len(cursableChains))
+	for selector := range cursableChains {
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i] < selectors[j] })
+
+	var report CurseImpactReport
+	for _, source := range selectors {
+		for _, dest := range selectors {
+			if source == dest {
+				continue
+			}
+			haltedAtSource, haltedAtDest := proposed.laneHalts(source, dest)
+			if !haltedAtSource && !haltedAtDest {
+				continue
+			}
+
+			connected, err := cursableChains[dest].IsConnectedToSourceChain(source)
+			if err != nil {
+				return CurseImpactReport{}, fmt.Errorf("failed to check if chain %d is connected to chain %d: %w", dest, source, err)
+			}
+			if !connected {
+				continue
+			}
+
+			lane := LaneCurseImpact{
+				SourceChainSelector: source,
+				DestChainSelector:   dest,
+				HaltedAtSource:      haltedAtSource,
+				HaltedAtDest:        haltedAtDest,
+			}
+			lane.AlreadyHalted, err = current.laneHalted(source, dest)
+			if err != nil {
+				return CurseImpactReport{}, err
+			}
+			if haltedAtDest {
+				lane.Inflight, err = evmLaneInflightMessages(e.GetContext(), state, source, dest, defaultInflightScanWindow)
+				if err != nil {
+					return CurseImpactReport{}, fmt.Errorf("failed to count in-flight messages from chain %d to chain %d: %w", source, dest, err)
+				}
+			}
+			report.Lanes = append(report.Lanes, lane)
+		}
+	}
+
+	for _, selector := range selectors {
+		chainState, ok := state.Chains[selector]
+		if !ok {
+			continue
+		}
+		pools, err := evmTokenPoolsCurseImpact(e.GetContext(), selector, chainState, proposed[selector])
+		if err != nil {
+			return CurseImpactReport{}, fmt.Errorf("failed to check token pools on chain %d: %w", selector, err)
+		}
+		report.TokenPools = append(report.TokenPools, pools...)
+	}
+
+	return report, nil
+}
+
+// chainCurses are the curses of a single chain RMNRemote
+type chainCurses struct {
+	global bool
+	// remotes are the cursed remote chain selectors
+	remotes map[uint64]bool
+}
+
+func (c chainCurses) isCursed(remote uint64) bool {
+	return c.global || c.remotes[remote]
+}
+
+// curseState holds the curses of each chain, keyed by the chain selector of the RMNRemote
+type curseState map[uint64]chainCurses
+
+func newCurseState(subjectsBySelector map[uint64][]globals.Subject) (curseState, error) {
+	state := make(curseState, len(subjectsBySelector))
+	for selector, subjects := range subjectsBySelector {
+		family, err := chain_selectors.GetSelectorFamily(selector)
+		if err != nil {
+			return nil, err
+		}
+		curses := chainCurses{remotes: make(map[uint64]bool)}
+		for _, subject := range subjects {
+			if subject == globals.GlobalCurseSubject() {
+				curses.global = true
+				continue
+			}
+			curses.remotes[globals.FamilyAwareSubjectToSelector(subject, family)] = true
+		}
+		state[selector] = curses
+	}
+	return state, nil
+}
+
+// laneHalts returns whether the lane from source to dest is halted on the source and on the destination chain
+func (s curseState) laneHalts(source, dest uint64) (atSource bool, atDest bool) {
+	return s[source].isCursed(dest), s[dest].isCursed(source)
+}
+
+// currentCurses reads the curses currently applied onchain, caching the result of each subject
+type currentCurses struct {
+	chains map[uint64]CursableChain
+	cursed map[uint64]map[globals.Subject]bool
+}
+
+func newCurrentCurses(chains map[uint64]CursableChain) *currentCurses {
+	return &currentCurses{chains: chains, cursed: make(map[uint64]map[globals.Subject]bool)}
+}
+
+func (c *currentCurses) isCursed(selector uint64, remote uint64) (bool, error) {
+	family, err := chain_selectors.GetSelectorFamily(selector)
+	if err != nil {
+		return false, err
+	}
+	for _, subject := range []globals.Subject{globals.GlobalCurseSubject(), globals.FamilyAwareSelectorToSubject(remote, family)} {
+		if _, ok := c.cursed[selector]; !ok {
+			c.cursed[selector] = make(map[globals.Subject]bool)
+		}
+		cursed, ok := c.cursed[selector][subject]
+		if !ok {
+			cursed, err = c.chains[selector].IsSubjectCursed(subject)
+			if err != nil {
+				return false, fmt.Errorf("failed to check if chain %d is cursed: %w", selector, err)
+			}
+			c.cursed[selector][subject] = cursed
+		}
+		if cursed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *currentCurses) laneHalted(source, dest uint64) (bool, error) {
+	cursed, err := c.isCursed(source, dest)
+	if err != nil || cursed {
+		return cursed, err
+	}
+	return c.isCursed(dest, source)
+}
+
+// evmLaneInflightMessages counts the messages sent but not executed on a lane between EVM chains, nil for other lanes.
+// Execution is only checked for the last scanWindow committed messages.
+func evmLaneInflightMessages(ctx context.Context, state stateview.CCIPOnChainState, source, dest uint64, scanWindow uint64) (*LaneInflightMessages, error) {
+	sourceState, ok := state.Chains[source]
+	if !ok || sourceState.OnRamp == nil {
+		return nil, nil
+	}
+	destState, ok := state.Chains[dest]
+	if !ok || destState.OffRamp == nil {
+		return nil, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	destChainConfig, err := sourceState.OnRamp.GetDestChainConfig(opts, dest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get onramp dest chain config: %w", err)
+	}
+	sourceChainConfig, err := destState.OffRamp.GetSourceChainConfig(opts, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offramp source chain config: %w", err)
+	}
+
+	inflight := &LaneInflightMessages{LastSentSeqNr: destChainConfig.SequenceNumber}
+	if sourceChainConfig.MinSeqNr > 0 {
+		inflight.LastCommittedSeqNr = sourceChainConfig.MinSeqNr - 1
+	}
+	if inflight.LastSentSeqNr > inflight.LastCommittedSeqNr {
+		inflight.Uncommitted = inflight.LastSentSeqNr - inflight.LastCommittedSeqNr
+	}
+
+	inflight.ScannedFromSeqNr = 1
+	if inflight.LastCommittedSeqNr > scanWindow {
+		inflight.ScannedFromSeqNr = inflight.LastCommittedSeqNr - scanWindow + 1
+	}
+	for seqNr := inflight.ScannedFromSeqNr; seqNr <= inflight.LastCommittedSeqNr; seqNr++ {
+		executionState, err := destState.OffRamp.GetExecutionState(opts, source, seqNr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get execution state of sequence number %d: %w", seqNr, err)
+		}
+		if executionState != executionStateSuccess {
+			inflight.CommittedUnexecuted++
+		}
+	}
+
+	return inflight, nil
+}
+
+type supportedChainsTokenPool interface {
+	Address() common.Address
+	GetSupportedChains(opts *bind.CallOpts) ([]uint64, error)
+}
+
+// evmTokenPoolsCurseImpact returns the token pools of the chain with supported remote chains cursed by the proposed
+// curses of the chain, token pools check the curses of their own chain RMN only.
+func evmTokenPoolsCurseImpact(ctx context.Context, selector uint64, chainState evm.CCIPChainState, proposed chainCurses) ([]TokenPoolCurseImpact, error) {
+	if !proposed.global && len(proposed.remotes) == 0 {
+		return nil, nil
+	}
+
+	type symbolPool struct {
+		symbol shared.TokenSymbol
+		pool   supportedChainsTokenPool
+	}
+	var pools []symbolPool
+	for symbol, versions := range chainState.BurnMintTokenPools {
+		for _, pool := range versions {
+			pools = append(pools, symbolPool{symbol, pool})
+		}
+	}
+	for symbol, versions := range chainState.BurnWithFromMintTokenPools {
+		for _, pool := range versions {
+			pools = append(pools, symbolPool{symbol, pool})
+		}
+	}
+	for symbol, versions := range chainState.BurnFromMintTokenPools {
+		for _, pool := range versions {
+			pools = append(pools, symbolPool{symbol, pool})
+		}
+	}
+	for symbol, versions := range chainState.LockReleaseTokenPools {
+		for _, pool := range versions {
+			pools = append(pools, symbolPool{symbol, pool})
+		}
+	}
+	for _, pool := range chainState.USDCTokenPools {
+		pools = append(pools, symbolPool{shared.USDCSymbol, pool})
+	}
+
+	var impacts []TokenPoolCurseImpact
+	for _, p := range pools {
+		remotes, err := p.pool.GetSupportedChains(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get supported chains of %s token pool %s: %w", p.symbol, p.pool.Address().Hex(), err)
+		}
+		var cursedRemotes []uint64
+		for _, remote := range remotes {
+			if proposed.isCursed(remote) {
+				cursedRemotes = append(cursedRemotes, remote)
+			}
+		}
+		if len(cursedRemotes) == 0 {
+			continue
+		}
+		sort.Slice(cursedRemotes, func(i, j int) bool { return cursedRemotes[i] < cursedRemotes[j] })
+		impacts = append(impacts, TokenPoolCurseImpact{
+			ChainSelector:        selector,
+			TokenSymbol:          p.symbol,
+			Pool:                 p.pool.Address(),
+			RemoteChainSelectors: cursedRemotes,
+		})
+	}
+
+	sort.Slice(impacts, func(i, j int) bool {
+		if impacts[i].TokenSymbol != impacts[j].TokenSymbol {
+			return impacts[i].TokenSymbol < impacts[j].TokenSymbol
+		}
+		return impacts[i].Pool.Hex() < impacts[j].Pool.Hex()
+	})
+	return impacts, nil
+}
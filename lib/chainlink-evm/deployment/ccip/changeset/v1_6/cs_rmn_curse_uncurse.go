@@ -51,6 +51,8 @@ type RMNCurseConfig struct {
 	// Use this if you want to include curse subject even when they are already cursed (CurseChangeset) or already uncursed (UncurseChangeset)
 	Force  bool
 	Reason string
+	// Use this to log the impact of the curse (halted lanes, stuck in-flight messages, affected token pools) before cursing, see SimulateRMNCurse
+	ReportImpact bool
 }
 
 func (c RMNCurseConfig) Validate(e cldf.Environment) error {
@@ -140,6 +142,29 @@ func (c RMNCurseConfig) Validate(e cldf.Environment) error {
 	return nil
 }
 
+// curseActions generates the curse actions of the config, lanes that are not connected are filtered out unless IncludeNotConnectedLanes is set
+func (c RMNCurseConfig) curseActions(e cldf.Environment) ([]RMNCurseAction, error) {
+	var curseActions []RMNCurseAction
+	for _, curseAction := range c.CurseActions {
+		actions, err := curseAction(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate curse actions: %w", err)
+		}
+
+		curseActions = append(curseActions, actions...)
+	}
+
+	if !c.IncludeNotConnectedLanes {
+		var err error
+		curseActions, err = FilterOutNotConnectedLanes(e, curseActions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter out not connected lanes: %w", err)
+		}
+	}
+
+	return curseActions, nil
+}
+
 // CurseLaneOnlyOnSource curses a lane only on the source chain
 // This will prevent message from source to destination to be initiated
 // One noteworthy behaviour is that this means that message can be sent from destination to source but will not be executed on the source
@@ -365,24 +390,19 @@ func RMNCurseChangeset(e cldf.Environment, cfg RMNCurseConfig) (cldf.ChangesetOu
 		return cldf.ChangesetOutput{}, fmt.Errorf("failed to load onchain state: %w", err)
 	}
 
-	deployerGroup := deployergroup.NewDeployerGroup(e, state, cfg.MCMS).WithDeploymentContext("proposal to curse RMNs: " + cfg.Reason)
-
-	// Generate curse actions
-	var curseActions []RMNCurseAction
-	for _, curseAction := range cfg.CurseActions {
-		actions, err := curseAction(e)
+	if cfg.ReportImpact {
+		report, err := SimulateRMNCurse(e, cfg)
 		if err != nil {
-			return cldf.ChangesetOutput{}, fmt.Errorf("failed to generate curse actions: %w", err)
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to simulate curse: %w", err)
 		}
-
-		curseActions = append(curseActions, actions...)
+		report.Log(e.Logger)
 	}
 
-	if !cfg.IncludeNotConnectedLanes {
-		curseActions, err = FilterOutNotConnectedLanes(e, curseActions)
-		if err != nil {
-			return cldf.ChangesetOutput{}, fmt.Errorf("failed to filter out not connected lanes: %w", err)
-		}
+	deployerGroup := deployergroup.NewDeployerGroup(e, state, cfg.MCMS).WithDeploymentContext("proposal to curse RMNs: " + cfg.Reason)
+
+	curseActions, err := cfg.curseActions(e)
+	if err != nil {
+		return cldf.ChangesetOutput{}, err
 	}
 
 	// Group curse actions by chain selector
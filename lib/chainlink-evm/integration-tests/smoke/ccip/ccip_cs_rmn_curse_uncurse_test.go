@@ -316,6 +316,62 @@ func TestRMNCurseOneConnectedLanes(t *testing.T) {
 	}, mapIDToSelector)
 }
 
+func TestRMNCurseImpactReport(t *testing.T) {
+	e, _ := testhelpers.NewMemoryEnvironment(t, testhelpers.WithNumOfChains(2), testhelpers.WithSolChains(1))
+
+	mapIDToSelector := func(id uint64) uint64 {
+		return v1_6.GetAllCursableChainsSelector(e.Env)[id]
+	}
+
+	_, err := v1_6.UpdateOffRampSourcesChangeset(e.Env,
+		v1_6.UpdateOffRampSourcesConfig{
+			UpdatesByChain: map[uint64]map[uint64]v1_6.OffRampSourceUpdate{
+				mapIDToSelector(Evm1): { // to
+					mapIDToSelector(Evm2): { // from
+						IsEnabled:                 true,
+						TestRouter:                false,
+						IsRMNVerificationDisabled: true,
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	config := v1_6.RMNCurseConfig{
+		CurseActions: []v1_6.CurseAction{
+			v1_6.CurseChain(mapIDToSelector(Evm1)),
+		},
+		Reason: "test curse",
+	}
+
+	report, err := v1_6.SimulateRMNCurse(e.Env, config)
+	require.NoError(t, err)
+	require.Len(t, report.Lanes, 1)
+	lane := report.Lanes[0]
+	require.Equal(t, mapIDToSelector(Evm2), lane.SourceChainSelector)
+	require.Equal(t, mapIDToSelector(Evm1), lane.DestChainSelector)
+	require.True(t, lane.HaltedAtSource)
+	require.True(t, lane.HaltedAtDest)
+	require.False(t, lane.AlreadyHalted)
+	require.NotNil(t, lane.Inflight)
+	require.Zero(t, lane.Inflight.Uncommitted)
+	require.Zero(t, lane.Inflight.CommittedUnexecuted)
+	require.Empty(t, report.TokenPools)
+
+	// simulation does not curse anything
+	verifyNoActiveCurseOnAllChains(t, &e)
+
+	config.ReportImpact = true
+	_, err = v1_6.RMNCurseChangeset(e.Env, config)
+	require.NoError(t, err)
+
+	report, err = v1_6.SimulateRMNCurse(e.Env, config)
+	require.NoError(t, err)
+	require.Len(t, report.Lanes, 1)
+	require.True(t, report.Lanes[0].AlreadyHalted)
+}
+
 func TestRMNCurseOneConnectedLanesGlobalOnly(t *testing.T) {
 	e, _ := testhelpers.NewMemoryEnvironment(t, testhelpers.WithNumOfChains(2), testhelpers.WithSolChains(1))
 